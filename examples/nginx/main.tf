@@ -147,28 +147,28 @@ resource "linode_instance" "nginx" {
     private_key = chomp(file(var.private_ssh_key))
   }
 
-  disk {
+  disk = [{
     label           = "boot"
     size            = data.linode_instance_type.default.disk / 2
     authorized_keys = [chomp(file(var.public_ssh_key))]
     root_pass       = "${random_string.password.result}"
     image           = data.linode_image.ubuntu.id
-  }
+  }]
 
-  config {
+  config = [{
     label  = "nginx"
     kernel = "linode/latest-64bit"
 
-    devices {
-      sda {
+    devices = [{
+      sda = [{
         disk_label = "boot"
-      }
+      }]
 
-      sdb {
+      sdb = [{
         volume_id = element(linode_volume.nginx-vol.*.id, count.index)
-      }
-    }
-  }
+      }]
+    }]
+  }]
 
   provisioner "remote-exec" {
     inline = [
@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -44,6 +45,18 @@ var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, erro
 	},
 }
 
+// ProtoV6ProviderFactoriesFor returns the shared provider factories for the given test.
+// If LINODE_RECORDER_MODE is set, the requests of the providers go through the Recorder of the test.
+func ProtoV6ProviderFactoriesFor(t testing.TB) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	if GetRecorderMode() == RecorderModeDisabled {
+		return ProtoV6ProviderFactories
+	}
+
+	return NewRecorder(t).ProtoV6ProviderFactories()
+}
+
 var HttpExternalProviders = map[string]resource.ExternalProvider{
 	"http": {
 		Source: "hashicorp/http",
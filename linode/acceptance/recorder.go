@@ -0,0 +1,581 @@
+package acceptance
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+const (
+	recorderModeEnvVar = "LINODE_RECORDER_MODE"
+
+	// RecordingsDir is the directory, relative to the package under test,
+	// containing the recordings used in replay mode.
+	RecordingsDir = "testdata/recordings"
+
+	// testImagesRecording is the file in the RecordingsDir containing
+	// the test images used by the recorded tests of a package.
+	testImagesRecording = "test_images.json"
+
+	redactedValue = "REDACTED"
+)
+
+type RecorderMode string
+
+const (
+	// RecorderModeDisabled sends all requests to the Linode API without recording them.
+	RecorderModeDisabled RecorderMode = ""
+
+	// RecorderModeRecord sends all requests to the Linode API and
+	// saves the scrubbed interactions once the test has passed.
+	RecorderModeRecord RecorderMode = "record"
+
+	// RecorderModeReplay serves all requests from previously recorded interactions
+	// without accessing the Linode API.
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// sensitiveRecordingKeys are the JSON keys whose values are never written to a recording.
+var sensitiveRecordingKeys = map[string]struct{}{
+	"access_key":    {},
+	"kubeconfig":    {},
+	"password":      {},
+	"private_key":   {},
+	"root_pass":     {},
+	"root_password": {},
+	"secret_key":    {},
+	"ssl_key":       {},
+	"tls_key":       {},
+	"token":         {},
+}
+
+// recorders holds the Recorder of each test using one, keyed by test name.
+// Recorded tests are the only tests that can run in replay mode.
+var recorders sync.Map
+
+// GetRecorderMode returns the recorder mode configured through the LINODE_RECORDER_MODE environment variable.
+func GetRecorderMode() RecorderMode {
+	return RecorderMode(strings.ToLower(strings.TrimSpace(os.Getenv(recorderModeEnvVar))))
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Filter string `json:"filter,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+type recordedInteraction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+// key returns the value used to match replayed requests against recorded interactions.
+func (r recordedRequest) key() string {
+	return strings.Join([]string{r.Method, r.URL, r.Filter}, " ")
+}
+
+type recordedTestImages struct {
+	Latest   string `json:"latest"`
+	Previous string `json:"previous"`
+}
+
+type recording struct {
+	Values       map[string][]string    `json:"values,omitempty"`
+	Interactions []*recordedInteraction `json:"interactions"`
+}
+
+// Recorder records the Linode API interactions of a single acceptance test
+// and replays them so that the test can run without an API token.
+type Recorder struct {
+	t    testing.TB
+	mode RecorderMode
+	path string
+
+	mu        sync.Mutex
+	recording recording
+
+	// replayed, cursor and valueIndex track the progress of a replay.
+	// The cursor is the index following the last replayed interaction.
+	replayed   []bool
+	cursor     int
+	valueIndex map[string]int
+}
+
+// NewRecorder returns the recorder for the given test using the configured recorder mode,
+// creating it on first use. In replay mode, the recording of the test is loaded
+// from the RecordingsDir of the package.
+func NewRecorder(t testing.TB) *Recorder {
+	t.Helper()
+
+	r := &Recorder{
+		t:          t,
+		mode:       GetRecorderMode(),
+		path:       filepath.Join(RecordingsDir, strings.ReplaceAll(t.Name(), "/", "_")+".json"),
+		valueIndex: make(map[string]int),
+	}
+
+	if existing, loaded := recorders.LoadOrStore(t.Name(), r); loaded {
+		return existing.(*Recorder)
+	}
+
+	t.Cleanup(func() {
+		recorders.Delete(t.Name())
+	})
+
+	switch r.mode {
+	case RecorderModeDisabled:
+	case RecorderModeRecord:
+		t.Cleanup(r.save)
+	case RecorderModeReplay:
+		if err := r.load(); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				t.Skipf("skipping test without recording %s in replay mode", r.path)
+			}
+
+			t.Fatalf("failed to load recording: %s", err)
+		}
+	default:
+		t.Fatalf("invalid value %q for %s; expected %q or %q", r.mode, recorderModeEnvVar, RecorderModeRecord, RecorderModeReplay)
+	}
+
+	return r
+}
+
+// Mode returns the mode of this recorder.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// HTTPClientModifier returns a modifier routing the requests of an HTTP client through this recorder.
+func (r *Recorder) HTTPClientModifier() helper.HTTPClientModifier {
+	return func(client *http.Client) error {
+		client.Transport = &recorderTransport{
+			recorder: r,
+			next:     cmp.Or(client.Transport, http.DefaultTransport),
+		}
+		return nil
+	}
+}
+
+// ProtoV6ProviderFactories returns provider factories for providers whose requests go through this recorder.
+// If recording is disabled, the shared ProtoV6ProviderFactories are returned.
+func (r *Recorder) ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	if r.mode == RecorderModeDisabled {
+		return ProtoV6ProviderFactories
+	}
+
+	frameworkProvider := &linode.FrameworkProvider{
+		ProviderVersion:     TestAccFrameworkProvider.ProviderVersion,
+		HTTPClientModifiers: []helper.HTTPClientModifier{r.HTTPClientModifier()},
+	}
+	sdkV2Provider := linode.ProviderWithHTTPClientModifiers(r.HTTPClientModifier())
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"linode": func() (tfprotov6.ProviderServer, error) {
+			return ProtoV6CustomProviderFactories["linode"](frameworkProvider, sdkV2Provider)
+		},
+	}
+}
+
+// Client returns a Linode client whose requests go through this recorder,
+// to be used in test checks.
+func (r *Recorder) Client() *linodego.Client {
+	r.t.Helper()
+
+	token := os.Getenv("LINODE_TOKEN")
+	if token == "" && r.mode != RecorderModeReplay {
+		r.t.Fatal("LINODE_TOKEN must be set for acceptance tests")
+	}
+
+	config := &helper.Config{
+		AccessToken:         token,
+		APIVersion:          cmp.Or(os.Getenv("LINODE_API_VERSION"), "v4beta"),
+		APIURL:              os.Getenv("LINODE_URL"),
+		HTTPClientModifiers: []helper.HTTPClientModifier{r.HTTPClientModifier()},
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		r.t.Fatalf("failed to get client: %s", err)
+	}
+
+	return client
+}
+
+// Value returns the next value with the given name. The value is generated in record mode and
+// when recording is disabled, and is read from the recording in replay mode.
+// This allows tests to use random or API-dependent values (e.g. regions) in their configurations.
+func (r *Recorder) Value(name string, generate func() (string, error)) string {
+	r.t.Helper()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == RecorderModeReplay {
+		index := r.valueIndex[name]
+
+		values := r.recording.Values[name]
+		if index >= len(values) {
+			r.t.Fatalf("no recorded value %q at index %d", name, index)
+		}
+
+		r.valueIndex[name]++
+
+		return values[index]
+	}
+
+	value, err := generate()
+	if err != nil {
+		r.t.Fatalf("failed to generate value %q: %s", name, err)
+	}
+
+	if r.mode == RecorderModeRecord {
+		if r.recording.Values == nil {
+			r.recording.Values = make(map[string][]string)
+		}
+
+		r.recording.Values[name] = append(r.recording.Values[name], value)
+	}
+
+	return value
+}
+
+// RandomWithPrefix returns a random name with the given prefix which is preserved in the recording.
+func (r *Recorder) RandomWithPrefix(prefix string) string {
+	r.t.Helper()
+
+	return r.Value("random_with_prefix:"+prefix, func() (string, error) {
+		return acctest.RandomWithPrefix(prefix), nil
+	})
+}
+
+func (r *Recorder) load() error {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, &r.recording); err != nil {
+		return err
+	}
+
+	r.replayed = make([]bool, len(r.recording.Interactions))
+
+	return nil
+}
+
+func (r *Recorder) save() {
+	if r.t.Failed() {
+		r.t.Logf("Test failed, skipping save of recording %s", r.path)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.recording, "", "  ")
+	if err != nil {
+		r.t.Errorf("failed to marshal recording: %s", err)
+		return
+	}
+
+	if err := writeRecordingFile(r.path, data); err != nil {
+		r.t.Errorf("failed to save recording: %s", err)
+		return
+	}
+
+	// The configurations of recorded tests reference the test images,
+	// so they must be replayed along with the recordings.
+	images, err := json.MarshalIndent(recordedTestImages{
+		Latest:   TestImageLatest,
+		Previous: TestImagePrevious,
+	}, "", "  ")
+	if err != nil {
+		r.t.Errorf("failed to marshal test images: %s", err)
+		return
+	}
+
+	if err := writeRecordingFile(filepath.Join(RecordingsDir, testImagesRecording), images); err != nil {
+		r.t.Errorf("failed to save test images: %s", err)
+	}
+}
+
+// loadRecordedTestImages sets the test images to the ones saved along with the recordings of the package.
+func loadRecordedTestImages() {
+	data, err := os.ReadFile(filepath.Join(RecordingsDir, testImagesRecording))
+	if err != nil {
+		// Packages without recordings have no tests to replay
+		log.Printf("[WARN] failed to load recorded test images: %s", err)
+		return
+	}
+
+	var images recordedTestImages
+
+	if err := json.Unmarshal(data, &images); err != nil {
+		log.Fatalf("failed to parse recorded test images: %s", err)
+	}
+
+	TestImageLatest = images.Latest
+	TestImagePrevious = images.Previous
+}
+
+func writeRecordingFile(path string, data []byte) error {
+	if token := os.Getenv("LINODE_TOKEN"); token != "" {
+		data = bytes.ReplaceAll(data, []byte(token), []byte(redactedValue))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create recordings directory: %w", err)
+	}
+
+	// #nosec G306 -- Recordings are committed to the repository
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func (r *Recorder) record(interaction *recordedInteraction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recording.Interactions = append(r.recording.Interactions, interaction)
+}
+
+// replay returns the recorded response for the given request.
+//
+// Interactions are replayed in the order they were recorded, but the number of
+// GET requests is allowed to differ from the recording, since it depends on the
+// Terraform version and on polling. A GET request is served by the next unreplayed
+// matching interaction preceding the next unreplayed mutating one, and otherwise
+// by the latest matching interaction that was already passed, so that repeated
+// reads return the state of the resource at that point of the test.
+func (r *Recorder) replay(request recordedRequest) (*recordedResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := request.key()
+	interactions := r.recording.Interactions
+
+	if len(r.replayed) != len(interactions) {
+		r.replayed = make([]bool, len(interactions))
+	}
+
+	for i := r.cursor; i < len(interactions); i++ {
+		if r.replayed[i] {
+			continue
+		}
+
+		if interactions[i].Request.key() == key {
+			r.replayed[i] = true
+			r.cursor = max(r.cursor, i+1)
+
+			return &interactions[i].Response, nil
+		}
+
+		if request.Method == http.MethodGet && interactions[i].Request.Method != http.MethodGet {
+			break
+		}
+	}
+
+	if request.Method != http.MethodGet {
+		// Mutating requests of concurrently applied resources may be sent in a different order
+		for i, interaction := range interactions {
+			if !r.replayed[i] && interaction.Request.key() == key {
+				r.replayed[i] = true
+				r.cursor = max(r.cursor, i+1)
+
+				return &interaction.Response, nil
+			}
+		}
+
+		return nil, fmt.Errorf("no unreplayed interaction for request %s %s", request.Method, request.URL)
+	}
+
+	var latest *recordedInteraction
+
+	for i, interaction := range interactions {
+		if interaction.Request.key() != key {
+			continue
+		}
+
+		if latest == nil || i < r.cursor {
+			latest = interaction
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("no recorded interaction for request %s %s", request.Method, request.URL)
+	}
+
+	return &latest.Response, nil
+}
+
+type recorderTransport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+func (t *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := recordedRequest{
+		Method: req.Method,
+		URL:    recordedURL(req.URL),
+		Filter: req.Header.Get("X-Filter"),
+		Body:   scrubRecordedBody(requestBody),
+	}
+
+	if t.recorder.mode == RecorderModeReplay {
+		response, err := t.recorder.replay(request)
+		if err != nil {
+			return nil, err
+		}
+
+		header := make(http.Header)
+		if response.ContentType != "" {
+			header.Set("Content-Type", response.ContentType)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+			StatusCode:    response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(response.Body)),
+			ContentLength: int64(len(response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || t.recorder.mode != RecorderModeRecord {
+		return resp, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to read response body: %w", err), resp.Body.Close())
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	t.recorder.record(&recordedInteraction{
+		Request: request,
+		Response: recordedResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        scrubRecordedBody(responseBody),
+		},
+	})
+
+	return resp, nil
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	if err := req.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// recordedURL returns the path and sorted query of the given URL without the API version,
+// so that recordings can be replayed against any API URL and version.
+func recordedURL(u *url.URL) string {
+	path := u.Path
+
+	if segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2); len(segments) == 2 &&
+		strings.HasPrefix(segments[0], "v4") {
+		path = "/" + segments[1]
+	}
+
+	if query := u.Query().Encode(); query != "" {
+		return path + "?" + query
+	}
+
+	return path
+}
+
+// scrubRecordedBody replaces the values of sensitive keys in a JSON body.
+// Bodies that aren't JSON are recorded as-is.
+func scrubRecordedBody(body []byte) string {
+	if len(body) < 1 {
+		return ""
+	}
+
+	var data any
+
+	if err := json.Unmarshal(body, &data); err != nil {
+		return string(body)
+	}
+
+	result, err := json.Marshal(scrubRecordedValue(data))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(result)
+}
+
+func scrubRecordedValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if _, ok := sensitiveRecordingKeys[strings.ToLower(key)]; ok && item != nil {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = scrubRecordedValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = scrubRecordedValue(item)
+		}
+	}
+
+	return value
+}
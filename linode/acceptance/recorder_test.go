@@ -0,0 +1,168 @@
+//go:build integration || util
+
+package acceptance
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRecorder(t *testing.T, mode RecorderMode) *Recorder {
+	return &Recorder{
+		t:          t,
+		mode:       mode,
+		valueIndex: make(map[string]int),
+	}
+}
+
+func getRecorded(t *testing.T, client *http.Client, url string) (int, string, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, string(body), nil
+}
+
+func TestRecorder_recordReplay(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 123, "token": "secret-token", "status": "pending"}`))
+	}))
+
+	recorder := newTestRecorder(t, RecorderModeRecord)
+
+	recordClient := &http.Client{}
+	require.NoError(t, recorder.HTTPClientModifier()(recordClient))
+
+	status, body, err := getRecorded(t, recordClient, server.URL+"/v4beta/profile/tokens/123?page=1")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status)
+
+	// Live responses are passed through without scrubbing
+	require.Contains(t, body, "secret-token")
+
+	server.Close()
+
+	require.Len(t, recorder.recording.Interactions, 1)
+
+	interaction := recorder.recording.Interactions[0]
+	require.Equal(t, "/profile/tokens/123?page=1", interaction.Request.URL)
+	require.NotContains(t, interaction.Response.Body, "secret-token")
+	require.Contains(t, interaction.Response.Body, redactedValue)
+
+	replayer := newTestRecorder(t, RecorderModeReplay)
+	replayer.recording = recorder.recording
+
+	replayClient := &http.Client{}
+	require.NoError(t, replayer.HTTPClientModifier()(replayClient))
+
+	// Recorded interactions are repeated once exhausted, regardless of the API URL and version
+	for range 2 {
+		status, body, err = getRecorded(t, replayClient, "http://localhost/v4/profile/tokens/123?page=1")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+		require.Contains(t, body, `"status":"pending"`)
+	}
+
+	require.Equal(t, 1, requests)
+
+	_, _, err = getRecorded(t, replayClient, "http://localhost/v4/profile/tokens/456")
+	require.Error(t, err)
+}
+
+func TestRecorder_replayOrder(t *testing.T) {
+	t.Parallel()
+
+	interaction := func(method, url string, statusCode int, body string) *recordedInteraction {
+		return &recordedInteraction{
+			Request:  recordedRequest{Method: method, URL: url},
+			Response: recordedResponse{StatusCode: statusCode, Body: body},
+		}
+	}
+
+	replayer := newTestRecorder(t, RecorderModeReplay)
+	replayer.recording.Interactions = []*recordedInteraction{
+		interaction(http.MethodPost, "/profile/sshkeys", http.StatusOK, "created"),
+		interaction(http.MethodGet, "/profile/sshkeys/1", http.StatusOK, "created"),
+		interaction(http.MethodPut, "/profile/sshkeys/1", http.StatusOK, "updated"),
+		interaction(http.MethodGet, "/profile/sshkeys/1", http.StatusOK, "updated"),
+		interaction(http.MethodDelete, "/profile/sshkeys/1", http.StatusOK, "{}"),
+		interaction(http.MethodGet, "/profile/sshkeys/1", http.StatusNotFound, "deleted"),
+	}
+
+	replayClient := &http.Client{}
+	require.NoError(t, replayer.HTTPClientModifier()(replayClient))
+
+	send := func(method string) (int, string) {
+		req, err := http.NewRequest(method, "http://localhost/v4/profile/sshkeys/1", nil)
+		require.NoError(t, err)
+
+		if method == http.MethodPost {
+			req.URL.Path = "/v4/profile/sshkeys"
+		}
+
+		resp, err := replayClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp.StatusCode, string(body)
+	}
+
+	_, body := send(http.MethodPost)
+	require.Equal(t, "created", body)
+
+	// Reads are replayed any number of times until the next mutating request
+	for range 3 {
+		_, body = send(http.MethodGet)
+		require.Equal(t, "created", body)
+	}
+
+	_, body = send(http.MethodPut)
+	require.Equal(t, "updated", body)
+
+	// Reads may also be skipped
+	_, body = send(http.MethodDelete)
+	require.Equal(t, "{}", body)
+
+	status, body := send(http.MethodGet)
+	require.Equal(t, http.StatusNotFound, status)
+	require.Equal(t, "deleted", body)
+
+	// Mutating requests are only replayed once
+	req, err := http.NewRequest(http.MethodDelete, "http://localhost/v4/profile/sshkeys/1", nil)
+	require.NoError(t, err)
+
+	_, err = replayClient.Do(req)
+	require.Error(t, err)
+}
+
+func TestRecorder_value(t *testing.T) {
+	t.Parallel()
+
+	recorder := newTestRecorder(t, RecorderModeRecord)
+
+	generated := recorder.RandomWithPrefix("tf_test")
+
+	replayer := newTestRecorder(t, RecorderModeReplay)
+	replayer.recording = recorder.recording
+
+	require.Equal(t, generated, replayer.RandomWithPrefix("tf_test"))
+}
@@ -75,6 +75,11 @@ func initOptInTests() {
 
 // initTestImages grabs the latest Linode Alpine images for acceptance test configurations
 func initTestImages() {
+	if GetRecorderMode() == RecorderModeReplay {
+		loadRecordedTestImages()
+		return
+	}
+
 	client, err := GetTestClient()
 	if err != nil {
 		log.Fatalf("failed to get client: %s", err)
@@ -156,6 +161,14 @@ func TestProvider(t *testing.T) {
 func PreCheck(t testing.TB) {
 	t.Helper()
 
+	if GetRecorderMode() == RecorderModeReplay {
+		if _, ok := recorders.Load(t.Name()); !ok {
+			t.Skip("skipping test without recording support in replay mode")
+		}
+
+		return
+	}
+
 	if v := os.Getenv("LINODE_TOKEN"); v == "" {
 		t.Fatal("LINODE_TOKEN must be set for acceptance tests")
 	}
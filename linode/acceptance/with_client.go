@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"context"
+	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/linode/linodego"
@@ -23,6 +24,23 @@ func NewFrameworkProviderWithClient(
 	}
 }
 
+// GetTestClientFor returns a Linode client for the checks of the given test.
+// If LINODE_RECORDER_MODE is set, the requests of the client go through the Recorder of the test.
+func GetTestClientFor(t testing.TB) *linodego.Client {
+	t.Helper()
+
+	if GetRecorderMode() != RecorderModeDisabled {
+		return NewRecorder(t).Client()
+	}
+
+	client, err := GetTestClient()
+	if err != nil {
+		t.Fatalf("failed to get client: %s", err)
+	}
+
+	return client
+}
+
 func (fp *FrameworkProviderWithClient) Configure(
 	ctx context.Context,
 	req provider.ConfigureRequest,
@@ -0,0 +1,142 @@
+package cloudinitmultipart
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// boundary is fixed so that the same parts always produce the same user data.
+const boundary = "MIMEBOUNDARY"
+
+var _ function.Function = &Function{}
+
+var partObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"content_type": types.StringType,
+		"content":      types.StringType,
+	},
+}
+
+type PartModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Content     types.String `tfsdk:"content"`
+}
+
+func NewFunction() function.Function {
+	return &Function{}
+}
+
+type Function struct{}
+
+func (f *Function) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "cloudinit_multipart"
+}
+
+func (f *Function) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Assembles base64-encoded MIME multipart user data from cloud-init parts.",
+		Description: "Assembles the given parts into a MIME multipart message processed by cloud-init " +
+			"and returns it base64-encoded for use as the `metadata.user_data` of a `linode_instance`.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name: "parts",
+				Description: "The parts of the user data, each with a `content_type` " +
+					"(e.g. `text/cloud-config` or `text/x-shellscript`) and a `content`.",
+				ElementType: partObjectType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Function) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var parts []PartModel
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	userData, err := buildMultipart(parts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(
+		resp.Result.Set(ctx, base64.StdEncoding.EncodeToString(userData)),
+	)
+}
+
+// buildMultipart assembles the given parts into a MIME multipart message.
+func buildMultipart(parts []PartModel) ([]byte, error) {
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("at least one part must be specified")
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\r\n", boundary))
+	buf.WriteString("MIME-Version: 1.0\r\n\r\n")
+
+	writer := multipart.NewWriter(&buf)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	for i, part := range parts {
+		if part.ContentType.IsNull() || part.Content.IsNull() {
+			return nil, fmt.Errorf("part %d must have a content_type and a content", i)
+		}
+
+		if _, _, err := mime.ParseMediaType(part.ContentType.ValueString()); err != nil {
+			return nil, fmt.Errorf("part %d has an invalid content_type: %w", i, err)
+		}
+
+		content := part.Content.ValueString()
+		if strings.Contains(content, "--"+boundary) {
+			return nil, fmt.Errorf("part %d must not contain the MIME boundary %q", i, boundary)
+		}
+
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.ContentType.ValueString()},
+			"Content-Transfer-Encoding": {"7bit"},
+			"Mime-Version":              {"1.0"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := partWriter.Write([]byte(content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,73 @@
+//go:build unit
+
+package cloudinitmultipart
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMultipart(t *testing.T) {
+	parts := []PartModel{
+		{
+			ContentType: types.StringValue("text/cloud-config"),
+			Content:     types.StringValue("#cloud-config\npackages:\n  - nginx\n"),
+		},
+		{
+			ContentType: types.StringValue("text/x-shellscript"),
+			Content:     types.StringValue("#!/bin/bash\necho hello\n"),
+		},
+	}
+
+	result, err := buildMultipart(parts)
+	require.NoError(t, err)
+
+	// The result must be stable to avoid diffs between plans
+	again, err := buildMultipart(parts)
+	require.NoError(t, err)
+	require.Equal(t, result, again)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(result))
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	for _, expected := range parts {
+		part, err := reader.NextPart()
+		require.NoError(t, err)
+		require.Equal(t, expected.ContentType.ValueString(), part.Header.Get("Content-Type"))
+
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+		require.Equal(t, expected.Content.ValueString(), string(content))
+	}
+
+	_, err = reader.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestBuildMultipart_invalid(t *testing.T) {
+	_, err := buildMultipart(nil)
+	require.ErrorContains(t, err, "at least one part")
+
+	_, err = buildMultipart([]PartModel{
+		{ContentType: types.StringValue("not a content type;;"), Content: types.StringValue("")},
+	})
+	require.ErrorContains(t, err, "invalid content_type")
+
+	_, err = buildMultipart([]PartModel{
+		{ContentType: types.StringValue("text/plain"), Content: types.StringValue("--" + boundary)},
+	})
+	require.ErrorContains(t, err, "must not contain the MIME boundary")
+}
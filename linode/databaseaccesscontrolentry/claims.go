@@ -0,0 +1,69 @@
+package databaseaccesscontrolentry
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// allowListClaims tracks the allow list entries claimed by each access control
+// entry of a database, so entries that are still claimed by another access
+// control entry are not removed from the database allow list.
+//
+// Claims are only known for access control entries that have been read,
+// created or updated by this provider process.
+type allowListClaims struct {
+	mu     sync.Mutex
+	claims map[string]map[string][]string
+}
+
+var claims = &allowListClaims{}
+
+// databaseKey returns the key identifying the given database.
+func databaseKey(dbType string, dbID int) string {
+	return fmt.Sprintf("%s:%d", dbType, dbID)
+}
+
+// Set records the allow list entries claimed by the access control entry with the given label.
+func (c *allowListClaims) Set(dbType string, dbID int, label string, entries []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.claims == nil {
+		c.claims = make(map[string]map[string][]string)
+	}
+
+	key := databaseKey(dbType, dbID)
+	if c.claims[key] == nil {
+		c.claims[key] = make(map[string][]string)
+	}
+
+	c.claims[key][label] = slices.Clone(entries)
+}
+
+// Remove forgets the claims of the access control entry with the given label.
+func (c *allowListClaims) Remove(dbType string, dbID int, label string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.claims[databaseKey(dbType, dbID)], label)
+}
+
+// Unclaimed returns the given entries that are not claimed by any access control
+// entry other than the one with the given label.
+func (c *allowListClaims) Unclaimed(dbType string, dbID int, label string, entries []string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	others := c.claims[databaseKey(dbType, dbID)]
+
+	return slices.DeleteFunc(slices.Clone(entries), func(entry string) bool {
+		for otherLabel, otherEntries := range others {
+			if otherLabel != label && slices.Contains(otherEntries, entry) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,108 @@
+package databaseaccesscontrolentry
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+type ResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	DatabaseID   types.Int64    `tfsdk:"database_id"`
+	DatabaseType types.String   `tfsdk:"database_type"`
+	Label        types.String   `tfsdk:"label"`
+	AllowList    types.Set      `tfsdk:"allow_list"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Flatten stores the entries of this resource that are still present in the
+// given database allow list.
+func (m *ResourceModel) Flatten(
+	ctx context.Context,
+	dbAllowList []string,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	dbID := helper.FrameworkSafeInt64ToInt(m.DatabaseID.ValueInt64(), diags)
+	if diags.HasError() {
+		return
+	}
+
+	m.ID = helper.KeepOrUpdateString(
+		m.ID,
+		buildID(dbID, m.DatabaseType.ValueString(), m.Label.ValueString()),
+		preserveKnown,
+	)
+
+	entries := intersectAllowList(m.GetAllowList(ctx, diags), dbAllowList)
+
+	allowList, d := types.SetValueFrom(ctx, types.StringType, entries)
+	diags.Append(d...)
+
+	m.AllowList = helper.KeepOrUpdateValue(m.AllowList, allowList, preserveKnown)
+}
+
+func (m *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	m.ID = helper.KeepOrUpdateValue(m.ID, other.ID, preserveKnown)
+	m.DatabaseID = helper.KeepOrUpdateValue(m.DatabaseID, other.DatabaseID, preserveKnown)
+	m.DatabaseType = helper.KeepOrUpdateValue(m.DatabaseType, other.DatabaseType, preserveKnown)
+	m.Label = helper.KeepOrUpdateValue(m.Label, other.Label, preserveKnown)
+	m.AllowList = helper.KeepOrUpdateValue(m.AllowList, other.AllowList, preserveKnown)
+}
+
+// GetAllowList returns the allow list entries managed by this resource.
+func (m *ResourceModel) GetAllowList(ctx context.Context, diags *diag.Diagnostics) []string {
+	if m.AllowList.IsNull() || m.AllowList.IsUnknown() {
+		return nil
+	}
+
+	var result []string
+	diags.Append(m.AllowList.ElementsAs(ctx, &result, false)...)
+
+	return result
+}
+
+// buildID returns the ID of an access control entry, which uses
+// the same format as the import ID.
+func buildID(dbID int, dbType, label string) string {
+	return fmt.Sprintf("%d,%s,%s", dbID, dbType, label)
+}
+
+// mergeAllowList returns the database allow list with the entries in remove
+// dropped and the entries in add appended, preserving the order of existing
+// entries.
+func mergeAllowList(current, remove, add []string) []string {
+	result := slices.DeleteFunc(slices.Clone(current), func(entry string) bool {
+		return slices.Contains(remove, entry) && !slices.Contains(add, entry)
+	})
+
+	for _, entry := range add {
+		if !slices.Contains(result, entry) {
+			result = append(result, entry)
+		}
+	}
+
+	if result == nil {
+		result = []string{}
+	}
+
+	return result
+}
+
+// intersectAllowList returns the entries that are present in the database allow list.
+func intersectAllowList(entries, dbAllowList []string) []string {
+	result := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if slices.Contains(dbAllowList, entry) {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
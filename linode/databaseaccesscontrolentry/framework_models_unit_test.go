@@ -0,0 +1,138 @@
+//go:build unit
+
+package databaseaccesscontrolentry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeAllowList(t *testing.T) {
+	current := []string{"10.0.0.0/24", "192.168.0.1/32", "172.16.0.0/16"}
+
+	// Entries managed elsewhere are preserved
+	require.Equal(
+		t,
+		[]string{"10.0.0.0/24", "172.16.0.0/16", "192.168.0.2/32"},
+		mergeAllowList(current, []string{"192.168.0.1/32"}, []string{"192.168.0.2/32"}),
+	)
+
+	// Entries that are both removed and added are kept in place
+	require.Equal(
+		t,
+		current,
+		mergeAllowList(current, []string{"10.0.0.0/24"}, []string{"10.0.0.0/24"}),
+	)
+
+	// Removing all entries results in an empty allow list
+	require.Equal(
+		t,
+		[]string{},
+		mergeAllowList([]string{"10.0.0.0/24"}, []string{"10.0.0.0/24"}, nil),
+	)
+}
+
+func TestIntersectAllowList(t *testing.T) {
+	require.Equal(
+		t,
+		[]string{"10.0.0.0/24"},
+		intersectAllowList(
+			[]string{"10.0.0.0/24", "192.168.0.1/32"},
+			[]string{"172.16.0.0/16", "10.0.0.0/24"},
+		),
+	)
+}
+
+func TestAllowListClaims(t *testing.T) {
+	var c allowListClaims
+
+	c.Set("mysql", 123, "app", []string{"10.0.0.0/24", "192.168.0.1/32"})
+	c.Set("mysql", 123, "platform", []string{"10.0.0.0/24"})
+	c.Set("postgresql", 123, "other", []string{"192.168.0.1/32"})
+
+	// Entries claimed by other access control entries of the same database are kept
+	require.Equal(
+		t,
+		[]string{"192.168.0.1/32"},
+		c.Unclaimed("mysql", 123, "app", []string{"10.0.0.0/24", "192.168.0.1/32"}),
+	)
+
+	// An access control entry's own claims don't keep its entries
+	require.Empty(t, c.Unclaimed("mysql", 123, "platform", []string{"10.0.0.0/24"}))
+
+	c.Remove("mysql", 123, "platform")
+
+	require.Equal(
+		t,
+		[]string{"10.0.0.0/24"},
+		c.Unclaimed("mysql", 123, "app", []string{"10.0.0.0/24"}),
+	)
+}
+
+func TestBuildID(t *testing.T) {
+	// IDs use the same format as import IDs
+	require.Equal(t, "123,postgresql,app", buildID(123, "postgresql", "app"))
+}
+
+func TestImportStateRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	r := NewResource().(resource.ResourceWithImportState)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for _, label := range []string{"platform", "app-2_v1.0"} {
+		t.Run(label, func(t *testing.T) {
+			id := buildID(123, "mysql", label)
+
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var state ResourceModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+
+			require.Equal(t, id, state.ID.ValueString())
+			require.Equal(t, int64(123), state.DatabaseID.ValueInt64())
+			require.Equal(t, "mysql", state.DatabaseType.ValueString())
+			require.Equal(t, label, state.Label.ValueString())
+		})
+	}
+}
+
+func TestLabelValidators(t *testing.T) {
+	ctx := context.Background()
+	validators := frameworkResourceSchema.Attributes["label"].(schema.StringAttribute).Validators
+
+	testCases := map[string]bool{
+		"platform":   false,
+		"app-2_v1.0": false,
+		"":           true,
+		"app,web":    true,
+		"my app":     true,
+		"app\tweb":   true,
+	}
+
+	for label, expectError := range testCases {
+		resp := validator.StringResponse{}
+		for _, v := range validators {
+			v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue(label)}, &resp)
+		}
+
+		require.Equal(t, expectError, resp.Diagnostics.HasError(), "label %q", label)
+	}
+}
@@ -0,0 +1,449 @@
+package databaseaccesscontrolentry
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+const (
+	DefaultCreateTimeout = 30 * time.Minute
+	DefaultUpdateTimeout = 30 * time.Minute
+	DefaultDeleteTimeout = 30 * time.Minute
+)
+
+// databaseLocks serializes allow list updates to the same database so
+// entries managed by different resources don't overwrite each other.
+var databaseLocks helper.KeyedMutex
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_database_access_control_entry",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, d := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	dbID := helper.FrameworkSafeInt64ToInt(plan.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbType := plan.DatabaseType.ValueString()
+	ctx = populateLogAttributes(ctx, dbType, dbID, plan.Label.ValueString())
+
+	entries := plan.GetAllowList(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbAllowList, err := updateAllowList(ctx, r.Meta.Client, dbType, dbID, nil, entries, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to add allow list entries to database %d", dbID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Flatten(ctx, dbAllowList, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claims.Set(dbType, dbID, plan.Label.ValueString(), entries)
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(buildID(dbID, dbType, plan.Label.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	dbID := helper.FrameworkSafeInt64ToInt(state.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbType := state.DatabaseType.ValueString()
+	ctx = populateLogAttributes(ctx, dbType, dbID, state.Label.ValueString())
+
+	dbAllowList, err := getDBAllowList(ctx, r.Meta.Client, dbType, dbID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Database No Longer Exists",
+				fmt.Sprintf(
+					"Removing access control entry %s from state because the "+
+						"target database no longer exists",
+					state.ID.String(),
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get allow list for database %d", dbID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Imported entries don't know which allow list entries they own yet,
+	// so they will be populated from the configuration on the next apply.
+	if state.AllowList.IsNull() {
+		state.AllowList = types.SetValueMust(types.StringType, nil)
+	} else if len(state.AllowList.Elements()) > 0 &&
+		len(intersectAllowList(state.GetAllowList(ctx, &resp.Diagnostics), dbAllowList)) < 1 {
+		resp.Diagnostics.AddWarning(
+			"Marking Access Control Entry for Recreation",
+			fmt.Sprintf(
+				"None of the entries managed by %s are in the allow list of database %d anymore.",
+				state.ID.ValueString(),
+				dbID,
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Flatten(ctx, dbAllowList, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claims.Set(dbType, dbID, state.Label.ValueString(), state.GetAllowList(ctx, &resp.Diagnostics))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, d := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	dbID := helper.FrameworkSafeInt64ToInt(state.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbType := state.DatabaseType.ValueString()
+	ctx = populateLogAttributes(ctx, dbType, dbID, state.Label.ValueString())
+
+	if !state.AllowList.Equal(plan.AllowList) {
+		oldEntries := state.GetAllowList(ctx, &resp.Diagnostics)
+		newEntries := plan.GetAllowList(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		label := state.Label.ValueString()
+
+		// Entries still claimed by other access control entries are kept
+		dbAllowList, err := updateAllowList(
+			ctx,
+			r.Meta.Client,
+			dbType,
+			dbID,
+			claims.Unclaimed(dbType, dbID, label, oldEntries),
+			newEntries,
+			updateTimeout,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to update allow list entries of database %d", dbID),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.Flatten(ctx, dbAllowList, true, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		claims.Set(dbType, dbID, label, newEntries)
+	}
+
+	plan.CopyFrom(state, true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, d := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	dbID := helper.FrameworkSafeInt64ToInt(state.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbType := state.DatabaseType.ValueString()
+	ctx = populateLogAttributes(ctx, dbType, dbID, state.Label.ValueString())
+
+	label := state.Label.ValueString()
+
+	// Entries still claimed by other access control entries are kept
+	entries := claims.Unclaimed(dbType, dbID, label, state.GetAllowList(ctx, &resp.Diagnostics))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claims.Remove(dbType, dbID, label)
+
+	if _, err := updateAllowList(ctx, r.Meta.Client, dbType, dbID, entries, nil, deleteTimeout); err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Database No Longer Exists",
+				fmt.Sprintf("Database %d was not found; assuming its allow list entries were removed.", dbID),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to remove allow list entries from database %d", dbID),
+			err.Error(),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+
+	helper.ImportStateWithMultipleIDs(
+		ctx,
+		req,
+		resp,
+		[]helper.ImportableID{
+			{
+				Name:          "database_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "database_type",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+			{
+				Name:          "label",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We need to manually set the ID in state
+	// because it is not implicitly populated by one of the
+	// ID attributes above
+	var state ResourceModel
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbID := helper.FrameworkSafeInt64ToInt(state.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	state.ID = types.StringValue(buildID(dbID, state.DatabaseType.ValueString(), state.Label.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// updateAllowList replaces the entries in remove with the entries in add
+// in the allow list of the given database and returns the resulting allow list.
+func updateAllowList(
+	ctx context.Context,
+	client *linodego.Client,
+	dbType string,
+	dbID int,
+	remove, add []string,
+	timeout time.Duration,
+) ([]string, error) {
+	unlock := databaseLocks.Lock(databaseKey(dbType, dbID))
+	defer unlock()
+
+	// Always read the current allow list while holding the lock
+	// so entries managed elsewhere are preserved.
+	current, err := getDBAllowList(ctx, client, dbType, dbID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get allow list: %w", err)
+	}
+
+	desired := mergeAllowList(current, remove, add)
+	if slices.Equal(current, desired) {
+		tflog.Debug(ctx, "Allow list is already up to date")
+		return current, nil
+	}
+
+	updatePoller, err := client.NewEventPoller(ctx, dbID, linodego.EntityDatabase, linodego.ActionDatabaseUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create update EventPoller: %w", err)
+	}
+
+	tflog.Debug(ctx, "Updating database allow list", map[string]any{
+		"allow_list": desired,
+	})
+
+	switch dbType {
+	case "mysql":
+		_, err = client.UpdateMySQLDatabase(ctx, dbID, linodego.MySQLUpdateOptions{AllowList: &desired})
+	case "postgresql":
+		_, err = client.UpdatePostgresDatabase(ctx, dbID, linodego.PostgresUpdateOptions{AllowList: &desired})
+	default:
+		err = fmt.Errorf("invalid database type: %s", dbType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutSeconds, err := helper.SafeFloat64ToInt(timeout.Seconds())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := updatePoller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for update event completion: %w", err)
+	}
+
+	return desired, nil
+}
+
+func getDBAllowList(ctx context.Context, client *linodego.Client, dbType string, dbID int) ([]string, error) {
+	switch dbType {
+	case "mysql":
+		tflog.Trace(ctx, "client.GetMySQLDatabase(...)")
+
+		db, err := client.GetMySQLDatabase(ctx, dbID)
+		if err != nil {
+			return nil, err
+		}
+
+		return db.AllowList, nil
+	case "postgresql":
+		tflog.Trace(ctx, "client.GetPostgresDatabase(...)")
+
+		db, err := client.GetPostgresDatabase(ctx, dbID)
+		if err != nil {
+			return nil, err
+		}
+
+		return db.AllowList, nil
+	}
+
+	return nil, fmt.Errorf("invalid database type: %s", dbType)
+}
+
+func populateLogAttributes(ctx context.Context, dbType string, dbID int, label string) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"database_type": dbType,
+		"database_id":   dbID,
+		"label":         label,
+	})
+}
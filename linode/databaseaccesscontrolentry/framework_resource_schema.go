@@ -0,0 +1,67 @@
+package databaseaccesscontrolentry
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique ID of this access control entry.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.Int64Attribute{
+			Description: "The ID of the database to add the allow list entries to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"database_type": schema.StringAttribute{
+			Description: "The type of the database to add the allow list entries to.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(databaseshared.ValidDatabaseTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "A label identifying this set of allow list entries, e.g. the module that owns them.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				// The label is part of the comma-separated import ID, which can't contain whitespace
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^[^,\s]+$`), "must not contain commas or whitespace",
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"allow_list": schema.SetAttribute{
+			Description: "The IP addresses or CIDR ranges to add to the allow list of the database. " +
+				"Entries not managed by this resource are left untouched.",
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+	},
+}
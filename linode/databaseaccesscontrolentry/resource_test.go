@@ -0,0 +1,164 @@
+//go:build integration || databaseaccesscontrolentry || dbaas_tests
+
+package databaseaccesscontrolentry_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/databaseaccesscontrolentry/tmpl"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared"
+)
+
+var (
+	mysqlEngineVersion    string
+	postgresEngineVersion string
+	testRegion            string
+)
+
+func init() {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err)
+	}
+
+	v, err := databaseshared.ResolveValidDBEngine(context.Background(), *client, "mysql")
+	if err != nil {
+		log.Fatalf("failed to get db engine version: %s", err)
+	}
+
+	mysqlEngineVersion = v.ID
+
+	v, err = databaseshared.ResolveValidDBEngine(context.Background(), *client, "postgresql")
+	if err != nil {
+		log.Fatalf("failed to get db engine version: %s", err)
+	}
+
+	postgresEngineVersion = v.ID
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{linodego.CapabilityDBAAS}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceDatabaseAccessControlEntry_PostgreSQL(t *testing.T) {
+	t.Parallel()
+
+	testAccessControlEntries(t, "postgresql", postgresEngineVersion, tmpl.PostgreSQL)
+}
+
+func TestAccResourceDatabaseAccessControlEntry_MySQL(t *testing.T) {
+	t.Parallel()
+
+	testAccessControlEntries(t, "mysql", mysqlEngineVersion, tmpl.MySQL)
+}
+
+func testAccessControlEntries(
+	t *testing.T,
+	dbType, engine string,
+	config func(testing.TB, tmpl.TemplateData) string,
+) {
+	dbName := fmt.Sprintf("linode_database_%s_v2.foobar", dbType)
+	platformName := "linode_database_access_control_entry.platform"
+	appName := "linode_database_access_control_entry.app"
+
+	data := tmpl.TemplateData{
+		Engine:     engine,
+		Label:      acctest.RandomWithPrefix("tf_test"),
+		Region:     testRegion,
+		PlatformIP: "10.0.0.0/24",
+		AppIP:      "192.168.0.25/32",
+		IncludeApp: true,
+	}
+
+	withoutApp := data
+	withoutApp.IncludeApp = false
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(t, data),
+				Check: resource.ComposeTestCheckFunc(
+					checkAllowList(dbName, dbType, data.PlatformIP, data.AppIP),
+					resource.TestCheckResourceAttr(platformName, "label", "platform"),
+					resource.TestCheckResourceAttr(platformName, "allow_list.#", "1"),
+					resource.TestCheckResourceAttr(platformName, "allow_list.0", data.PlatformIP),
+					resource.TestCheckResourceAttr(appName, "label", "app"),
+					resource.TestCheckResourceAttr(appName, "allow_list.#", "1"),
+					resource.TestCheckResourceAttr(appName, "allow_list.0", data.AppIP),
+				),
+			},
+			{
+				// Removing the app entries must not affect the platform entries
+				Config: config(t, withoutApp),
+				Check: resource.ComposeTestCheckFunc(
+					checkAllowList(dbName, dbType, data.PlatformIP),
+					resource.TestCheckResourceAttr(platformName, "allow_list.#", "1"),
+				),
+			},
+			{
+				ResourceName:            platformName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_list"},
+			},
+		},
+	})
+}
+
+func checkAllowList(dbName, dbType string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[dbName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", dbName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var allowList []string
+
+		switch dbType {
+		case "mysql":
+			db, err := client.GetMySQLDatabase(context.Background(), id)
+			if err != nil {
+				return err
+			}
+			allowList = db.AllowList
+		case "postgresql":
+			db, err := client.GetPostgresDatabase(context.Background(), id)
+			if err != nil {
+				return err
+			}
+			allowList = db.AllowList
+		}
+
+		slices.Sort(allowList)
+		slices.Sort(expected)
+
+		if !slices.Equal(allowList, expected) {
+			return fmt.Errorf("expected allow list %v, got %v", expected, allowList)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,26 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	Engine     string
+	Label      string
+	Region     string
+	PlatformIP string
+	AppIP      string
+	IncludeApp bool
+}
+
+func PostgreSQL(t testing.TB, data TemplateData) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_access_control_entry_postgresql", data)
+}
+
+func MySQL(t testing.TB, data TemplateData) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_access_control_entry_mysql", data)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
 )
 
 var frameworkDatasourceSchema = schema.Schema{
@@ -131,6 +132,10 @@ var frameworkDatasourceSchema = schema.Schema{
 			Description: "The Managed Database engine version.",
 			Computed:    true,
 		},
+		"engine_config": engineconfig.DataSourceAttribute(
+			"The engine settings of the Managed Database.",
+			engineconfig.MySQLSettings,
+		),
 		"engine_config_binlog_retention_period": schema.Int64Attribute{
 			Computed:    true,
 			Description: "The minimum amount of time in seconds to keep binlog entries before deletion. This may be extended for services that require binlog entries for longer than the default for example if using the MySQL Debezium Kafka connector.",
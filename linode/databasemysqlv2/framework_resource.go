@@ -15,6 +15,7 @@ import (
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
 )
 
 const (
@@ -44,6 +45,49 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		engineconfig.ConflictsWithLegacyAttributes(),
+	}
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	engineconfig.ReconcileLegacyPlan(ctx, req, resp)
+	databaseshared.PlanApplyPendingUpdates(ctx, req, resp)
+
+	// Validate the restore time against the restore window of the source database
+	if req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() && r.Meta != nil {
+		var plan ResourceModel
+
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		databaseshared.ValidateForkRestoreTime(
+			ctx,
+			r.Meta.Client,
+			linodego.DatabaseEngineTypeMySQL,
+			plan.ForkSource,
+			plan.ForkRestoreTime,
+			&resp.Diagnostics,
+		)
+
+		helper.ValidateRegionRequirements(
+			ctx,
+			r.Meta.Client,
+			path.Root("region"),
+			plan.Region,
+			helper.RegionRequirements{Capabilities: []string{linodego.CapabilityDBAAS}},
+			&resp.Diagnostics,
+		)
+	}
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -75,14 +119,23 @@ func (r *Resource) Create(
 	}
 
 	createOpts := linodego.MySQLCreateOptions{
-		Label:        data.Label.ValueString(),
-		Region:       data.Region.ValueString(),
-		Type:         data.Type.ValueString(),
-		Engine:       data.EngineID.ValueString(),
-		ClusterSize:  helper.FrameworkSafeInt64ToInt(data.ClusterSize.ValueInt64(), &resp.Diagnostics),
-		Fork:         data.GetFork(resp.Diagnostics),
-		AllowList:    data.GetAllowList(ctx, resp.Diagnostics),
-		EngineConfig: data.GetEngineConfig(resp.Diagnostics),
+		Label:       data.Label.ValueString(),
+		Region:      data.Region.ValueString(),
+		Type:        data.Type.ValueString(),
+		Engine:      data.EngineID.ValueString(),
+		ClusterSize: helper.FrameworkSafeInt64ToInt(data.ClusterSize.ValueInt64(), &resp.Diagnostics),
+		Fork:        data.GetFork(resp.Diagnostics),
+		AllowList:   data.GetAllowList(ctx, resp.Diagnostics),
+	}
+
+	// engine_config is sent as-is rather than through createOpts
+	// so settings unknown to linodego can be configured
+	var engineConfig map[string]any
+
+	if engineconfig.IsConfigured(data.EngineConfig) {
+		engineConfig = data.GetNestedEngineConfig()
+	} else {
+		createOpts.EngineConfig = data.GetEngineConfig(resp.Diagnostics)
 	}
 
 	if privateNetwork != nil {
@@ -102,11 +155,18 @@ func (r *Resource) Create(
 		return
 	}
 
-	tflog.Debug(ctx, "client.CreateMySQLDatabase(...)", map[string]any{
-		"options": createOpts,
+	tflog.Debug(ctx, "engineconfig.CreateDatabase(...)", map[string]any{
+		"options":       createOpts,
+		"engine_config": engineConfig,
 	})
 
-	db, err := client.CreateMySQLDatabase(ctx, createOpts)
+	db, err := engineconfig.CreateDatabase[linodego.MySQLDatabase](
+		ctx,
+		client,
+		linodego.DatabaseEngineTypeMySQL,
+		createOpts,
+		engineConfig,
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create MySQL database",
@@ -217,6 +277,8 @@ func (r *Resource) Create(
 	// TODO: Remove when Crossplane empty string ID issue is resolved
 	data.ID = types.StringValue(strconv.Itoa(db.ID))
 
+	data.SetResourceDefaults()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -268,11 +330,16 @@ func (r *Resource) Read(
 		return
 	}
 
+	forkSource, forkRestoreTime := data.ForkSource, data.ForkRestoreTime
+
 	resp.Diagnostics.Append(data.Refresh(ctx, client, db.ID, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data.PreserveDetachedFork(forkSource, forkRestoreTime)
+	data.SetResourceDefaults()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -419,7 +486,14 @@ func (r *Resource) Update(
 		!helper.FrameworkValuesShallowEqual(state.EngineConfigMySQLTmpTableSize, plan.EngineConfigMySQLTmpTableSize),
 		!helper.FrameworkValuesShallowEqual(state.EngineConfigMySQLWaitTimeout, plan.EngineConfigMySQLWaitTimeout),
 	}
-	if slices.Contains(engineConfigFields, true) {
+	// engine_config is sent as-is rather than through updateOpts
+	// so settings unknown to linodego can be configured
+	var nestedEngineConfig map[string]any
+
+	if engineconfig.Changed(state.EngineConfig, plan.EngineConfig) {
+		shouldUpdate = true
+		nestedEngineConfig = plan.GetNestedEngineConfig()
+	} else if slices.Contains(engineConfigFields, true) {
 		shouldUpdate = true
 		engineConfig := plan.GetEngineConfig(resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
@@ -487,10 +561,18 @@ func (r *Resource) Update(
 			}
 		}
 
-		tflog.Debug(ctx, "client.UpdateMySQLDatabase(...)", map[string]any{
-			"options": updateOpts,
+		tflog.Debug(ctx, "engineconfig.UpdateDatabase(...)", map[string]any{
+			"options":       updateOpts,
+			"engine_config": nestedEngineConfig,
 		})
-		if _, err := client.UpdateMySQLDatabase(ctx, id, updateOpts); err != nil {
+		if _, err := engineconfig.UpdateDatabase[linodego.MySQLDatabase](
+			ctx,
+			client,
+			linodego.DatabaseEngineTypeMySQL,
+			id,
+			updateOpts,
+			nestedEngineConfig,
+		); err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to update database",
 				err.Error(),
@@ -524,11 +606,42 @@ func (r *Resource) Update(
 		}
 	}
 
+	// Pending updates are only marked as unknown by ModifyPlan
+	// when apply_pending_updates is enabled and updates are pending
+	if plan.ApplyPendingUpdates.ValueBool() && plan.PendingUpdates.IsUnknown() {
+		applied, err := databaseshared.ApplyPendingUpdatesSync(
+			ctx,
+			client,
+			id,
+			linodego.DatabaseEngineTypeMySQL,
+			updateTimeout,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to apply pending database updates",
+				err.Error(),
+			)
+			return
+		}
+
+		appliedUpdates, d := databaseshared.FlattenPendingUpdates(ctx, applied)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.AppliedUpdates = appliedUpdates
+	}
+
+	forkSource, forkRestoreTime := plan.ForkSource, plan.ForkRestoreTime
+
 	resp.Diagnostics.Append(plan.Refresh(ctx, client, id, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	plan.PreserveDetachedFork(forkSource, forkRestoreTime)
+
 	plan.CopyFrom(&state.Model, true)
 
 	// Workaround for Crossplane issue where ID is not
@@ -538,6 +651,8 @@ func (r *Resource) Update(
 		plan.ID = state.ID
 	}
 
+	plan.SetResourceDefaults()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -125,6 +125,8 @@ func TestAccResourceDatabaseMysqlV2_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "updates.hour_of_day"),
 
 					resource.TestCheckResourceAttr(resName, "pending_updates.#", "0"),
+					resource.TestCheckResourceAttr(resName, "apply_pending_updates", "false"),
+					resource.TestCheckResourceAttr(resName, "applied_updates.#", "0"),
 
 					resource.TestCheckResourceAttr(
 						resName,
@@ -418,6 +420,7 @@ func TestAccResourceDatabaseMysqlV2_fork(t *testing.T) {
 	resNameFork := "linode_database_mysql_v2.fork"
 
 	var dbSource linodego.MySQLDatabase
+	var forkID string
 
 	label := acctest.RandomWithPrefix("tf_test")
 
@@ -517,6 +520,10 @@ func TestAccResourceDatabaseMysqlV2_fork(t *testing.T) {
 					resource.TestCheckResourceAttr(resNameFork, "engine_id", testEngine),
 					resource.TestCheckResourceAttrSet(resNameFork, "fork_restore_time"),
 					resource.TestCheckResourceAttrSet(resNameFork, "fork_source"),
+					resource.TestCheckResourceAttrWith(resNameFork, "id", func(value string) error {
+						forkID = value
+						return nil
+					}),
 					resource.TestCheckResourceAttrSet(resNameFork, "host_primary"),
 					resource.TestCheckResourceAttr(resNameFork, "label", label+"-fork"),
 					resource.TestCheckResourceAttrSet(resNameFork, "members.%"),
@@ -548,6 +555,19 @@ func TestAccResourceDatabaseMysqlV2_fork(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"updated", "oldest_restore_time", "members"},
 			},
+			{
+				// Detaching the fork must not force a new resource
+				Config: tmpl.ForkDetached(t, label, testRegion, testEngine, "g6-nanode-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNameFork, "fork_detached", "true"),
+					resource.TestCheckResourceAttrWith(resNameFork, "id", func(value string) error {
+						if value != forkID {
+							return fmt.Errorf("expected fork %s to be kept, got %s", forkID, value)
+						}
+						return nil
+					}),
+				),
+			},
 		},
 	})
 }
@@ -867,6 +887,60 @@ func TestAccResourceDatabaseMysqlV2_engineConfig(t *testing.T) {
 	})
 }
 
+func TestAccResourceDatabaseMysqlV2_engineConfigNested(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_database_mysql_v2.foobar"
+	label := acctest.RandomWithPrefix("tf_test")
+
+	data := tmpl.TemplateDataNestedEngineConfig{
+		Label:                 label,
+		Region:                testRegion,
+		EngineID:              testEngine,
+		Type:                  "g6-nanode-1",
+		BinlogRetentionPeriod: 3600,
+		WaitTimeout:           3600,
+	}
+
+	updatedData := data
+	updatedData.BinlogRetentionPeriod = 7200
+	updatedData.WaitTimeout = 7200
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckMySQLDatabaseV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.EngineConfigNested(t, data),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckMySQLDatabaseExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "engine_config.binlog_retention_period", "3600"),
+					resource.TestCheckResourceAttr(resName, "engine_config.mysql.wait_timeout", "3600"),
+					resource.TestCheckResourceAttr(resName, "engine_config_binlog_retention_period", "3600"),
+					resource.TestCheckResourceAttr(resName, "engine_config_mysql_wait_timeout", "3600"),
+				),
+			},
+			{
+				Config: tmpl.EngineConfigNested(t, updatedData),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckMySQLDatabaseExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "engine_config.binlog_retention_period", "7200"),
+					resource.TestCheckResourceAttr(resName, "engine_config.mysql.wait_timeout", "7200"),
+					resource.TestCheckResourceAttr(resName, "engine_config_binlog_retention_period", "7200"),
+					resource.TestCheckResourceAttr(resName, "engine_config_mysql_wait_timeout", "7200"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated", "oldest_restore_time", "members"},
+			},
+		},
+	})
+}
+
 func TestAccResourceDatabaseMysqlV2_vpc(t *testing.T) {
 	t.Parallel()
 
@@ -82,6 +82,16 @@ func Complex(
 	)
 }
 
+type TemplateDataNestedEngineConfig struct {
+	Label    string
+	Region   string
+	EngineID string
+	Type     string
+
+	BinlogRetentionPeriod int
+	WaitTimeout           int
+}
+
 func EngineConfig(
 	t testing.TB,
 	data TemplateDataEngineConfig,
@@ -93,6 +103,17 @@ func EngineConfig(
 	)
 }
 
+func EngineConfigNested(
+	t testing.TB,
+	data TemplateDataNestedEngineConfig,
+) string {
+	return acceptance.ExecuteTemplate(
+		t,
+		"database_mysql_v2_engine_config_nested",
+		data,
+	)
+}
+
 func EngineConfigNullableField(
 	t testing.TB,
 	data TemplateDataEngineConfig,
@@ -117,6 +138,19 @@ func Fork(t testing.TB, label, region, engine, nodeType string) string {
 	)
 }
 
+func ForkDetached(t testing.TB, label, region, engine, nodeType string) string {
+	return acceptance.ExecuteTemplate(
+		t,
+		"database_mysql_v2_fork_detached",
+		TemplateData{
+			Label:    label,
+			Region:   region,
+			EngineID: engine,
+			Type:     nodeType,
+		},
+	)
+}
+
 func Suspension(
 	t testing.TB,
 	data TemplateData,
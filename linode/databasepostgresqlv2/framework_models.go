@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -13,6 +14,7 @@ import (
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
 )
 
 type ModelHosts struct {
@@ -22,7 +24,37 @@ type ModelHosts struct {
 
 type ResourceModel struct {
 	Model
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ApplyPendingUpdates types.Bool     `tfsdk:"apply_pending_updates"`
+	AppliedUpdates      types.Set      `tfsdk:"applied_updates"`
+	ForkDetached        types.Bool     `tfsdk:"fork_detached"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// SetResourceDefaults populates the resource-only fields that cannot be
+// resolved from the API, e.g. after an import.
+func (m *ResourceModel) SetResourceDefaults() {
+	if m.ForkDetached.IsNull() || m.ForkDetached.IsUnknown() {
+		m.ForkDetached = types.BoolValue(false)
+	}
+
+	if m.ApplyPendingUpdates.IsNull() || m.ApplyPendingUpdates.IsUnknown() {
+		m.ApplyPendingUpdates = types.BoolValue(false)
+	}
+
+	if m.AppliedUpdates.IsNull() || m.AppliedUpdates.IsUnknown() {
+		m.AppliedUpdates = types.SetValueMust(databaseshared.ObjectTypePendingUpdates, []attr.Value{})
+	}
+}
+
+// PreserveDetachedFork restores the given fork fields if this database has been
+// detached from its fork source, so they are no longer tracked against the API.
+func (m *ResourceModel) PreserveDetachedFork(forkSource types.Int64, forkRestoreTime timetypes.RFC3339) {
+	if !m.ForkDetached.ValueBool() {
+		return
+	}
+
+	m.ForkSource = forkSource
+	m.ForkRestoreTime = forkRestoreTime
 }
 
 type Model struct {
@@ -60,6 +92,7 @@ type Model struct {
 	PrivateNetwork types.Object `tfsdk:"private_network"`
 	Updates        types.Object `tfsdk:"updates"`
 	PendingUpdates types.Set    `tfsdk:"pending_updates"`
+	EngineConfig   types.Object `tfsdk:"engine_config"`
 
 	// EngineConfig-specific fields
 	EngineConfigPGAutovacuumAnalyzeScaleFactor         types.Float64 `tfsdk:"engine_config_pg_autovacuum_analyze_scale_factor"`
@@ -121,8 +154,15 @@ func (m *Model) Refresh(
 
 	tflog.Debug(ctx, "Refreshing the PostgreSQL database...")
 
-	tflog.Debug(ctx, "client.GetPostgresDatabase(...)")
-	db, err := client.GetPostgresDatabase(ctx, dbID)
+	// The database is fetched along with its raw engine config so
+	// settings unknown to linodego are kept in engine_config
+	tflog.Debug(ctx, "engineconfig.GetDatabase(...)")
+	db, rawEngineConfig, err := engineconfig.GetDatabase[linodego.PostgresDatabase](
+		ctx,
+		client,
+		linodego.DatabaseEngineTypePostgres,
+		dbID,
+	)
 	if err != nil {
 		d.AddError("Failed to refresh PostgreSQL database", err.Error())
 		return d
@@ -150,9 +190,20 @@ func (m *Model) Refresh(
 	}
 
 	d.Append(m.Flatten(ctx, db, ssl, creds, preserveKnown)...)
+
+	m.EngineConfig = engineconfig.Flatten(
+		m.EngineConfig,
+		rawEngineConfig,
+		engineconfig.PostgreSQLSettings,
+		preserveKnown,
+		&d,
+	)
+
 	return d
 }
 
+// Flatten sets the attributes of the model from the given database. The engine_config block
+// is flattened by Refresh from the raw engine config, which includes settings unknown to linodego.
 func (m *Model) Flatten(
 	ctx context.Context,
 	db *linodego.PostgresDatabase,
@@ -448,6 +499,7 @@ func (m *Model) CopyFrom(other *Model, preserveKnown bool) {
 	m.Created = helper.KeepOrUpdateValue(m.Created, other.Created, preserveKnown)
 	m.Encrypted = helper.KeepOrUpdateValue(m.Encrypted, other.Encrypted, preserveKnown)
 	m.Engine = helper.KeepOrUpdateValue(m.Engine, other.Engine, preserveKnown)
+	m.EngineConfig = helper.KeepOrUpdateValue(m.EngineConfig, other.EngineConfig, preserveKnown)
 	m.EngineID = helper.KeepOrUpdateValue(m.EngineID, other.EngineID, preserveKnown)
 	m.ForkRestoreTime = helper.KeepOrUpdateValue(m.ForkRestoreTime, other.ForkRestoreTime, preserveKnown)
 	m.HostPrimary = helper.KeepOrUpdateValue(m.HostPrimary, other.HostPrimary, preserveKnown)
@@ -701,6 +753,12 @@ func (m *Model) GetUpdates(ctx context.Context, d diag.Diagnostics) *databasesha
 	return &result
 }
 
+// GetNestedEngineConfig returns the API representation of the engine_config
+// block of this model, or nil if it is not configured.
+func (m *Model) GetNestedEngineConfig() map[string]any {
+	return engineconfig.Expand(m.EngineConfig, engineconfig.PostgreSQLSettings)
+}
+
 // GetEngineConfig returns a pointer to the linodego.PostgreSQLDatabaseEngineConfig for this model if specified, else nil.
 func (m *Model) GetEngineConfig(d diag.Diagnostics) *linodego.PostgresDatabaseEngineConfig {
 	var engineConfig linodego.PostgresDatabaseEngineConfig
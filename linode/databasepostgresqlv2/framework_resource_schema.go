@@ -1,7 +1,6 @@
 package databasepostgresqlv2
 
 import (
-	"context"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -21,8 +20,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/linode/terraform-provider-linode/v3/linode/helper"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
 )
 
 var frameworkResourceSchema = schema.Schema{
@@ -97,20 +96,7 @@ var frameworkResourceSchema = schema.Schema{
 			CustomType:  timetypes.RFC3339Type{},
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
-				stringplanmodifier.RequiresReplaceIf(
-					func(
-						ctx context.Context,
-						sr planmodifier.StringRequest,
-						rrifr *stringplanmodifier.RequiresReplaceIfFuncResponse,
-					) {
-						rrifr.RequiresReplace = !helper.CompareRFC3339TimeStrings(
-							sr.PlanValue.ValueString(),
-							sr.StateValue.ValueString(),
-						)
-					},
-					"Triggers replacement when `fork_restore_time` changes",
-					"Changing `fork_restore_time` forces a new resource.",
-				),
+				databaseshared.ForkRestoreTimeRequiresReplace(),
 			},
 		},
 		"fork_source": schema.Int64Attribute{
@@ -118,9 +104,10 @@ var frameworkResourceSchema = schema.Schema{
 			Optional:    true,
 			PlanModifiers: []planmodifier.Int64{
 				int64planmodifier.UseStateForUnknown(),
-				int64planmodifier.RequiresReplace(),
+				databaseshared.ForkSourceRequiresReplace(),
 			},
 		},
+		"fork_detached": databaseshared.ResourceAttributeForkDetached,
 		"suspended": schema.BoolAttribute{
 			Description:   "Whether this database is suspended.",
 			Computed:      true,
@@ -177,7 +164,9 @@ var frameworkResourceSchema = schema.Schema{
 			Computed:    true,
 			CustomType:  timetypes.RFC3339Type{},
 		},
-		"pending_updates": databaseshared.ResourceAttributePendingUpdates,
+		"pending_updates":       databaseshared.ResourceAttributePendingUpdates,
+		"apply_pending_updates": databaseshared.ResourceAttributeApplyPendingUpdates,
+		"applied_updates":       databaseshared.ResourceAttributeAppliedUpdates,
 		"platform": schema.StringAttribute{
 			Computed:      true,
 			Description:   "The back-end platform for relational databases used by the service.",
@@ -221,6 +210,11 @@ var frameworkResourceSchema = schema.Schema{
 			Description: "The Managed Database engine version.",
 			Computed:    true,
 		},
+		"engine_config": engineconfig.ResourceAttribute(
+			"The engine settings of the Managed Database. "+
+				"Cannot be used together with the flattened engine_config_* attributes.",
+			engineconfig.PostgreSQLSettings,
+		),
 		"engine_config_pg_autovacuum_analyze_scale_factor": schema.Float64Attribute{
 			Optional:    true,
 			Computed:    true,
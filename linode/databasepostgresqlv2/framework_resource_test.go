@@ -125,6 +125,8 @@ func TestAccResourceDatabasePostgresqlV2_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "updates.hour_of_day"),
 
 					resource.TestCheckResourceAttr(resName, "pending_updates.#", "0"),
+					resource.TestCheckResourceAttr(resName, "apply_pending_updates", "false"),
+					resource.TestCheckResourceAttr(resName, "applied_updates.#", "0"),
 
 					resource.TestCheckResourceAttr(resName, "engine_config_pg_password_encryption", "scram-sha-256"),
 					resource.TestCheckResourceAttr(resName, "engine_config_pg_stat_monitor_enable", "false"),
@@ -415,6 +417,7 @@ func TestAccResourceDatabasePostgresqlV2_fork(t *testing.T) {
 	resNameFork := "linode_database_postgresql_v2.fork"
 
 	var dbSource linodego.PostgresDatabase
+	var forkID string
 
 	label := acctest.RandomWithPrefix("tf_test")
 
@@ -514,6 +517,10 @@ func TestAccResourceDatabasePostgresqlV2_fork(t *testing.T) {
 					resource.TestCheckResourceAttr(resNameFork, "engine_id", testEngine),
 					resource.TestCheckResourceAttrSet(resNameFork, "fork_restore_time"),
 					resource.TestCheckResourceAttrSet(resNameFork, "fork_source"),
+					resource.TestCheckResourceAttrWith(resNameFork, "id", func(value string) error {
+						forkID = value
+						return nil
+					}),
 					resource.TestCheckResourceAttrSet(resNameFork, "host_primary"),
 					resource.TestCheckResourceAttr(resNameFork, "label", label+"-fork"),
 					resource.TestCheckResourceAttrSet(resNameFork, "members.%"),
@@ -545,6 +552,19 @@ func TestAccResourceDatabasePostgresqlV2_fork(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"updated", "oldest_restore_time", "members", "version"},
 			},
+			{
+				// Detaching the fork must not force a new resource
+				Config: tmpl.ForkDetached(t, label, testRegion, testEngine, "g6-nanode-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resNameFork, "fork_detached", "true"),
+					resource.TestCheckResourceAttrWith(resNameFork, "id", func(value string) error {
+						if value != forkID {
+							return fmt.Errorf("expected fork %s to be kept, got %s", forkID, value)
+						}
+						return nil
+					}),
+				),
+			},
 		},
 	})
 }
@@ -960,6 +980,60 @@ func TestAccResourceDatabasePostgresqlV2_engineConfig(t *testing.T) {
 	})
 }
 
+func TestAccResourceDatabasePostgresqlV2_engineConfigNested(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_database_postgresql_v2.foobar"
+	label := acctest.RandomWithPrefix("tf_test")
+
+	data := tmpl.TemplateDataNestedEngineConfig{
+		Label:    label,
+		Region:   testRegion,
+		EngineID: testEngine,
+		Type:     "g6-nanode-1",
+		WorkMem:  400,
+		JIT:      true,
+	}
+
+	updatedData := data
+	updatedData.WorkMem = 500
+	updatedData.JIT = false
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckPostgreSQLDatabaseV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.EngineConfigNested(t, data),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckPostgresDatabaseExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "engine_config.work_mem", "400"),
+					resource.TestCheckResourceAttr(resName, "engine_config.pg.jit", "true"),
+					resource.TestCheckResourceAttr(resName, "engine_config_work_mem", "400"),
+					resource.TestCheckResourceAttr(resName, "engine_config_pg_jit", "true"),
+				),
+			},
+			{
+				Config: tmpl.EngineConfigNested(t, updatedData),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckPostgresDatabaseExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "engine_config.work_mem", "500"),
+					resource.TestCheckResourceAttr(resName, "engine_config.pg.jit", "false"),
+					resource.TestCheckResourceAttr(resName, "engine_config_work_mem", "500"),
+					resource.TestCheckResourceAttr(resName, "engine_config_pg_jit", "false"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated", "oldest_restore_time", "members", "version"},
+			},
+		},
+	})
+}
+
 func TestAccResourceDatabasePostgresqlV2_vpc(t *testing.T) {
 	t.Parallel()
 
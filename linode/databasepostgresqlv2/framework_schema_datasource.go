@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
 )
 
 var frameworkDatasourceSchema = schema.Schema{
@@ -134,6 +135,10 @@ var frameworkDatasourceSchema = schema.Schema{
 			Description: "The Managed Database engine version.",
 			Computed:    true,
 		},
+		"engine_config": engineconfig.DataSourceAttribute(
+			"The engine settings of the Managed Database.",
+			engineconfig.PostgreSQLSettings,
+		),
 		"engine_config_pg_autovacuum_analyze_scale_factor": schema.Float64Attribute{
 			Computed:    true,
 			Description: "Specifies a fraction of the table size to add to autovacuum_analyze_threshold when deciding whether to trigger an ANALYZE. The default is 0.2 (20% of table size)",
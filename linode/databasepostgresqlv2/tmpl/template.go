@@ -115,6 +115,19 @@ func Fork(t testing.TB, label, region, engine, nodeType string) string {
 	)
 }
 
+func ForkDetached(t testing.TB, label, region, engine, nodeType string) string {
+	return acceptance.ExecuteTemplate(
+		t,
+		"database_postgresql_v2_fork_detached",
+		TemplateData{
+			Label:    label,
+			Region:   region,
+			EngineID: engine,
+			Type:     nodeType,
+		},
+	)
+}
+
 func Suspension(
 	t testing.TB,
 	data TemplateData,
@@ -137,6 +150,16 @@ func Data(
 	)
 }
 
+type TemplateDataNestedEngineConfig struct {
+	Label    string
+	Region   string
+	EngineID string
+	Type     string
+
+	WorkMem int
+	JIT     bool
+}
+
 func EngineConfig(
 	t testing.TB,
 	data TemplateDataEngineConfig,
@@ -159,6 +182,17 @@ func EngineConfigUpdate(
 	)
 }
 
+func EngineConfigNested(
+	t testing.TB,
+	data TemplateDataNestedEngineConfig,
+) string {
+	return acceptance.ExecuteTemplate(
+		t,
+		"database_postgresql_v2_engine_config_nested",
+		data,
+	)
+}
+
 func DataEngineConfig(
 	t testing.TB,
 	data TemplateDataEngineConfig,
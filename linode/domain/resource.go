@@ -25,6 +25,7 @@ func Resource() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
+			helper.SDKv2PlanTagsAll(),
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -88,7 +89,7 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	if err := d.Set("soa_email", domain.SOAEmail); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set soa_email: %w", err))
 	}
-	if err := d.Set("tags", domain.Tags); err != nil {
+	if err := helper.SDKv2SetTags(d, meta, domain.Tags); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set tags: %w", err))
 	}
 
@@ -112,11 +113,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		TTLSec:      d.Get("ttl_sec").(int),
 	}
 
-	if tagsRaw, tagsOk := d.GetOk("tags"); tagsOk {
-		for _, tag := range tagsRaw.(*schema.Set).List() {
-			createOpts.Tags = append(createOpts.Tags, tag.(string))
-		}
-	}
+	createOpts.Tags = helper.SDKv2TagsAll(d, meta)
 
 	if v, ok := d.GetOk("master_ips"); ok {
 		v := v.(*schema.Set).List()
@@ -192,8 +189,8 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
-	if d.HasChange("tags") {
-		tags := helper.ExpandStringSet(d.Get("tags").(*schema.Set))
+	if d.HasChanges("tags", "tags_all") {
+		tags := helper.SDKv2TagsAll(d, meta)
 		updateOpts.Tags = tags
 	}
 
@@ -0,0 +1,46 @@
+//go:build unit
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDomain_fakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	config := map[string]any{
+		"domain":    "fake-domain.example",
+		"type":      "master",
+		"soa_email": "admin@fake-domain.example",
+		"ttl_sec":   300,
+		"tags":      []any{"test"},
+	}
+
+	state := tf.Apply("linode_domain", nil, config)
+	require.NotEmpty(t, state.Attr("id"))
+	require.Equal(t, "fake-domain.example", state.Attr("domain"))
+	require.Equal(t, "active", state.Attr("status"))
+	require.Equal(t, float64(300), state.Attr("ttl_sec"))
+
+	config["description"] = "updated"
+	config["soa_email"] = "hostmaster@fake-domain.example"
+
+	state = tf.Apply("linode_domain", state, config)
+	require.Equal(t, "updated", state.Attr("description"))
+
+	domain, ok := server.Get("domains/" + state.Attr("id").(string))
+	require.True(t, ok)
+	require.Equal(t, "hostmaster@fake-domain.example", domain["soa_email"])
+
+	imported := tf.Import("linode_domain", state.Attr("id").(string))
+	require.Equal(t, state.Attr("domain"), imported.Attr("domain"))
+	require.Equal(t, state.Attr("soa_email"), imported.Attr("soa_email"))
+
+	tf.Destroy(state)
+	require.Empty(t, server.List("domains"))
+}
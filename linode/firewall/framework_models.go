@@ -37,6 +37,7 @@ type FirewallResourceModel struct {
 	ID             types.String      `tfsdk:"id"`
 	Label          types.String      `tfsdk:"label"`
 	Tags           types.Set         `tfsdk:"tags"`
+	TagsAll        types.Set         `tfsdk:"tags_all"`
 	Disabled       types.Bool        `tfsdk:"disabled"`
 	Inbound        []RuleModel       `tfsdk:"inbound"`
 	InboundPolicy  types.String      `tfsdk:"inbound_policy"`
@@ -123,15 +124,10 @@ func (data *FirewallResourceModel) ExpandFirewallRuleSet(
 }
 
 func (data *FirewallResourceModel) getCreateOptions(
-	ctx context.Context, diags *diag.Diagnostics,
+	ctx context.Context, meta *helper.FrameworkProviderMeta, diags *diag.Diagnostics,
 ) (createOpts linodego.FirewallCreateOptions) {
 	createOpts.Label = data.Label.ValueString()
-
-	newDiags := data.Tags.ElementsAs(ctx, &createOpts.Tags, false)
-	diags.Append(newDiags...)
-	if diags.HasError() {
-		return createOpts
-	}
+	createOpts.Tags = helper.FrameworkTagsAll(data.Tags, meta)
 
 	createOpts.Devices.Linodes = helper.ExpandFwInt64Set(data.Linodes, diags)
 	if diags.HasError() {
@@ -160,18 +156,15 @@ func (data *FirewallResourceModel) getCreateOptions(
 }
 
 func (plan *FirewallResourceModel) getUpdateOptions(
-	ctx context.Context, state FirewallResourceModel, diags *diag.Diagnostics,
+	state FirewallResourceModel, meta *helper.FrameworkProviderMeta,
 ) (updateOpts linodego.FirewallUpdateOptions, shouldUpdate bool) {
 	if !plan.Label.Equal(state.Label) {
 		updateOpts.Label = plan.Label.ValueString()
 		shouldUpdate = true
 	}
-	if !plan.Tags.Equal(state.Tags) {
-		newDiags := plan.Tags.ElementsAs(ctx, &updateOpts.Tags, false)
-		diags.Append(newDiags...)
-		if diags.HasError() {
-			return updateOpts, shouldUpdate
-		}
+	if !plan.Tags.Equal(state.Tags) || !plan.TagsAll.Equal(state.TagsAll) {
+		tags := helper.FrameworkTagsAll(plan.Tags, meta)
+		updateOpts.Tags = &tags
 		shouldUpdate = true
 	}
 	if !plan.Disabled.Equal(state.Disabled) {
@@ -334,6 +327,7 @@ func (data *FirewallResourceModel) CopyFrom(
 	data.ID = helper.KeepOrUpdateValue(data.ID, other.ID, preserveKnown)
 	data.Label = helper.KeepOrUpdateValue(data.Label, other.Label, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.Disabled = helper.KeepOrUpdateValue(data.Disabled, other.Disabled, preserveKnown)
 	data.InboundPolicy = helper.KeepOrUpdateValue(data.InboundPolicy, other.InboundPolicy, preserveKnown)
 	data.OutboundPolicy = helper.KeepOrUpdateValue(data.OutboundPolicy, other.OutboundPolicy, preserveKnown)
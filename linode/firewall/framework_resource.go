@@ -29,6 +29,25 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan FirewallResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper.FrameworkPlanTagsAll(ctx, req, resp, plan.Tags, r.Meta)
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -44,7 +63,7 @@ func (r *Resource) Create(
 		return
 	}
 
-	createOpts := plan.getCreateOptions(ctx, &resp.Diagnostics)
+	createOpts := plan.getCreateOptions(ctx, r.Meta, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -71,6 +90,10 @@ func (r *Resource) Create(
 		return
 	}
 
+	helper.FrameworkFlattenTags(
+		&plan.Tags, &plan.TagsAll, plan.Tags, firewall.Tags, r.Meta, true, &resp.Diagnostics,
+	)
+
 	refreshDevices(ctx, client, firewall.ID, &plan, &resp.Diagnostics, true)
 	refreshRules(ctx, client, firewall.ID, &plan, &resp.Diagnostics, true)
 
@@ -123,11 +146,17 @@ func (r *Resource) Read(
 		return
 	}
 
+	priorTags := state.Tags
+
 	state.flattenFirewallForResource(firewall, false, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	helper.FrameworkFlattenTags(
+		&state.Tags, &state.TagsAll, priorTags, firewall.Tags, r.Meta, false, &resp.Diagnostics,
+	)
+
 	refreshRules(ctx, client, id, &state, &resp.Diagnostics, false)
 	refreshDevices(ctx, client, id, &state, &resp.Diagnostics, false)
 
@@ -154,10 +183,7 @@ func (r *Resource) Update(
 		return
 	}
 
-	updateOpts, shouldUpdate := plan.getUpdateOptions(ctx, state, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	updateOpts, shouldUpdate := plan.getUpdateOptions(state, r.Meta)
 
 	if shouldUpdate {
 		firewall, err := client.UpdateFirewall(ctx, id, updateOpts)
@@ -170,6 +196,10 @@ func (r *Resource) Update(
 		if resp.Diagnostics.HasError() {
 			return
 		}
+
+		helper.FrameworkFlattenTags(
+			&plan.Tags, &plan.TagsAll, plan.Tags, firewall.Tags, r.Meta, true, &resp.Diagnostics,
+		)
 	}
 
 	if state.RulesAndPoliciesHaveChanges(ctx, plan, &resp.Diagnostics) {
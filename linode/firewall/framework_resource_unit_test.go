@@ -0,0 +1,71 @@
+//go:build unit
+
+package firewall_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func firewallConfig(label string, instanceIDs []any, ports string) map[string]any {
+	return map[string]any{
+		"label":           label,
+		"inbound_policy":  "DROP",
+		"outbound_policy": "ACCEPT",
+		"linodes":         instanceIDs,
+		"tags":            []any{"test"},
+		"inbound": []map[string]any{
+			{
+				"label":    "allow-http",
+				"action":   "ACCEPT",
+				"protocol": "TCP",
+				"ports":    ports,
+				"ipv4":     []any{"0.0.0.0/0"},
+			},
+		},
+	}
+}
+
+func TestResourceFirewall_fakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	instance, err := server.Client().CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Label:  "fake-instance",
+	})
+	require.NoError(t, err)
+
+	instanceIDs := []any{instance.ID}
+
+	state := tf.Apply("linode_firewall", nil, firewallConfig("fake-firewall", instanceIDs, "80"))
+	require.NotEmpty(t, state.Attr("id"))
+	require.Equal(t, "enabled", state.Attr("status"))
+	require.Equal(t, "80", state.Attr("inbound.0.ports"))
+	require.Equal(t, []any{float64(instance.ID)}, state.Attr("linodes"))
+	require.Equal(t, "linode", state.Attr("devices.0.type"))
+
+	firewallPath := "networking/firewalls/" + state.Attr("id").(string)
+
+	state = tf.Apply("linode_firewall", state, firewallConfig("fake-firewall-renamed", []any{}, "80,443"))
+	require.Equal(t, "fake-firewall-renamed", state.Attr("label"))
+	require.Equal(t, "80,443", state.Attr("inbound.0.ports"))
+	require.Empty(t, state.Attr("linodes"))
+	require.Empty(t, server.List(firewallPath+"/devices"))
+
+	firewall, ok := server.Get(firewallPath)
+	require.True(t, ok)
+	require.Equal(t, float64(2), firewall["rules"].(map[string]any)["version"])
+
+	imported := tf.Import("linode_firewall", state.Attr("id").(string))
+	require.Equal(t, state.Attr("label"), imported.Attr("label"))
+	require.Equal(t, state.Attr("inbound"), imported.Attr("inbound"))
+
+	tf.Destroy(state)
+	require.Empty(t, server.List("networking/firewalls"))
+}
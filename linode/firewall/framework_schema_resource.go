@@ -119,6 +119,11 @@ var frameworkResourceSchema = schema.Schema{
 			},
 			Default: helper.EmptySetDefault(types.StringType),
 		},
+		"tags_all": schema.SetAttribute{
+			Description: "All tags applied to the firewall, including the default tags of the provider.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
 		"disabled": schema.BoolAttribute{
 			Description: "If true, the Firewall is inactive.",
 			Optional:    true,
@@ -3,10 +3,15 @@ package linode
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v3/linode/account"
 	"github.com/linode/terraform-provider-linode/v3/linode/accountavailabilities"
 	"github.com/linode/terraform-provider-linode/v3/linode/accountavailability"
@@ -16,10 +21,12 @@ import (
 	"github.com/linode/terraform-provider-linode/v3/linode/backup"
 	"github.com/linode/terraform-provider-linode/v3/linode/childaccount"
 	"github.com/linode/terraform-provider-linode/v3/linode/childaccounts"
+	"github.com/linode/terraform-provider-linode/v3/linode/cloudinitmultipart"
 	"github.com/linode/terraform-provider-linode/v3/linode/consumerimagesharegroup"
 	"github.com/linode/terraform-provider-linode/v3/linode/consumerimagesharegroupimageshares"
 	"github.com/linode/terraform-provider-linode/v3/linode/consumerimagesharegrouptoken"
 	"github.com/linode/terraform-provider-linode/v3/linode/consumerimagesharegrouptokens"
+	"github.com/linode/terraform-provider-linode/v3/linode/databaseaccesscontrolentry"
 	"github.com/linode/terraform-provider-linode/v3/linode/databaseengines"
 	"github.com/linode/terraform-provider-linode/v3/linode/databasemysqlconfig"
 	"github.com/linode/terraform-provider-linode/v3/linode/databasemysqlv2"
@@ -38,16 +45,23 @@ import (
 	"github.com/linode/terraform-provider-linode/v3/linode/firewalltemplates"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper"
 	"github.com/linode/terraform-provider-linode/v3/linode/iamentities"
+	"github.com/linode/terraform-provider-linode/v3/linode/iamroleassignment"
 	"github.com/linode/terraform-provider-linode/v3/linode/iamuser"
 	"github.com/linode/terraform-provider-linode/v3/linode/image"
 	"github.com/linode/terraform-provider-linode/v3/linode/images"
+	"github.com/linode/terraform-provider-linode/v3/linode/instance"
 	"github.com/linode/terraform-provider-linode/v3/linode/instancedisk"
+	"github.com/linode/terraform-provider-linode/v3/linode/instancegroup"
+	"github.com/linode/terraform-provider-linode/v3/linode/instanceinterfaceupgrade"
 	"github.com/linode/terraform-provider-linode/v3/linode/instanceip"
+	"github.com/linode/terraform-provider-linode/v3/linode/instancelish"
 	"github.com/linode/terraform-provider-linode/v3/linode/instancenetworking"
+	"github.com/linode/terraform-provider-linode/v3/linode/instancerescue"
 	"github.com/linode/terraform-provider-linode/v3/linode/instancereservedipassignment"
 	"github.com/linode/terraform-provider-linode/v3/linode/instancesharedips"
 	"github.com/linode/terraform-provider-linode/v3/linode/instancetype"
 	"github.com/linode/terraform-provider-linode/v3/linode/instancetypes"
+	"github.com/linode/terraform-provider-linode/v3/linode/ipfailovergroup"
 	"github.com/linode/terraform-provider-linode/v3/linode/ipv6range"
 	"github.com/linode/terraform-provider-linode/v3/linode/ipv6ranges"
 	"github.com/linode/terraform-provider-linode/v3/linode/kernel"
@@ -105,6 +119,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v3/linode/stackscripts"
 	"github.com/linode/terraform-provider-linode/v3/linode/token"
 	"github.com/linode/terraform-provider-linode/v3/linode/user"
+	"github.com/linode/terraform-provider-linode/v3/linode/usergrant"
 	"github.com/linode/terraform-provider-linode/v3/linode/users"
 	"github.com/linode/terraform-provider-linode/v3/linode/vlan"
 	"github.com/linode/terraform-provider-linode/v3/linode/volume"
@@ -117,9 +132,14 @@ import (
 	"github.com/linode/terraform-provider-linode/v3/linode/vpcsubnets"
 )
 
+var _ provider.ProviderWithFunctions = &FrameworkProvider{}
+
 type FrameworkProvider struct {
 	ProviderVersion string
 	Meta            *helper.FrameworkProviderMeta
+
+	// HTTPClientModifiers are applied to the HTTP client of the configured Linode client.
+	HTTPClientModifiers []helper.HTTPClientModifier
 }
 
 // CreateFrameworkProviderWithMeta is used by the crossplane provider
@@ -160,6 +180,15 @@ func (p *FrameworkProvider) Schema(
 				Sensitive:   true,
 				Description: "The token that allows you access to your Linode account",
 			},
+			"token_command": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "A command and its arguments to run to retrieve the token that allows you " +
+					"access to your Linode account.",
+			},
 			"config_path": schema.StringAttribute{
 				Optional:    true,
 				Description: "The path to the Linode config file to use. (default `~/.config/linode`)",
@@ -184,10 +213,21 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: "The path to a Linode API CA file to trust.",
 			},
+			"child_account_euuid": schema.StringAttribute{
+				Optional: true,
+				Description: "The EUUID of a child account to manage. If set, the token of the parent account " +
+					"is exchanged for a proxy token scoped to the child account.",
+			},
 			"skip_instance_ready_poll": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Skip waiting for a linode_instance resource to be running.",
 			},
+			"lish_private_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The private SSH key used to read the console output of Linode instances through Lish. " +
+					"If set, the console output is included in errors for instances that fail to boot.",
+			},
 			"skip_instance_delete_poll": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Skip waiting for a linode_instance resource to finish deleting.",
@@ -212,6 +252,14 @@ func (p *FrameworkProvider) Schema(
 				Optional:    true,
 				Description: "Maximum delay in milliseconds before retrying a request.",
 			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum sustained rate of API requests per second.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of API requests that can be in flight at the same time.",
+			},
 			"event_poll_ms": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The rate in milliseconds to poll for events.",
@@ -244,6 +292,56 @@ func (p *FrameworkProvider) Schema(
 					"and versions will be force deleted.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				Description: "A policy for retrying failed API requests in addition to the built-in retry conditions.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"status_codes": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.Int64Type,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+							},
+							Description: "The response status codes to retry.",
+						},
+						"path_regex": schema.StringAttribute{
+							Optional:    true,
+							Description: "A regular expression matching the URL paths of the requests to retry.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "The total number of attempts made for a matching request.",
+						},
+						"backoff_ms": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "The delay in milliseconds before the first retry, doubled on each subsequent retry.",
+						},
+					},
+				},
+			},
+			"default_tags": schema.ListNestedBlock{
+				Description: "Tags to apply to all taggable resources managed by this provider.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The tags to apply to all taggable resources, in addition to their own tags.",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -254,9 +352,13 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		firewalldevice.NewResource,
 		iamuser.NewResource,
 		image.NewResource,
+		instance.NewResource,
 		instancedisk.NewResource,
+		instancegroup.NewResource,
+		instancerescue.NewResource,
 		instanceip.NewResource,
 		instancesharedips.NewResource,
+		ipfailovergroup.NewResource,
 		ipv6range.NewResource,
 		lkenodepool.NewResource,
 		lock.NewResource,
@@ -285,6 +387,9 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		firewallsettings.NewResource,
 		linodeinterface.NewResource,
 		monitoralertdefinition.NewResource,
+		databaseaccesscontrolentry.NewResource,
+		usergrant.NewResource,
+		iamroleassignment.NewResource,
 	}
 }
 
@@ -311,6 +416,7 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		sshkey.NewDataSource,
 		sshkeys.NewDataSource,
 		instancenetworking.NewDataSource,
+		instancelish.NewDataSource,
 		objcluster.NewDataSource,
 		domainrecord.NewDataSource,
 		volume.NewDataSource,
@@ -351,6 +457,7 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		ipv6ranges.NewDataSource,
 		domains.NewDataSource,
 		linodeinterface.NewDataSource,
+		instanceinterfaceupgrade.NewDataSource,
 		lke.NewDataSource,
 		lkeclusters.NewDataSource,
 		lketypes.NewDataSource,
@@ -388,3 +495,9 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		monitoralertchannels.NewDataSource,
 	}
 }
+
+func (p *FrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		cloudinitmultipart.NewFunction,
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -49,7 +51,7 @@ func (fp *FrameworkProvider) Configure(
 		tflog.Info(ctx, "Linode client was already configured, re-using..")
 		meta.Client = fp.Meta.Client
 	} else {
-		meta.Client = fp.InitLinodeClient(ctx, &data, req.TerraformVersion, nil, &resp.Diagnostics)
+		meta.Client = fp.InitLinodeClient(ctx, &data, req.TerraformVersion, fp.HTTPClientModifiers, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -85,6 +87,24 @@ func (fp *FrameworkProvider) ValidateConfig(
 		return
 	}
 
+	if !data.AccessToken.IsNull() && !data.TokenCommand.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_command"),
+			"Conflicting Provider Configuration",
+			"Only one of token and token_command can be specified.",
+		)
+	}
+
+	for i, retry := range data.Retry {
+		if _, err := regexp.Compile(retry.PathRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry").AtListIndex(i).AtName("path_regex"),
+				"Invalid Retry Policy",
+				fmt.Sprintf("Failed to compile path_regex: %s", err),
+			)
+		}
+	}
+
 	_, err := url.Parse(data.APIURL.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -140,6 +160,10 @@ func (fp *FrameworkProvider) HandleDefaults(
 		lpm.AccessToken = GetStringFromEnv("LINODE_TOKEN", types.StringNull())
 	}
 
+	if lpm.ChildAccountEUUID.IsNull() {
+		lpm.ChildAccountEUUID = GetStringFromEnv("LINODE_CHILD_ACCOUNT_EUUID", types.StringNull())
+	}
+
 	if lpm.ConfigPath.IsNull() {
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
@@ -182,6 +206,10 @@ func (fp *FrameworkProvider) HandleDefaults(
 		)
 	}
 
+	if lpm.LishPrivateKey.IsNull() {
+		lpm.LishPrivateKey = GetStringFromEnv("LINODE_LISH_PRIVATE_KEY", types.StringNull())
+	}
+
 	if lpm.SkipInstanceReadyPoll.IsNull() {
 		lpm.SkipInstanceReadyPoll = types.BoolValue(false)
 	}
@@ -281,11 +309,41 @@ func (fp *FrameworkProvider) InitLinodeClient(
 		}
 	}
 
+	var transport http.RoundTripper = httpTransport
+
+	rateLimitOpts := helper.RateLimitOptions{
+		MaxRequestsPerSecond:  lpm.MaxRequestsPerSecond.ValueFloat64(),
+		MaxConcurrentRequests: lpm.MaxConcurrentRequests.ValueInt64(),
+	}
+
+	if rateLimitOpts.IsEnabled() {
+		var tokenCommand []string
+
+		if !lpm.TokenCommand.IsNull() {
+			diags.Append(lpm.TokenCommand.ElementsAs(ctx, &tokenCommand, false)...)
+			if diags.HasError() {
+				return nil
+			}
+		}
+
+		transport = helper.SharedRateLimiter(
+			rateLimitOpts,
+			helper.RateLimitIdentity(
+				lpm.APIURL.ValueString(),
+				lpm.AccessToken.ValueString(),
+				tokenCommand,
+				lpm.ChildAccountEUUID.ValueString(),
+				lpm.ConfigPath.ValueString(),
+				lpm.ConfigProfile.ValueString(),
+			)...,
+		).Transport(transport)
+	}
+
 	oauth2Client := &http.Client{
 		Transport: helper.NewAPILoggerTransport(
 			logging.NewSubsystemLoggingHTTPTransport(
 				helper.APILoggerSubsystem,
-				httpTransport,
+				transport,
 			),
 		),
 	}
@@ -297,6 +355,27 @@ func (fp *FrameworkProvider) InitLinodeClient(
 		}
 	}
 
+	var tokenSource helper.TokenSource
+
+	if !lpm.TokenCommand.IsNull() {
+		var tokenCommand []string
+
+		diags.Append(lpm.TokenCommand.ElementsAs(ctx, &tokenCommand, false)...)
+		if diags.HasError() {
+			return nil
+		}
+
+		tokenSource = helper.NewTokenCommandSource(tokenCommand)
+	}
+
+	var childAccountTokens *helper.ChildAccountTokenSource
+
+	oauth2Client.Transport, childAccountTokens = helper.NewAuthTransport(
+		oauth2Client.Transport,
+		tokenSource,
+		lpm.ChildAccountEUUID.ValueString(),
+	)
+
 	client := linodego.NewClient(oauth2Client)
 	// Load the config file if it exists
 	if _, err := os.Stat(configPath); err == nil {
@@ -351,7 +430,24 @@ func (fp *FrameworkProvider) InitLinodeClient(
 	userAgent := fp.terraformUserAgent(tfVersion, UAPrefix)
 	client.SetUserAgent(userAgent)
 
-	helper.ApplyAllRetryConditions(&client)
+	retryPolicies := make([]helper.RetryPolicy, len(lpm.Retry))
+	for i, retry := range lpm.Retry {
+		retryPolicies[i] = retry.RetryPolicy(ctx, diags)
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	if err := helper.ApplyAllRetryConditions(&client, retryPolicies...); err != nil {
+		diags.AddError("Failed to apply retry policies", err.Error())
+		return nil
+	}
+
+	// Proxy tokens are issued using the parent token configured above
+	if childAccountTokens != nil {
+		childAccountTokens.SetParentClient(&client)
+	}
 
 	return &client
 }
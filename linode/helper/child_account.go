@@ -0,0 +1,91 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// ChildAccountTokenRefreshBuffer is how long before expiry a child account
+// proxy token is replaced with a new one.
+const ChildAccountTokenRefreshBuffer = 2 * time.Minute
+
+// ChildAccountTokenSource issues proxy tokens for a child account using the
+// token of the parent account and caches them until they are about to expire.
+type ChildAccountTokenSource struct {
+	euuid string
+
+	// parent is the client used to issue new proxy tokens.
+	parent *linodego.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry *time.Time
+
+	// now can be overridden in tests.
+	now func() time.Time
+}
+
+// NewChildAccountTokenSource creates a token source for the child account with the given EUUID.
+// SetParentClient must be called before any tokens are requested.
+func NewChildAccountTokenSource(euuid string) *ChildAccountTokenSource {
+	return &ChildAccountTokenSource{
+		euuid: euuid,
+		now:   time.Now,
+	}
+}
+
+// SetParentClient sets the client used to issue proxy tokens.
+func (s *ChildAccountTokenSource) SetParentClient(client *linodego.Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.parent = client
+}
+
+// Token returns a valid proxy token for the child account,
+// issuing a new one if the cached token is missing or about to expire.
+func (s *ChildAccountTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry == nil || s.now().Add(ChildAccountTokenRefreshBuffer).Before(*s.expiry)) {
+		return s.token, nil
+	}
+
+	if s.parent == nil {
+		return "", fmt.Errorf("no parent client configured for child account %s", s.euuid)
+	}
+
+	tflog.Debug(ctx, "client.CreateChildAccountToken(...)", map[string]any{
+		"euuid": s.euuid,
+	})
+
+	token, err := s.parent.CreateChildAccountToken(ctx, s.euuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to create proxy token for child account %s: %w", s.euuid, err)
+	}
+
+	s.token = token.Token
+	s.expiry = token.Expiry
+
+	return s.token, nil
+}
+
+// Transport wraps the given RoundTripper so that all API requests are authenticated
+// with a proxy token for the child account. Requests issuing the proxy token itself
+// are sent with the original (parent) credentials.
+func (s *ChildAccountTokenSource) Transport(transport http.RoundTripper) http.RoundTripper {
+	return NewTokenTransport(s, transport, s.isTokenRequest)
+}
+
+func (s *ChildAccountTokenSource) isTokenRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost &&
+		strings.HasSuffix(r.URL.Path, fmt.Sprintf("/account/child-accounts/%s/token", s.euuid))
+}
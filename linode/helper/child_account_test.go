@@ -0,0 +1,146 @@
+//go:build unit
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChildAccountTokenSource(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issued := 0
+
+	var lastAuthorization string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v4/account/child-accounts/child-euuid/token" {
+			// Proxy tokens must be issued with the parent token
+			require.Equal(t, "Bearer parent-token", r.Header.Get("Authorization"))
+
+			issued++
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"token":  "proxy-token-" + string(rune('0'+issued)),
+				"expiry": now.Add(15 * time.Minute).Format("2006-01-02T15:04:05"),
+			})
+			return
+		}
+
+		lastAuthorization = r.Header.Get("Authorization")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []any{}, "page": 1, "pages": 1, "results": 0})
+	}))
+	defer server.Close()
+
+	source := NewChildAccountTokenSource("child-euuid")
+	source.now = func() time.Time { return now }
+
+	httpClient := &http.Client{Transport: source.Transport(http.DefaultTransport)}
+
+	client := linodego.NewClient(httpClient)
+	client.SetBaseURL(server.URL)
+	client.SetToken("parent-token")
+	source.SetParentClient(&client)
+
+	_, err := client.ListRegions(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer proxy-token-1", lastAuthorization)
+
+	// Cached tokens are reused until they are about to expire
+	_, err = client.ListTypes(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer proxy-token-1", lastAuthorization)
+	require.Equal(t, 1, issued)
+
+	now = now.Add(14 * time.Minute)
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "proxy-token-2", token)
+	require.Equal(t, 2, issued)
+}
+
+func TestChildAccountTokenSource_noParent(t *testing.T) {
+	_, err := NewChildAccountTokenSource("child-euuid").Token(context.Background())
+	require.Error(t, err)
+}
+
+func TestNewAuthTransport_tokenCommandWithChildAccount(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var lastAuthorization string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost && r.URL.Path == "/v4/account/child-accounts/child-euuid/token" {
+			// Proxy tokens must be issued with the token from the token command
+			require.Equal(t, "Bearer command-token", r.Header.Get("Authorization"))
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"token":  "proxy-token",
+				"expiry": now.Add(15 * time.Minute).Format("2006-01-02T15:04:05"),
+			})
+			return
+		}
+
+		lastAuthorization = r.Header.Get("Authorization")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []any{}, "page": 1, "pages": 1, "results": 0})
+	}))
+	defer server.Close()
+
+	tokenSource := NewTokenCommandSource([]string{"get-token"})
+	tokenSource.run = func(ctx context.Context, command []string) ([]byte, error) {
+		return []byte("command-token"), nil
+	}
+
+	transport, childAccountTokens := NewAuthTransport(http.DefaultTransport, tokenSource, "child-euuid")
+	require.NotNil(t, childAccountTokens)
+
+	childAccountTokens.now = func() time.Time { return now }
+
+	client := linodego.NewClient(&http.Client{Transport: transport})
+	client.SetBaseURL(server.URL)
+	childAccountTokens.SetParentClient(&client)
+
+	// API requests must run as the child account rather than the parent account
+	_, err := client.ListRegions(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer proxy-token", lastAuthorization)
+}
+
+func TestNewAuthTransport_tokenCommandOnly(t *testing.T) {
+	var lastAuthorization string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastAuthorization = r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []any{}, "page": 1, "pages": 1, "results": 0})
+	}))
+	defer server.Close()
+
+	tokenSource := NewTokenCommandSource([]string{"get-token"})
+	tokenSource.run = func(ctx context.Context, command []string) ([]byte, error) {
+		return []byte("command-token"), nil
+	}
+
+	transport, childAccountTokens := NewAuthTransport(http.DefaultTransport, tokenSource, "")
+	require.Nil(t, childAccountTokens)
+
+	client := linodego.NewClient(&http.Client{Transport: transport})
+	client.SetBaseURL(server.URL)
+
+	_, err := client.ListRegions(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer command-token", lastAuthorization)
+}
@@ -27,32 +27,48 @@ type ProviderMeta struct {
 
 // Config represents the Linode provider configuration.
 type Config struct {
-	AccessToken string
-	APIURL      string
-	APIVersion  string
-	APICAPath   string
-	UAPrefix    string
+	AccessToken  string
+	TokenCommand []string
+	APIURL       string
+	APIVersion   string
+	APICAPath    string
+	UAPrefix     string
+
+	ChildAccountEUUID string
 
 	ConfigPath    string
 	ConfigProfile string
 
 	TerraformVersion string
 
+	// LishPrivateKey is used to read the console output of instances through Lish.
+	LishPrivateKey string
+
 	SkipInstanceReadyPoll        bool
 	SkipInstanceDeletePoll       bool
 	SkipLKEClusterDeletePoll     bool
 	SkipImplicitReboots          bool
 	DisableInternalCache         bool
 	MinRetryDelayMilliseconds    int
+	MaxRequestsPerSecond         float64
+	MaxConcurrentRequests        int
 	MaxRetryDelayMilliseconds    int
 	EventPollMilliseconds        int
 	LKEEventPollMilliseconds     int
 	LKENodeReadyPollMilliseconds int
 
+	RetryPolicies []RetryPolicy
+
+	// DefaultTags are applied to all taggable resources in addition to their own tags.
+	DefaultTags []string
+
 	ObjAccessKey         string
 	ObjSecretKey         string
 	ObjUseTempKeys       bool
 	ObjBucketForceDelete bool
+
+	// HTTPClientModifiers are applied to the HTTP client before the Linode client is created.
+	HTTPClientModifiers []HTTPClientModifier
 }
 
 // Client returns a fully initialized Linode client.
@@ -70,15 +86,55 @@ func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
 		}
 	}
 
+	var transport http.RoundTripper = httpTransport
+
+	rateLimitOpts := RateLimitOptions{
+		MaxRequestsPerSecond:  c.MaxRequestsPerSecond,
+		MaxConcurrentRequests: int64(c.MaxConcurrentRequests),
+	}
+
+	if rateLimitOpts.IsEnabled() {
+		transport = SharedRateLimiter(
+			rateLimitOpts,
+			RateLimitIdentity(
+				c.APIURL,
+				c.AccessToken,
+				c.TokenCommand,
+				c.ChildAccountEUUID,
+				c.ConfigPath,
+				c.ConfigProfile,
+			)...,
+		).Transport(transport)
+	}
+
 	oauth2Client := &http.Client{
 		Transport: NewAPILoggerTransport(
 			logging.NewSubsystemLoggingHTTPTransport(
 				APILoggerSubsystem,
-				httpTransport,
+				transport,
 			),
 		),
 	}
 
+	for _, modifier := range c.HTTPClientModifiers {
+		if err := modifier(oauth2Client); err != nil {
+			return nil, fmt.Errorf("failed to run HTTP client modifier: %w", err)
+		}
+	}
+
+	var tokenSource TokenSource
+	if len(c.TokenCommand) > 0 {
+		tokenSource = NewTokenCommandSource(c.TokenCommand)
+	}
+
+	var childAccountTokens *ChildAccountTokenSource
+
+	oauth2Client.Transport, childAccountTokens = NewAuthTransport(
+		oauth2Client.Transport,
+		tokenSource,
+		c.ChildAccountEUUID,
+	)
+
 	client := linodego.NewClient(oauth2Client)
 
 	client.SetBaseURL(DefaultLinodeURL)
@@ -136,12 +192,20 @@ func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
 		userAgent = c.UAPrefix + " " + userAgent
 	}
 	client.SetUserAgent(userAgent)
-	ApplyAllRetryConditions(&client)
+
+	if err := ApplyAllRetryConditions(&client, c.RetryPolicies...); err != nil {
+		return nil, err
+	}
 
 	// We always want to disable resty debugging in favor
 	// of Terraform transport debugging.
 	client.SetDebug(false)
 
+	// Proxy tokens are issued using the parent token configured above
+	if childAccountTokens != nil {
+		childAccountTokens.SetParentClient(&client)
+	}
+
 	return &client, nil
 }
 
@@ -0,0 +1,264 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+)
+
+const (
+	volumeTypeID       = "volume"
+	nodeBalancerTypeID = "nodebalancer"
+)
+
+// ResourceCost is the estimated cost of a resource in US dollars,
+// derived from the region-specific prices of its type.
+type ResourceCost struct {
+	Hourly  float64
+	Monthly float64
+}
+
+// Add returns the sum of this cost and the given cost.
+func (c ResourceCost) Add(other ResourceCost) ResourceCost {
+	return ResourceCost{
+		Hourly:  c.Hourly + other.Hourly,
+		Monthly: c.Monthly + other.Monthly,
+	}
+}
+
+// Multiply returns this cost multiplied by the given quantity, e.g. the number of nodes or GB.
+func (c ResourceCost) Multiply(quantity int) ResourceCost {
+	return ResourceCost{
+		Hourly:  c.Hourly * float64(quantity),
+		Monthly: c.Monthly * float64(quantity),
+	}
+}
+
+// roundCost rounds a price to remove floating point artifacts, e.g. from float32 prices.
+func roundCost(value float64) float64 {
+	return math.Round(value*1e6) / 1e6
+}
+
+func regionLinodePrice(price *linodego.LinodePrice, regionPrices []linodego.LinodeRegionPrice, region string) ResourceCost {
+	for _, regionPrice := range regionPrices {
+		if regionPrice.ID == region {
+			return ResourceCost{Hourly: float64(regionPrice.Hourly), Monthly: float64(regionPrice.Monthly)}
+		}
+	}
+
+	if price == nil {
+		return ResourceCost{}
+	}
+
+	return ResourceCost{Hourly: float64(price.Hourly), Monthly: float64(price.Monthly)}
+}
+
+// GetLinodeTypeCost returns the cost of a Linode of the given type in the given region,
+// including the Backups add-on if enabled.
+func GetLinodeTypeCost(
+	ctx context.Context,
+	client *linodego.Client,
+	typeID, region string,
+	backupsEnabled bool,
+) (*ResourceCost, error) {
+	// This endpoint is cached by linodego
+	linodeType, err := client.GetType(ctx, typeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Linode type %s: %w", typeID, err)
+	}
+
+	cost := regionLinodePrice(linodeType.Price, linodeType.RegionPrices, region)
+
+	if backupsEnabled && linodeType.Addons != nil && linodeType.Addons.Backups != nil {
+		cost = cost.Add(regionLinodePrice(linodeType.Addons.Backups.Price, linodeType.Addons.Backups.RegionPrices, region))
+	}
+
+	return &cost, nil
+}
+
+// GetVolumeCost returns the cost of a Volume of the given size in GB in the given region.
+func GetVolumeCost(ctx context.Context, client *linodego.Client, region string, size int) (*ResourceCost, error) {
+	// This endpoint is cached by linodego
+	volumeTypes, err := client.ListVolumeTypes(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Volume types: %w", err)
+	}
+
+	for _, volumeType := range volumeTypes {
+		if volumeType.ID != volumeTypeID {
+			continue
+		}
+
+		cost := ResourceCost{Hourly: volumeType.Price.Hourly, Monthly: volumeType.Price.Monthly}
+
+		for _, regionPrice := range volumeType.RegionPrices {
+			if regionPrice.ID == region {
+				cost = ResourceCost{Hourly: regionPrice.Hourly, Monthly: regionPrice.Monthly}
+			}
+		}
+
+		cost = cost.Multiply(size)
+
+		return &cost, nil
+	}
+
+	return nil, fmt.Errorf("volume type %s not found", volumeTypeID)
+}
+
+// GetNodeBalancerCost returns the cost of a NodeBalancer in the given region.
+func GetNodeBalancerCost(ctx context.Context, client *linodego.Client, region string) (*ResourceCost, error) {
+	// This endpoint is cached by linodego
+	nodeBalancerTypes, err := client.ListNodeBalancerTypes(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NodeBalancer types: %w", err)
+	}
+
+	for _, nodeBalancerType := range nodeBalancerTypes {
+		if nodeBalancerType.ID != nodeBalancerTypeID {
+			continue
+		}
+
+		cost := ResourceCost{Hourly: nodeBalancerType.Price.Hourly, Monthly: nodeBalancerType.Price.Monthly}
+
+		for _, regionPrice := range nodeBalancerType.RegionPrices {
+			if regionPrice.ID == region {
+				cost = ResourceCost{Hourly: regionPrice.Hourly, Monthly: regionPrice.Monthly}
+			}
+		}
+
+		return &cost, nil
+	}
+
+	return nil, fmt.Errorf("NodeBalancer type %s not found", nodeBalancerTypeID)
+}
+
+// CostFunc returns the cost of a resource, or nil if it can't be determined
+// because the values it depends on are not known yet.
+type CostFunc func() (*ResourceCost, error)
+
+// SetCost sets the hourly and monthly cost attributes of a resource model to the result of getCost.
+// If the cost can't be determined, unknown values are set to null and known values are preserved.
+func SetCost(ctx context.Context, hourly, monthly *types.Float64, getCost CostFunc) {
+	cost, err := getCost()
+	if err != nil {
+		tflog.Warn(ctx, "Failed to determine the cost of the resource", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	if cost == nil {
+		if hourly.IsUnknown() {
+			*hourly = types.Float64Null()
+		}
+
+		if monthly.IsUnknown() {
+			*monthly = types.Float64Null()
+		}
+
+		return
+	}
+
+	*hourly = types.Float64Value(roundCost(cost.Hourly))
+	*monthly = types.Float64Value(roundCost(cost.Monthly))
+}
+
+// PlanCost sets the planned hourly_cost and monthly_cost attributes to the result of getCost
+// if they are unknown, so that the cost of a resource is known at plan time.
+func PlanCost(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	getCost CostFunc,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var hourly types.Float64
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hourly_cost"), &hourly)...)
+	if resp.Diagnostics.HasError() || !hourly.IsUnknown() {
+		return
+	}
+
+	cost, err := getCost()
+	if err != nil {
+		tflog.Warn(ctx, "Failed to determine the planned cost of the resource", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if cost == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hourly_cost"), roundCost(cost.Hourly))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monthly_cost"), roundCost(cost.Monthly))...)
+}
+
+// SDKv2SetCost sets the hourly_cost and monthly_cost attributes of a resource to the result of getCost.
+// If the cost can't be determined, the current values are preserved.
+func SDKv2SetCost(ctx context.Context, d *schema.ResourceData, getCost CostFunc) {
+	cost, err := getCost()
+	if err != nil {
+		tflog.Warn(ctx, "Failed to determine the cost of the resource", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	if cost == nil {
+		return
+	}
+
+	d.Set("hourly_cost", roundCost(cost.Hourly))
+	d.Set("monthly_cost", roundCost(cost.Monthly))
+}
+
+// SDKv2PlanCost returns a CustomizeDiffFunc setting the planned hourly_cost and monthly_cost
+// attributes of a resource being created or with changes to any of the given attributes.
+// The attributes are planned as computed if the cost can't be determined at plan time.
+func SDKv2PlanCost(
+	getCost func(ctx context.Context, diff *schema.ResourceDiff, client *linodego.Client) CostFunc,
+	keys ...string,
+) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		if diff.Id() != "" && !diff.HasChanges(keys...) {
+			return nil
+		}
+
+		var cost *ResourceCost
+
+		if providerMeta, ok := meta.(*ProviderMeta); ok {
+			var err error
+
+			cost, err = getCost(ctx, diff, &providerMeta.Client)()
+			if err != nil {
+				tflog.Warn(ctx, "Failed to determine the planned cost of the resource", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+
+		if cost == nil {
+			if err := diff.SetNewComputed("hourly_cost"); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("monthly_cost")
+		}
+
+		if err := diff.SetNew("hourly_cost", roundCost(cost.Hourly)); err != nil {
+			return err
+		}
+
+		return diff.SetNew("monthly_cost", roundCost(cost.Monthly))
+	}
+}
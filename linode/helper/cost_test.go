@@ -0,0 +1,141 @@
+//go:build unit
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+var costTestResponses = map[string]string{
+	"/v4/linode/types/g6-standard-1": `{
+		"id": "g6-standard-1",
+		"price": {"hourly": 0.018, "monthly": 12},
+		"region_prices": [{"id": "id-cgk", "hourly": 0.0216, "monthly": 14.4}],
+		"addons": {
+			"backups": {
+				"price": {"hourly": 0.004, "monthly": 2.5},
+				"region_prices": [{"id": "id-cgk", "hourly": 0.0045, "monthly": 3}]
+			}
+		}
+	}`,
+	"/v4/volumes/types": `{
+		"data": [{
+			"id": "volume",
+			"price": {"hourly": 0.00015, "monthly": 0.1},
+			"region_prices": [{"id": "id-cgk", "hourly": 0.00018, "monthly": 0.12}]
+		}],
+		"page": 1, "pages": 1, "results": 1
+	}`,
+	"/v4/nodebalancers/types": `{
+		"data": [{
+			"id": "nodebalancer",
+			"price": {"hourly": 0.015, "monthly": 10},
+			"region_prices": [{"id": "id-cgk", "hourly": 0.018, "monthly": 12}]
+		}],
+		"page": 1, "pages": 1, "results": 1
+	}`,
+}
+
+func newCostTestClient(t *testing.T) *linodego.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body, ok := costTestResponses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+	client.UseCache(false)
+
+	return &client
+}
+
+func roundedCost(cost *ResourceCost) ResourceCost {
+	return ResourceCost{Hourly: roundCost(cost.Hourly), Monthly: roundCost(cost.Monthly)}
+}
+
+func TestGetLinodeTypeCost(t *testing.T) {
+	client := newCostTestClient(t)
+	ctx := context.Background()
+
+	cost, err := GetLinodeTypeCost(ctx, client, "g6-standard-1", "us-east", false)
+	require.NoError(t, err)
+	require.Equal(t, ResourceCost{Hourly: 0.018, Monthly: 12}, roundedCost(cost))
+
+	cost, err = GetLinodeTypeCost(ctx, client, "g6-standard-1", "id-cgk", true)
+	require.NoError(t, err)
+	require.Equal(t, ResourceCost{Hourly: 0.0261, Monthly: 17.4}, roundedCost(cost))
+
+	_, err = GetLinodeTypeCost(ctx, client, "g6-fake-1", "us-east", false)
+	require.Error(t, err)
+}
+
+func TestGetVolumeCost(t *testing.T) {
+	client := newCostTestClient(t)
+	ctx := context.Background()
+
+	cost, err := GetVolumeCost(ctx, client, "us-east", 20)
+	require.NoError(t, err)
+	require.Equal(t, ResourceCost{Hourly: 0.003, Monthly: 2}, roundedCost(cost))
+
+	cost, err = GetVolumeCost(ctx, client, "id-cgk", 20)
+	require.NoError(t, err)
+	require.Equal(t, ResourceCost{Hourly: 0.0036, Monthly: 2.4}, roundedCost(cost))
+}
+
+func TestGetNodeBalancerCost(t *testing.T) {
+	client := newCostTestClient(t)
+	ctx := context.Background()
+
+	cost, err := GetNodeBalancerCost(ctx, client, "us-east")
+	require.NoError(t, err)
+	require.Equal(t, ResourceCost{Hourly: 0.015, Monthly: 10}, roundedCost(cost))
+
+	cost, err = GetNodeBalancerCost(ctx, client, "id-cgk")
+	require.NoError(t, err)
+	require.Equal(t, ResourceCost{Hourly: 0.018, Monthly: 12}, roundedCost(cost))
+}
+
+func TestSetCost(t *testing.T) {
+	ctx := context.Background()
+
+	hourly, monthly := types.Float64Unknown(), types.Float64Unknown()
+	SetCost(ctx, &hourly, &monthly, func() (*ResourceCost, error) {
+		return &ResourceCost{Hourly: 0.0180000001, Monthly: 12}, nil
+	})
+	require.Equal(t, types.Float64Value(0.018), hourly)
+	require.Equal(t, types.Float64Value(12), monthly)
+
+	// Known values are preserved if the cost can't be determined
+	SetCost(ctx, &hourly, &monthly, func() (*ResourceCost, error) {
+		return nil, errors.New("unauthorized")
+	})
+	require.Equal(t, types.Float64Value(0.018), hourly)
+	require.Equal(t, types.Float64Value(12), monthly)
+
+	// Unknown values are nulled if the cost can't be determined
+	hourly, monthly = types.Float64Unknown(), types.Float64Unknown()
+	SetCost(ctx, &hourly, &monthly, func() (*ResourceCost, error) {
+		return nil, errors.New("unauthorized")
+	})
+	require.True(t, hourly.IsNull())
+	require.True(t, monthly.IsNull())
+}
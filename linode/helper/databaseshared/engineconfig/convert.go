@@ -0,0 +1,174 @@
+package engineconfig
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IsConfigured returns whether the given engine_config value should be used
+// to build engine config API requests.
+func IsConfigured(value types.Object) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// Changed returns whether the planned engine_config value differs from the
+// value in state in a way that requires an API update.
+func Changed(state, plan types.Object) bool {
+	return IsConfigured(plan) && !state.Equal(plan)
+}
+
+// Expand converts an engine_config object into its API representation,
+// which is sent to the API as-is (see request.go).
+// Unknown and null values are omitted so the API keeps their current values,
+// except for nullable settings which are explicitly cleared.
+func Expand(value types.Object, settings []Setting) map[string]any {
+	if !IsConfigured(value) {
+		return nil
+	}
+
+	attributes := value.Attributes()
+	result := make(map[string]any)
+
+	for _, s := range settings {
+		v, ok := attributes[s.Name]
+		if !ok || v.IsUnknown() {
+			continue
+		}
+
+		if v.IsNull() {
+			if s.Nullable {
+				result[s.APIName] = nil
+			}
+			continue
+		}
+
+		switch v := v.(type) {
+		case types.Object:
+			if children := Expand(v, s.Children); len(children) > 0 {
+				result[s.APIName] = children
+			}
+		case types.Bool:
+			result[s.APIName] = v.ValueBool()
+		case types.Float64:
+			result[s.APIName] = v.ValueFloat64()
+		case types.Int64:
+			result[s.APIName] = v.ValueInt64()
+		case types.String:
+			result[s.APIName] = v.ValueString()
+		}
+	}
+
+	return result
+}
+
+// Flatten converts an engine config returned by the API into an
+// engine_config object. Known values in prior are kept when preserveKnown
+// is true.
+func Flatten(
+	prior types.Object,
+	engineConfig any,
+	settings []Setting,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) types.Object {
+	raw, err := toMap(engineConfig)
+	if err != nil {
+		diags.AddError("Failed to flatten engine_config", err.Error())
+		return types.ObjectNull(AttributeTypes(settings))
+	}
+
+	return flattenObject(prior, raw, settings, preserveKnown, diags)
+}
+
+func flattenObject(
+	prior types.Object,
+	raw map[string]any,
+	settings []Setting,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) types.Object {
+	var priorAttributes map[string]attr.Value
+	if IsConfigured(prior) {
+		priorAttributes = prior.Attributes()
+	}
+
+	values := make(map[string]attr.Value, len(settings))
+
+	for _, s := range settings {
+		priorValue, hasPrior := priorAttributes[s.Name]
+
+		if s.Type == SettingTypeGroup {
+			priorGroup, _ := priorValue.(types.Object)
+			rawGroup, _ := raw[s.APIName].(map[string]any)
+
+			values[s.Name] = flattenObject(priorGroup, rawGroup, s.Children, preserveKnown, diags)
+			continue
+		}
+
+		if preserveKnown && hasPrior && !priorValue.IsUnknown() {
+			values[s.Name] = priorValue
+			continue
+		}
+
+		values[s.Name] = flattenValue(s, raw[s.APIName])
+	}
+
+	result, d := types.ObjectValue(AttributeTypes(settings), values)
+	diags.Append(d...)
+
+	return result
+}
+
+func flattenValue(s Setting, raw any) attr.Value {
+	switch s.Type {
+	case SettingTypeBool:
+		if v, ok := raw.(bool); ok {
+			return types.BoolValue(v)
+		}
+		return types.BoolNull()
+	case SettingTypeFloat64:
+		if v, ok := raw.(json.Number); ok {
+			if f, err := v.Float64(); err == nil {
+				return types.Float64Value(f)
+			}
+		}
+		return types.Float64Null()
+	case SettingTypeInt64:
+		if v, ok := raw.(json.Number); ok {
+			if i, err := v.Int64(); err == nil {
+				return types.Int64Value(i)
+			}
+		}
+		return types.Int64Null()
+	case SettingTypeString:
+		if v, ok := raw.(string); ok {
+			return types.StringValue(v)
+		}
+		return types.StringNull()
+	}
+
+	return nil
+}
+
+// toMap converts the given value into a generic map through its JSON
+// representation, preserving number precision.
+func toMap(value any) (map[string]any, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	var result map[string]any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
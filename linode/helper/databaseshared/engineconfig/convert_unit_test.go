@@ -0,0 +1,160 @@
+//go:build unit
+
+package engineconfig_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
+	"github.com/stretchr/testify/require"
+)
+
+var testSettings = []engineconfig.Setting{
+	{
+		Name:    "pg",
+		APIName: "pg",
+		Type:    engineconfig.SettingTypeGroup,
+		Children: []engineconfig.Setting{
+			{Name: "jit", APIName: "jit", Type: engineconfig.SettingTypeBool},
+			{Name: "max_buckets", APIName: "pg_stat_monitor.max_buckets", Type: engineconfig.SettingTypeInt64},
+			{Name: "stopword_table", APIName: "stopword_table", Type: engineconfig.SettingTypeString, Nullable: true},
+		},
+	},
+	{Name: "work_mem", APIName: "work_mem", Type: engineconfig.SettingTypeInt64},
+	{Name: "shared_buffers_percentage", APIName: "shared_buffers_percentage", Type: engineconfig.SettingTypeFloat64},
+}
+
+type testEngineConfigPG struct {
+	JIT        *bool `json:"jit,omitempty"`
+	MaxBuckets *int  `json:"pg_stat_monitor.max_buckets,omitempty"`
+}
+
+type testEngineConfig struct {
+	PG      *testEngineConfigPG `json:"pg,omitempty"`
+	WorkMem *int                `json:"work_mem,omitempty"`
+	Buffers *float64            `json:"shared_buffers_percentage,omitempty"`
+}
+
+func testObject(t *testing.T, pg map[string]attr.Value, workMem types.Int64, buffers types.Float64) types.Object {
+	attrTypes := engineconfig.AttributeTypes(testSettings)
+
+	pgValue, d := types.ObjectValue(attrTypes["pg"].(types.ObjectType).AttrTypes, pg)
+	require.False(t, d.HasError(), d.Errors())
+
+	result, d := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"pg":                        pgValue,
+		"work_mem":                  workMem,
+		"shared_buffers_percentage": buffers,
+	})
+	require.False(t, d.HasError(), d.Errors())
+
+	return result
+}
+
+func TestExpand(t *testing.T) {
+	value := testObject(
+		t,
+		map[string]attr.Value{
+			"jit":            types.BoolValue(true),
+			"max_buckets":    types.Int64Unknown(),
+			"stopword_table": types.StringNull(),
+		},
+		types.Int64Value(64),
+		types.Float64Null(),
+	)
+
+	result := engineconfig.Expand(value, testSettings)
+
+	require.Equal(t, map[string]any{
+		"pg": map[string]any{
+			"jit":            true,
+			"stopword_table": nil,
+		},
+		"work_mem": int64(64),
+	}, result)
+}
+
+func TestExpand_unconfigured(t *testing.T) {
+	require.Nil(t, engineconfig.Expand(types.ObjectUnknown(engineconfig.AttributeTypes(testSettings)), testSettings))
+	require.Nil(t, engineconfig.Expand(types.ObjectNull(engineconfig.AttributeTypes(testSettings)), testSettings))
+}
+
+func TestFlatten(t *testing.T) {
+	jit := true
+	maxBuckets := 3
+	workMem := 32
+
+	apiConfig := testEngineConfig{
+		PG: &testEngineConfigPG{
+			JIT:        &jit,
+			MaxBuckets: &maxBuckets,
+		},
+		WorkMem: &workMem,
+	}
+
+	var d diag.Diagnostics
+
+	result := engineconfig.Flatten(
+		types.ObjectUnknown(engineconfig.AttributeTypes(testSettings)),
+		apiConfig,
+		testSettings,
+		false,
+		&d,
+	)
+	require.False(t, d.HasError(), d.Errors())
+
+	expected := testObject(
+		t,
+		map[string]attr.Value{
+			"jit":            types.BoolValue(true),
+			"max_buckets":    types.Int64Value(3),
+			"stopword_table": types.StringNull(),
+		},
+		types.Int64Value(32),
+		types.Float64Null(),
+	)
+
+	require.True(t, expected.Equal(result), result.String())
+}
+
+func TestFlatten_preserveKnown(t *testing.T) {
+	workMem := 32
+
+	prior := testObject(
+		t,
+		map[string]attr.Value{
+			"jit":            types.BoolValue(false),
+			"max_buckets":    types.Int64Unknown(),
+			"stopword_table": types.StringUnknown(),
+		},
+		types.Int64Value(16),
+		types.Float64Unknown(),
+	)
+
+	var d diag.Diagnostics
+
+	result := engineconfig.Flatten(
+		prior,
+		testEngineConfig{WorkMem: &workMem},
+		testSettings,
+		true,
+		&d,
+	)
+	require.False(t, d.HasError(), d.Errors())
+
+	expected := testObject(
+		t,
+		map[string]attr.Value{
+			"jit":            types.BoolValue(false),
+			"max_buckets":    types.Int64Null(),
+			"stopword_table": types.StringNull(),
+		},
+		types.Int64Value(16),
+		types.Float64Null(),
+	)
+
+	require.True(t, expected.Equal(result), result.String())
+}
@@ -0,0 +1,393 @@
+// Command gen generates the engine setting lists of the engineconfig package
+// from the metadata reported by the Linode API's `/databases/<engine>/config`
+// endpoints.
+//
+// Usage:
+//
+//	go run ./gen -engine postgresql -var PostgreSQLSettings [-fetch]
+//
+// When -fetch is set, the metadata snapshot is refreshed from the API using
+// the LINODE_TOKEN, LINODE_URL and LINODE_API_VERSION environment variables
+// before generating code.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var invalidAttributeChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type setting struct {
+	Name            string
+	APIName         string
+	Type            string
+	Description     string
+	Minimum         *float64
+	Maximum         *float64
+	MinLength       *int64
+	MaxLength       *int64
+	Pattern         string
+	Enum            []string
+	Nullable        bool
+	RequiresRestart bool
+	Children        []setting
+}
+
+func main() {
+	engine := flag.String("engine", "", "The database engine to generate settings for (e.g. postgresql)")
+	varName := flag.String("var", "", "The name of the generated settings variable")
+	metadataDir := flag.String("metadata", "metadata", "The directory containing engine metadata snapshots")
+	fetch := flag.Bool("fetch", false, "Refresh the metadata snapshot from the Linode API")
+	flag.Parse()
+
+	if *engine == "" || *varName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	metadataPath := filepath.Join(*metadataDir, *engine+".json")
+
+	if *fetch {
+		if err := fetchMetadata(*engine, metadataPath); err != nil {
+			log.Fatalf("failed to fetch %s metadata: %s", *engine, err)
+		}
+	}
+
+	raw, err := os.ReadFile(metadataPath) // #nosec G304 -- path is provided by go:generate
+	if err != nil {
+		log.Fatalf("failed to read metadata: %s", err)
+	}
+
+	settings, err := parseMetadata(raw)
+	if err != nil {
+		log.Fatalf("failed to parse metadata: %s", err)
+	}
+
+	source, err := render(*engine, *varName, metadataPath, settings)
+	if err != nil {
+		log.Fatalf("failed to render settings: %s", err)
+	}
+
+	output := *engine + "_gen.go"
+	if err := os.WriteFile(output, source, 0o600); err != nil {
+		log.Fatalf("failed to write %s: %s", output, err)
+	}
+}
+
+func fetchMetadata(engine, metadataPath string) error {
+	token := os.Getenv("LINODE_TOKEN")
+	if token == "" {
+		return fmt.Errorf("LINODE_TOKEN must be set")
+	}
+
+	baseURL := os.Getenv("LINODE_URL")
+	if baseURL == "" {
+		baseURL = "https://api.linode.com"
+	}
+
+	apiVersion := os.Getenv("LINODE_API_VERSION")
+	if apiVersion == "" {
+		apiVersion = "v4"
+	}
+
+	url := fmt.Sprintf("%s/%s/databases/%s/config", strings.TrimSuffix(baseURL, "/"), apiVersion, engine)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err != nil {
+		return err
+	}
+	indented.WriteString("\n")
+
+	return os.WriteFile(metadataPath, indented.Bytes(), 0o600)
+}
+
+func parseMetadata(raw []byte) ([]setting, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var metadata map[string]any
+	if err := decoder.Decode(&metadata); err != nil {
+		return nil, err
+	}
+
+	return parseGroup(metadata)
+}
+
+func parseGroup(group map[string]any) ([]setting, error) {
+	keys := make([]string, 0, len(group))
+	for key := range group {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	result := make([]setting, 0, len(keys))
+
+	for _, key := range keys {
+		value, ok := group[key].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected metadata for %s: %v", key, group[key])
+		}
+
+		s := setting{
+			Name:    invalidAttributeChars.ReplaceAllString(strings.ToLower(key), "_"),
+			APIName: key,
+		}
+
+		if !isSetting(value) {
+			children, err := parseGroup(value)
+			if err != nil {
+				return nil, err
+			}
+
+			s.Type = "SettingTypeGroup"
+			s.Children = children
+			result = append(result, s)
+			continue
+		}
+
+		if err := s.parse(value); err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		if s.Type == "" {
+			log.Printf("skipping %s: unsupported type %v", key, value["type"])
+			continue
+		}
+
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// isSetting returns whether the given metadata object describes a single
+// setting rather than a group of settings.
+func isSetting(value map[string]any) bool {
+	switch value["type"].(type) {
+	case string, []any:
+		_, hasRestart := value["requires_restart"]
+		_, hasDescription := value["description"]
+		return hasRestart || hasDescription
+	}
+
+	return false
+}
+
+func (s *setting) parse(value map[string]any) error {
+	var apiTypes []string
+
+	switch t := value["type"].(type) {
+	case string:
+		apiTypes = []string{t}
+	case []any:
+		for _, v := range t {
+			if str, ok := v.(string); ok {
+				apiTypes = append(apiTypes, str)
+			}
+		}
+	}
+
+	if slices.Contains(apiTypes, "null") {
+		s.Nullable = true
+		apiTypes = slices.DeleteFunc(apiTypes, func(t string) bool { return t == "null" })
+	}
+
+	s.Description, _ = value["description"].(string)
+	s.RequiresRestart, _ = value["requires_restart"].(bool)
+	s.Pattern, _ = value["pattern"].(string)
+
+	var err error
+
+	if s.Minimum, err = floatField(value, "minimum"); err != nil {
+		return err
+	}
+
+	if s.Maximum, err = floatField(value, "maximum"); err != nil {
+		return err
+	}
+
+	if s.MinLength, err = intField(value, "minLength"); err != nil {
+		return err
+	}
+
+	if s.MaxLength, err = intField(value, "maxLength"); err != nil {
+		return err
+	}
+
+	if enum, ok := value["enum"].([]any); ok {
+		for _, v := range enum {
+			if str, ok := v.(string); ok {
+				s.Enum = append(s.Enum, str)
+			}
+		}
+	}
+
+	if len(apiTypes) != 1 {
+		return nil
+	}
+
+	switch apiTypes[0] {
+	case "boolean":
+		s.Type = "SettingTypeBool"
+	case "number":
+		s.Type = "SettingTypeFloat64"
+	case "string":
+		s.Type = "SettingTypeString"
+	case "integer":
+		s.Type = "SettingTypeInt64"
+
+		// Some integer settings (e.g. group_concat_max_len) accept values
+		// beyond the int64 range, so they are exposed as floats.
+		if (s.Maximum != nil && *s.Maximum > math.MaxInt64) ||
+			(s.Minimum != nil && *s.Minimum < math.MinInt64) {
+			s.Type = "SettingTypeFloat64"
+		}
+	}
+
+	return nil
+}
+
+func floatField(value map[string]any, key string) (*float64, error) {
+	n, ok := value[key].(json.Number)
+	if !ok {
+		return nil, nil
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return &f, nil
+}
+
+func intField(value map[string]any, key string) (*int64, error) {
+	n, ok := value[key].(json.Number)
+	if !ok {
+		return nil, nil
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return &i, nil
+}
+
+func render(engine, varName, metadataPath string, settings []setting) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by engineconfig/gen from %s; DO NOT EDIT.\n\n", filepath.ToSlash(metadataPath))
+	buf.WriteString("package engineconfig\n\n")
+	fmt.Fprintf(&buf, "// %s describes the engine_config settings of the %s database engine.\n", varName, engine)
+	fmt.Fprintf(&buf, "var %s = []Setting{\n", varName)
+	renderSettings(&buf, settings)
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func renderSettings(buf *bytes.Buffer, settings []setting) {
+	for _, s := range settings {
+		buf.WriteString("{\n")
+		fmt.Fprintf(buf, "Name: %s,\n", strconv.Quote(s.Name))
+		fmt.Fprintf(buf, "APIName: %s,\n", strconv.Quote(s.APIName))
+		fmt.Fprintf(buf, "Type: %s,\n", s.Type)
+
+		if s.Description != "" {
+			fmt.Fprintf(buf, "Description: %s,\n", strconv.Quote(s.Description))
+		}
+
+		if s.Minimum != nil {
+			fmt.Fprintf(buf, "Minimum: floatPtr(%s),\n", strconv.FormatFloat(*s.Minimum, 'f', -1, 64))
+		}
+
+		if s.Maximum != nil {
+			fmt.Fprintf(buf, "Maximum: floatPtr(%s),\n", strconv.FormatFloat(*s.Maximum, 'f', -1, 64))
+		}
+
+		if s.MinLength != nil {
+			fmt.Fprintf(buf, "MinLength: int64Ptr(%d),\n", *s.MinLength)
+		}
+
+		if s.MaxLength != nil {
+			fmt.Fprintf(buf, "MaxLength: int64Ptr(%d),\n", *s.MaxLength)
+		}
+
+		if s.Pattern != "" {
+			fmt.Fprintf(buf, "Pattern: %s,\n", quoteRaw(s.Pattern))
+		}
+
+		if len(s.Enum) > 0 {
+			quoted := make([]string, len(s.Enum))
+			for i, v := range s.Enum {
+				quoted[i] = strconv.Quote(v)
+			}
+			fmt.Fprintf(buf, "Enum: []string{%s},\n", strings.Join(quoted, ", "))
+		}
+
+		if s.Nullable {
+			buf.WriteString("Nullable: true,\n")
+		}
+
+		if s.RequiresRestart {
+			buf.WriteString("RequiresRestart: true,\n")
+		}
+
+		if len(s.Children) > 0 {
+			buf.WriteString("Children: []Setting{\n")
+			renderSettings(buf, s.Children)
+			buf.WriteString("},\n")
+		}
+
+		buf.WriteString("},\n")
+	}
+}
+
+// quoteRaw prefers raw string literals for regular expressions.
+func quoteRaw(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+
+	return "`" + s + "`"
+}
@@ -0,0 +1,8 @@
+package engineconfig
+
+// The engine setting lists are generated from the metadata snapshots in the
+// metadata directory. Run `go generate` with `-fetch` added to the commands
+// below (and LINODE_TOKEN set) to refresh the snapshots from the API.
+
+//go:generate go run ./gen -engine mysql -var MySQLSettings
+//go:generate go run ./gen -engine postgresql -var PostgreSQLSettings
@@ -0,0 +1,242 @@
+// Code generated by engineconfig/gen from metadata/mysql.json; DO NOT EDIT.
+
+package engineconfig
+
+// MySQLSettings describes the engine_config settings of the mysql database engine.
+var MySQLSettings = []Setting{
+	{
+		Name:        "binlog_retention_period",
+		APIName:     "binlog_retention_period",
+		Type:        SettingTypeInt64,
+		Description: "The minimum amount of time in seconds to keep binlog entries before deletion. This may be extended for services that require binlog entries for longer than the default for example if using the MySQL Debezium Kafka connector.",
+		Minimum:     floatPtr(600),
+		Maximum:     floatPtr(86400),
+	},
+	{
+		Name:    "mysql",
+		APIName: "mysql",
+		Type:    SettingTypeGroup,
+		Children: []Setting{
+			{
+				Name:        "connect_timeout",
+				APIName:     "connect_timeout",
+				Type:        SettingTypeInt64,
+				Description: "The number of seconds that the mysqld server waits for a connect packet before responding with Bad handshake.",
+				Minimum:     floatPtr(2),
+				Maximum:     floatPtr(3600),
+			},
+			{
+				Name:        "default_time_zone",
+				APIName:     "default_time_zone",
+				Type:        SettingTypeString,
+				Description: "Default server time zone as an offset from UTC (from -12:00 to +12:00), a time zone name, or 'SYSTEM' to use the MySQL server default.",
+				Minimum:     floatPtr(2),
+				Maximum:     floatPtr(100),
+				MinLength:   int64Ptr(2),
+				MaxLength:   int64Ptr(100),
+				Pattern:     `^([-+][\d:]*|[\w/]*)$`,
+			},
+			{
+				Name:        "group_concat_max_len",
+				APIName:     "group_concat_max_len",
+				Type:        SettingTypeFloat64,
+				Description: "The maximum permitted result length in bytes for the GROUP_CONCAT() function.",
+				Minimum:     floatPtr(4),
+				Maximum:     floatPtr(18446744073709552000),
+			},
+			{
+				Name:        "information_schema_stats_expiry",
+				APIName:     "information_schema_stats_expiry",
+				Type:        SettingTypeInt64,
+				Description: "The time, in seconds, before cached statistics expire.",
+				Minimum:     floatPtr(900),
+				Maximum:     floatPtr(31536000),
+			},
+			{
+				Name:        "innodb_change_buffer_max_size",
+				APIName:     "innodb_change_buffer_max_size",
+				Type:        SettingTypeInt64,
+				Description: "Maximum size for the InnoDB change buffer, as a percentage of the total size of the buffer pool. Default is 25.",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(50),
+			},
+			{
+				Name:        "innodb_flush_neighbors",
+				APIName:     "innodb_flush_neighbors",
+				Type:        SettingTypeInt64,
+				Description: "Specifies whether flushing a page from the InnoDB buffer pool also flushes other dirty pages in the same extent (default is 1): 0 - dirty pages in the same extent are not flushed, 1 - flush contiguous dirty pages in the same extent, 2 - flush dirty pages in the same extent.",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(2),
+			},
+			{
+				Name:            "innodb_ft_min_token_size",
+				APIName:         "innodb_ft_min_token_size",
+				Type:            SettingTypeInt64,
+				Description:     "Minimum length of words that are stored in an InnoDB FULLTEXT index. Changing this parameter will lead to a restart of the MySQL service.",
+				Minimum:         floatPtr(0),
+				Maximum:         floatPtr(16),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "innodb_ft_server_stopword_table",
+				APIName:     "innodb_ft_server_stopword_table",
+				Type:        SettingTypeString,
+				Description: "This option is used to specify your own InnoDB FULLTEXT index stopword list for all InnoDB tables.",
+				MaxLength:   int64Ptr(1024),
+				Pattern:     `^.+/.+$`,
+				Nullable:    true,
+			},
+			{
+				Name:        "innodb_lock_wait_timeout",
+				APIName:     "innodb_lock_wait_timeout",
+				Type:        SettingTypeInt64,
+				Description: "The length of time in seconds an InnoDB transaction waits for a row lock before giving up. Default is 120.",
+				Minimum:     floatPtr(1),
+				Maximum:     floatPtr(3600),
+			},
+			{
+				Name:        "innodb_log_buffer_size",
+				APIName:     "innodb_log_buffer_size",
+				Type:        SettingTypeInt64,
+				Description: "The size in bytes of the buffer that InnoDB uses to write to the log files on disk.",
+				Minimum:     floatPtr(1048576),
+				Maximum:     floatPtr(4294967295),
+			},
+			{
+				Name:        "innodb_online_alter_log_max_size",
+				APIName:     "innodb_online_alter_log_max_size",
+				Type:        SettingTypeInt64,
+				Description: "The upper limit in bytes on the size of the temporary log files used during online DDL operations for InnoDB tables.",
+				Minimum:     floatPtr(65536),
+				Maximum:     floatPtr(1099511627776),
+			},
+			{
+				Name:            "innodb_read_io_threads",
+				APIName:         "innodb_read_io_threads",
+				Type:            SettingTypeInt64,
+				Description:     "The number of I/O threads for read operations in InnoDB. Default is 4. Changing this parameter will lead to a restart of the MySQL service.",
+				Minimum:         floatPtr(1),
+				Maximum:         floatPtr(64),
+				RequiresRestart: true,
+			},
+			{
+				Name:            "innodb_rollback_on_timeout",
+				APIName:         "innodb_rollback_on_timeout",
+				Type:            SettingTypeBool,
+				Description:     "When enabled a transaction timeout causes InnoDB to abort and roll back the entire transaction. Changing this parameter will lead to a restart of the MySQL service.",
+				RequiresRestart: true,
+			},
+			{
+				Name:        "innodb_thread_concurrency",
+				APIName:     "innodb_thread_concurrency",
+				Type:        SettingTypeInt64,
+				Description: "Defines the maximum number of threads permitted inside of InnoDB. Default is 0 (infinite concurrency - no limit).",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(1000),
+			},
+			{
+				Name:            "innodb_write_io_threads",
+				APIName:         "innodb_write_io_threads",
+				Type:            SettingTypeInt64,
+				Description:     "The number of I/O threads for write operations in InnoDB. Default is 4. Changing this parameter will lead to a restart of the MySQL service.",
+				Minimum:         floatPtr(1),
+				Maximum:         floatPtr(64),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "interactive_timeout",
+				APIName:     "interactive_timeout",
+				Type:        SettingTypeInt64,
+				Description: "The number of seconds the server waits for activity on an interactive connection before closing it.",
+				Minimum:     floatPtr(30),
+				Maximum:     floatPtr(604800),
+			},
+			{
+				Name:        "internal_tmp_mem_storage_engine",
+				APIName:     "internal_tmp_mem_storage_engine",
+				Type:        SettingTypeString,
+				Description: "The storage engine for in-memory internal temporary tables.",
+				Enum:        []string{"TempTable", "MEMORY"},
+			},
+			{
+				Name:        "max_allowed_packet",
+				APIName:     "max_allowed_packet",
+				Type:        SettingTypeInt64,
+				Description: "Size of the largest message in bytes that can be received by the server. Default is 67108864 (64M).",
+				Minimum:     floatPtr(102400),
+				Maximum:     floatPtr(1073741824),
+			},
+			{
+				Name:        "max_heap_table_size",
+				APIName:     "max_heap_table_size",
+				Type:        SettingTypeInt64,
+				Description: "Limits the size of internal in-memory tables. Also set tmp_table_size. Default is 16777216 (16M).",
+				Minimum:     floatPtr(1048576),
+				Maximum:     floatPtr(1073741824),
+			},
+			{
+				Name:            "net_buffer_length",
+				APIName:         "net_buffer_length",
+				Type:            SettingTypeInt64,
+				Description:     "Start sizes of connection buffer and result buffer. Default is 16384 (16K). Changing this parameter will lead to a restart of the MySQL service.",
+				Minimum:         floatPtr(1024),
+				Maximum:         floatPtr(1048576),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "net_read_timeout",
+				APIName:     "net_read_timeout",
+				Type:        SettingTypeInt64,
+				Description: "The number of seconds to wait for more data from a connection before aborting the read.",
+				Minimum:     floatPtr(1),
+				Maximum:     floatPtr(3600),
+			},
+			{
+				Name:        "net_write_timeout",
+				APIName:     "net_write_timeout",
+				Type:        SettingTypeInt64,
+				Description: "The number of seconds to wait for a block to be written to a connection before aborting the write.",
+				Minimum:     floatPtr(1),
+				Maximum:     floatPtr(3600),
+			},
+			{
+				Name:        "sort_buffer_size",
+				APIName:     "sort_buffer_size",
+				Type:        SettingTypeInt64,
+				Description: "Sort buffer size in bytes for ORDER BY optimization. Default is 262144 (256K).",
+				Minimum:     floatPtr(32768),
+				Maximum:     floatPtr(1073741824),
+			},
+			{
+				Name:        "sql_mode",
+				APIName:     "sql_mode",
+				Type:        SettingTypeString,
+				Description: "Global SQL mode. Set to empty to use MySQL server defaults. When creating a new service and not setting this field Aiven default SQL mode (strict, SQL standard compliant) will be assigned.",
+				MaxLength:   int64Ptr(1024),
+				Pattern:     `^[A-Z_]*(,[A-Z_]+)*$`,
+			},
+			{
+				Name:        "sql_require_primary_key",
+				APIName:     "sql_require_primary_key",
+				Type:        SettingTypeBool,
+				Description: "Require primary key to be defined for new tables or old tables modified with ALTER TABLE and fail if missing. It is recommended to always have primary keys because various functionality may break if any large table is missing them.",
+			},
+			{
+				Name:        "tmp_table_size",
+				APIName:     "tmp_table_size",
+				Type:        SettingTypeInt64,
+				Description: "Limits the size of internal in-memory tables. Also set max_heap_table_size. Default is 16777216 (16M).",
+				Minimum:     floatPtr(1048576),
+				Maximum:     floatPtr(1073741824),
+			},
+			{
+				Name:        "wait_timeout",
+				APIName:     "wait_timeout",
+				Type:        SettingTypeInt64,
+				Description: "The number of seconds the server waits for activity on a noninteractive connection before closing it.",
+				Minimum:     floatPtr(1),
+				Maximum:     floatPtr(2147483),
+			},
+		},
+	},
+}
@@ -0,0 +1,206 @@
+package engineconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// LegacyAttributePrefix is the prefix of the flattened engine config
+// attributes that predate the nested engine_config attribute.
+const LegacyAttributePrefix = AttributeName + "_"
+
+// restartHintModifier adds a plan warning when a setting that requires
+// a database restart is changed.
+type restartHintModifier struct{}
+
+func (m restartHintModifier) Description(_ context.Context) string {
+	return "Warns when changing this setting will restart the database cluster."
+}
+
+func (m restartHintModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m restartHintModifier) warn(diags *diag.Diagnostics, p path.Path, state, plan attr.Value) {
+	// Nothing is being restarted on creation or when the value is unchanged.
+	if state.IsNull() || state.IsUnknown() || state.Equal(plan) {
+		return
+	}
+
+	diags.AddAttributeWarning(
+		p,
+		"Database cluster will be restarted",
+		fmt.Sprintf(
+			"Changing %s requires a restart of the database cluster, "+
+				"which will briefly interrupt connections when applied.",
+			p,
+		),
+	)
+}
+
+func (m restartHintModifier) PlanModifyBool(
+	ctx context.Context,
+	req planmodifier.BoolRequest,
+	resp *planmodifier.BoolResponse,
+) {
+	m.warn(&resp.Diagnostics, req.Path, req.StateValue, req.PlanValue)
+}
+
+func (m restartHintModifier) PlanModifyFloat64(
+	ctx context.Context,
+	req planmodifier.Float64Request,
+	resp *planmodifier.Float64Response,
+) {
+	m.warn(&resp.Diagnostics, req.Path, req.StateValue, req.PlanValue)
+}
+
+func (m restartHintModifier) PlanModifyInt64(
+	ctx context.Context,
+	req planmodifier.Int64Request,
+	resp *planmodifier.Int64Response,
+) {
+	m.warn(&resp.Diagnostics, req.Path, req.StateValue, req.PlanValue)
+}
+
+func (m restartHintModifier) PlanModifyString(
+	ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	m.warn(&resp.Diagnostics, req.Path, req.StateValue, req.PlanValue)
+}
+
+// ConflictsWithLegacyAttributes returns a config validator that rejects
+// configurations setting both engine_config and any of the flattened
+// engine_config_* attributes.
+func ConflictsWithLegacyAttributes() resource.ConfigValidator {
+	return legacyConflictValidator{}
+}
+
+type legacyConflictValidator struct{}
+
+func (v legacyConflictValidator) Description(_ context.Context) string {
+	return fmt.Sprintf(
+		"Ensures %s is not configured alongside any %s* attribute.",
+		AttributeName, LegacyAttributePrefix,
+	)
+}
+
+func (v legacyConflictValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v legacyConflictValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var attributes map[string]tftypes.Value
+	if err := req.Config.Raw.As(&attributes); err != nil {
+		resp.Diagnostics.AddError("Failed to read resource configuration", err.Error())
+		return
+	}
+
+	if nested, ok := attributes[AttributeName]; !ok || nested.IsNull() {
+		return
+	}
+
+	for _, name := range legacyAttributeNames(attributes) {
+		if attributes[name].IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Conflicting engine configuration",
+			fmt.Sprintf(
+				"%s cannot be configured together with %s. "+
+					"Please move this setting into the %s block.",
+				name, AttributeName, AttributeName,
+			),
+		)
+	}
+}
+
+// ReconcileLegacyPlan keeps engine_config and the flattened engine_config_*
+// attributes consistent with each other: when one of them is changed, the
+// other is marked as unknown so it can be refreshed after apply.
+func ReconcileLegacyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to reconcile on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planAttributes, stateAttributes map[string]tftypes.Value
+
+	if err := req.Plan.Raw.As(&planAttributes); err != nil {
+		resp.Diagnostics.AddError("Failed to read resource plan", err.Error())
+		return
+	}
+
+	if err := req.State.Raw.As(&stateAttributes); err != nil {
+		resp.Diagnostics.AddError("Failed to read resource state", err.Error())
+		return
+	}
+
+	legacyNames := legacyAttributeNames(planAttributes)
+
+	legacyChanged := slices.ContainsFunc(legacyNames, func(name string) bool {
+		return valueChanged(stateAttributes[name], planAttributes[name])
+	})
+	nestedChanged := valueChanged(stateAttributes[AttributeName], planAttributes[AttributeName])
+
+	switch {
+	case nestedChanged:
+		for _, name := range legacyNames {
+			planAttributes[name] = unknownOf(planAttributes[name])
+		}
+	case legacyChanged:
+		planAttributes[AttributeName] = unknownOf(planAttributes[AttributeName])
+	default:
+		return
+	}
+
+	resp.Plan.Raw = tftypes.NewValue(req.Plan.Raw.Type(), planAttributes)
+}
+
+func legacyAttributeNames(attributes map[string]tftypes.Value) []string {
+	var result []string
+
+	for name := range attributes {
+		if strings.HasPrefix(name, LegacyAttributePrefix) {
+			result = append(result, name)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// valueChanged returns whether a planned value differs from its state value,
+// ignoring computed values that have not been populated yet.
+func valueChanged(state, plan tftypes.Value) bool {
+	if state.IsNull() && !plan.IsKnown() {
+		return false
+	}
+
+	return !state.Equal(plan)
+}
+
+func unknownOf(value tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(value.Type(), tftypes.UnknownValue)
+}
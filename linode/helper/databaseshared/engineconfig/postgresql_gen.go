@@ -0,0 +1,398 @@
+// Code generated by engineconfig/gen from metadata/postgresql.json; DO NOT EDIT.
+
+package engineconfig
+
+// PostgreSQLSettings describes the engine_config settings of the postgresql database engine.
+var PostgreSQLSettings = []Setting{
+	{
+		Name:    "pg",
+		APIName: "pg",
+		Type:    SettingTypeGroup,
+		Children: []Setting{
+			{
+				Name:        "autovacuum_analyze_scale_factor",
+				APIName:     "autovacuum_analyze_scale_factor",
+				Type:        SettingTypeFloat64,
+				Description: "Specifies a fraction of the table size to add to autovacuum_analyze_threshold when deciding whether to trigger an ANALYZE. The default is 0.2 (20% of table size)",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(1),
+			},
+			{
+				Name:        "autovacuum_analyze_threshold",
+				APIName:     "autovacuum_analyze_threshold",
+				Type:        SettingTypeInt64,
+				Description: "Specifies the minimum number of inserted, updated or deleted tuples needed to trigger an ANALYZE in any one table. The default is 50 tuples.",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(2147483647),
+			},
+			{
+				Name:            "autovacuum_max_workers",
+				APIName:         "autovacuum_max_workers",
+				Type:            SettingTypeInt64,
+				Description:     "Specifies the maximum number of autovacuum processes (other than the autovacuum launcher) that may be running at any one time. The default is three. This parameter can only be set at server start.",
+				Minimum:         floatPtr(1),
+				Maximum:         floatPtr(20),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "autovacuum_naptime",
+				APIName:     "autovacuum_naptime",
+				Type:        SettingTypeInt64,
+				Description: "Specifies the minimum delay between autovacuum runs on any given database. The delay is measured in seconds, and the default is one minute",
+				Minimum:     floatPtr(1),
+				Maximum:     floatPtr(86400),
+			},
+			{
+				Name:        "autovacuum_vacuum_cost_delay",
+				APIName:     "autovacuum_vacuum_cost_delay",
+				Type:        SettingTypeInt64,
+				Description: "Specifies the cost delay value that will be used in automatic VACUUM operations. If -1 is specified, the regular vacuum_cost_delay value will be used. The default value is 20 milliseconds",
+				Minimum:     floatPtr(-1),
+				Maximum:     floatPtr(100),
+			},
+			{
+				Name:        "autovacuum_vacuum_cost_limit",
+				APIName:     "autovacuum_vacuum_cost_limit",
+				Type:        SettingTypeInt64,
+				Description: "Specifies the cost limit value that will be used in automatic VACUUM operations. If -1 is specified (which is the default), the regular vacuum_cost_limit value will be used.",
+				Minimum:     floatPtr(-1),
+				Maximum:     floatPtr(10000),
+			},
+			{
+				Name:        "autovacuum_vacuum_scale_factor",
+				APIName:     "autovacuum_vacuum_scale_factor",
+				Type:        SettingTypeFloat64,
+				Description: "Specifies a fraction of the table size to add to autovacuum_vacuum_threshold when deciding whether to trigger a VACUUM. The default is 0.2 (20% of table size)",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(1),
+			},
+			{
+				Name:        "autovacuum_vacuum_threshold",
+				APIName:     "autovacuum_vacuum_threshold",
+				Type:        SettingTypeInt64,
+				Description: "Specifies the minimum number of updated or deleted tuples needed to trigger a VACUUM in any one table. The default is 50 tuples",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(2147483647),
+			},
+			{
+				Name:        "bgwriter_delay",
+				APIName:     "bgwriter_delay",
+				Type:        SettingTypeInt64,
+				Description: "Specifies the delay between activity rounds for the background writer in milliseconds. Default is 200.",
+				Minimum:     floatPtr(10),
+				Maximum:     floatPtr(10000),
+			},
+			{
+				Name:        "bgwriter_flush_after",
+				APIName:     "bgwriter_flush_after",
+				Type:        SettingTypeInt64,
+				Description: "Whenever more than bgwriter_flush_after bytes have been written by the background writer, attempt to force the OS to issue these writes to the underlying storage. Specified in kilobytes, default is 512. Setting of 0 disables forced writeback.",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(2048),
+			},
+			{
+				Name:        "bgwriter_lru_maxpages",
+				APIName:     "bgwriter_lru_maxpages",
+				Type:        SettingTypeInt64,
+				Description: "In each round, no more than this many buffers will be written by the background writer. Setting this to zero disables background writing. Default is 100.",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(1073741823),
+			},
+			{
+				Name:        "bgwriter_lru_multiplier",
+				APIName:     "bgwriter_lru_multiplier",
+				Type:        SettingTypeFloat64,
+				Description: "The average recent need for new buffers is multiplied by bgwriter_lru_multiplier to arrive at an estimate of the number that will be needed during the next round, (up to bgwriter_lru_maxpages). 1.0 represents a “just in time” policy of writing exactly the number of buffers predicted to be needed. Larger values provide some cushion against spikes in demand, while smaller values intentionally leave writes to be done by server processes. The default is 2.0.",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(10),
+			},
+			{
+				Name:        "deadlock_timeout",
+				APIName:     "deadlock_timeout",
+				Type:        SettingTypeInt64,
+				Description: "This is the amount of time, in milliseconds, to wait on a lock before checking to see if there is a deadlock condition.",
+				Minimum:     floatPtr(500),
+				Maximum:     floatPtr(1800000),
+			},
+			{
+				Name:        "default_toast_compression",
+				APIName:     "default_toast_compression",
+				Type:        SettingTypeString,
+				Description: "Specifies the default TOAST compression method for values of compressible columns (the default is lz4).",
+				Enum:        []string{"lz4", "pglz"},
+			},
+			{
+				Name:        "idle_in_transaction_session_timeout",
+				APIName:     "idle_in_transaction_session_timeout",
+				Type:        SettingTypeInt64,
+				Description: "Time out sessions with open transactions after this number of milliseconds",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(604800000),
+			},
+			{
+				Name:        "jit",
+				APIName:     "jit",
+				Type:        SettingTypeBool,
+				Description: "Controls system-wide use of Just-in-Time Compilation (JIT).",
+			},
+			{
+				Name:            "max_files_per_process",
+				APIName:         "max_files_per_process",
+				Type:            SettingTypeInt64,
+				Description:     "PostgreSQL maximum number of files that can be open per process",
+				Minimum:         floatPtr(1000),
+				Maximum:         floatPtr(4096),
+				RequiresRestart: true,
+			},
+			{
+				Name:            "max_locks_per_transaction",
+				APIName:         "max_locks_per_transaction",
+				Type:            SettingTypeInt64,
+				Description:     "PostgreSQL maximum locks per transaction",
+				Minimum:         floatPtr(64),
+				Maximum:         floatPtr(6400),
+				RequiresRestart: true,
+			},
+			{
+				Name:            "max_logical_replication_workers",
+				APIName:         "max_logical_replication_workers",
+				Type:            SettingTypeInt64,
+				Description:     "PostgreSQL maximum logical replication workers (taken from the pool of max_parallel_workers)",
+				Minimum:         floatPtr(4),
+				Maximum:         floatPtr(64),
+				RequiresRestart: true,
+			},
+			{
+				Name:            "max_parallel_workers",
+				APIName:         "max_parallel_workers",
+				Type:            SettingTypeInt64,
+				Description:     "Sets the maximum number of workers that the system can support for parallel queries",
+				Minimum:         floatPtr(0),
+				Maximum:         floatPtr(96),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "max_parallel_workers_per_gather",
+				APIName:     "max_parallel_workers_per_gather",
+				Type:        SettingTypeInt64,
+				Description: "Sets the maximum number of workers that can be started by a single Gather or Gather Merge node",
+				Minimum:     floatPtr(0),
+				Maximum:     floatPtr(96),
+			},
+			{
+				Name:            "max_pred_locks_per_transaction",
+				APIName:         "max_pred_locks_per_transaction",
+				Type:            SettingTypeInt64,
+				Description:     "PostgreSQL maximum predicate locks per transaction",
+				Minimum:         floatPtr(64),
+				Maximum:         floatPtr(5120),
+				RequiresRestart: true,
+			},
+			{
+				Name:            "max_replication_slots",
+				APIName:         "max_replication_slots",
+				Type:            SettingTypeInt64,
+				Description:     "PostgreSQL maximum replication slots",
+				Minimum:         floatPtr(8),
+				Maximum:         floatPtr(64),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "max_slot_wal_keep_size",
+				APIName:     "max_slot_wal_keep_size",
+				Type:        SettingTypeInt64,
+				Description: "PostgreSQL maximum WAL size (MB) reserved for replication slots. Default is -1 (unlimited). wal_keep_size minimum WAL size setting takes precedence over this.",
+				Minimum:     floatPtr(-1),
+				Maximum:     floatPtr(2147483647),
+			},
+			{
+				Name:            "max_stack_depth",
+				APIName:         "max_stack_depth",
+				Type:            SettingTypeInt64,
+				Description:     "Maximum depth of the stack in bytes",
+				Minimum:         floatPtr(2097152),
+				Maximum:         floatPtr(6291456),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "max_standby_archive_delay",
+				APIName:     "max_standby_archive_delay",
+				Type:        SettingTypeInt64,
+				Description: "Max standby archive delay in milliseconds",
+				Minimum:     floatPtr(1),
+				Maximum:     floatPtr(43200000),
+			},
+			{
+				Name:        "max_standby_streaming_delay",
+				APIName:     "max_standby_streaming_delay",
+				Type:        SettingTypeInt64,
+				Description: "Max standby streaming delay in milliseconds",
+				Minimum:     floatPtr(1),
+				Maximum:     floatPtr(43200000),
+			},
+			{
+				Name:            "max_wal_senders",
+				APIName:         "max_wal_senders",
+				Type:            SettingTypeInt64,
+				Description:     "PostgreSQL maximum WAL senders",
+				Minimum:         floatPtr(20),
+				Maximum:         floatPtr(64),
+				RequiresRestart: true,
+			},
+			{
+				Name:            "max_worker_processes",
+				APIName:         "max_worker_processes",
+				Type:            SettingTypeInt64,
+				Description:     "Sets the maximum number of background processes that the system can support",
+				Minimum:         floatPtr(8),
+				Maximum:         floatPtr(96),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "password_encryption",
+				APIName:     "password_encryption",
+				Type:        SettingTypeString,
+				Description: "Chooses the algorithm for encrypting passwords.",
+				Enum:        []string{"md5", "scram-sha-256"},
+			},
+			{
+				Name:        "pg_partman_bgw_interval",
+				APIName:     "pg_partman_bgw.interval",
+				Type:        SettingTypeInt64,
+				Description: "Sets the time interval to run pg_partman's scheduled tasks",
+				Minimum:     floatPtr(3600),
+				Maximum:     floatPtr(604800),
+			},
+			{
+				Name:        "pg_partman_bgw_role",
+				APIName:     "pg_partman_bgw.role",
+				Type:        SettingTypeString,
+				Description: "Controls which role to use for pg_partman's scheduled background tasks.",
+				MaxLength:   int64Ptr(64),
+				Pattern:     `^[_A-Za-z0-9][-._A-Za-z0-9]{0,63}$`,
+			},
+			{
+				Name:            "pg_stat_monitor_pgsm_enable_query_plan",
+				APIName:         "pg_stat_monitor.pgsm_enable_query_plan",
+				Type:            SettingTypeBool,
+				Description:     "Enables or disables query plan monitoring",
+				RequiresRestart: true,
+			},
+			{
+				Name:            "pg_stat_monitor_pgsm_max_buckets",
+				APIName:         "pg_stat_monitor.pgsm_max_buckets",
+				Type:            SettingTypeInt64,
+				Description:     "Sets the maximum number of buckets",
+				Minimum:         floatPtr(1),
+				Maximum:         floatPtr(10),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "pg_stat_statements_track",
+				APIName:     "pg_stat_statements.track",
+				Type:        SettingTypeString,
+				Description: "Controls which statements are counted. Specify top to track top-level statements (those issued directly by clients), all to also track nested statements (such as statements invoked within functions), or none to disable statement statistics collection. The default value is top.",
+				Enum:        []string{"all", "top", "none"},
+			},
+			{
+				Name:        "temp_file_limit",
+				APIName:     "temp_file_limit",
+				Type:        SettingTypeInt64,
+				Description: "PostgreSQL temporary file limit in KiB, -1 for unlimited",
+				Minimum:     floatPtr(-1),
+				Maximum:     floatPtr(2147483647),
+			},
+			{
+				Name:        "timezone",
+				APIName:     "timezone",
+				Type:        SettingTypeString,
+				Description: "PostgreSQL service timezone",
+				MaxLength:   int64Ptr(64),
+				Pattern:     `^[\w/]*$`,
+			},
+			{
+				Name:            "track_activity_query_size",
+				APIName:         "track_activity_query_size",
+				Type:            SettingTypeInt64,
+				Description:     "Specifies the number of bytes reserved to track the currently executing command for each active session.",
+				Minimum:         floatPtr(1024),
+				Maximum:         floatPtr(10240),
+				RequiresRestart: true,
+			},
+			{
+				Name:        "track_commit_timestamp",
+				APIName:     "track_commit_timestamp",
+				Type:        SettingTypeString,
+				Description: "Record commit time of transactions.",
+				Enum:        []string{"off", "on"},
+			},
+			{
+				Name:        "track_functions",
+				APIName:     "track_functions",
+				Type:        SettingTypeString,
+				Description: "Enables tracking of function call counts and time used.",
+				Enum:        []string{"all", "pl", "none"},
+			},
+			{
+				Name:        "track_io_timing",
+				APIName:     "track_io_timing",
+				Type:        SettingTypeString,
+				Description: "Enables timing of database I/O calls. This parameter is off by default, because it will repeatedly query the operating system for the current time, which may cause significant overhead on some platforms.",
+				Enum:        []string{"off", "on"},
+			},
+			{
+				Name:        "wal_sender_timeout",
+				APIName:     "wal_sender_timeout",
+				Type:        SettingTypeInt64,
+				Description: "Terminate replication connections that are inactive for longer than this amount of time, in milliseconds. Setting this value to zero disables the timeout.",
+			},
+			{
+				Name:        "wal_writer_delay",
+				APIName:     "wal_writer_delay",
+				Type:        SettingTypeInt64,
+				Description: "WAL flush interval in milliseconds. Note that setting this value to lower than the default 200ms may negatively impact performance.",
+				Minimum:     floatPtr(10),
+				Maximum:     floatPtr(200),
+			},
+		},
+	},
+	{
+		Name:            "pg_stat_monitor_enable",
+		APIName:         "pg_stat_monitor_enable",
+		Type:            SettingTypeBool,
+		Description:     "Enable the pg_stat_monitor extension. Enabling this extension will cause the cluster to be restarted. When this extension is enabled, pg_stat_statements results for utility commands are unreliable.",
+		RequiresRestart: true,
+	},
+	{
+		Name:    "pglookout",
+		APIName: "pglookout",
+		Type:    SettingTypeGroup,
+		Children: []Setting{
+			{
+				Name:        "max_failover_replication_time_lag",
+				APIName:     "max_failover_replication_time_lag",
+				Type:        SettingTypeInt64,
+				Description: "Number of seconds of master unavailability before triggering database failover to standby.",
+				Minimum:     floatPtr(10),
+				Maximum:     floatPtr(999999),
+			},
+		},
+	},
+	{
+		Name:            "shared_buffers_percentage",
+		APIName:         "shared_buffers_percentage",
+		Type:            SettingTypeFloat64,
+		Description:     "Percentage of total RAM that the database server uses for shared memory buffers. Valid range is 20-60 (float), which corresponds to 20% - 60%. This setting adjusts the shared_buffers configuration value.",
+		Minimum:         floatPtr(20),
+		Maximum:         floatPtr(60),
+		RequiresRestart: true,
+	},
+	{
+		Name:        "work_mem",
+		APIName:     "work_mem",
+		Type:        SettingTypeInt64,
+		Description: "Sets the maximum amount of memory to be used by a query operation (such as a sort or hash table) before writing to temporary disk files, in MB. Default is 1MB + 0.075% of total RAM (up to 32MB).",
+		Minimum:     floatPtr(1),
+		Maximum:     floatPtr(1024),
+	},
+}
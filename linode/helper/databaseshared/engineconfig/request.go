@@ -0,0 +1,150 @@
+package engineconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/linode/linodego"
+)
+
+// apiKey is the key of the engine config in database API requests and responses.
+const apiKey = "engine_config"
+
+// The helpers in this file send engine configs to the API as raw JSON rather than
+// through the linodego engine config structs, so settings added to the API after
+// the bundled linodego release can be managed without a provider release.
+
+// CreateDatabase creates a database of the given engine from the given linodego
+// create options, sending engineConfig as its engine_config.
+func CreateDatabase[T any](
+	ctx context.Context,
+	client *linodego.Client,
+	engine linodego.DatabaseEngineType,
+	options any,
+	engineConfig map[string]any,
+) (*T, error) {
+	return sendDatabaseRequest[T](
+		ctx, client, http.MethodPost, instancesEndpoint(engine), options, engineConfig,
+	)
+}
+
+// UpdateDatabase updates a database of the given engine from the given linodego
+// update options, sending engineConfig as its engine_config.
+func UpdateDatabase[T any](
+	ctx context.Context,
+	client *linodego.Client,
+	engine linodego.DatabaseEngineType,
+	id int,
+	options any,
+	engineConfig map[string]any,
+) (*T, error) {
+	return sendDatabaseRequest[T](
+		ctx, client, http.MethodPut, instanceEndpoint(engine, id), options, engineConfig,
+	)
+}
+
+// GetDatabase gets a database of the given engine along with its raw engine config,
+// which may contain settings the linodego engine config structs do not know about.
+func GetDatabase[T any](
+	ctx context.Context,
+	client *linodego.Client,
+	engine linodego.DatabaseEngineType,
+	id int,
+) (*T, json.RawMessage, error) {
+	body, err := doRequest(ctx, client, http.MethodGet, instanceEndpoint(engine, id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var db T
+	if err := json.Unmarshal(body, &db); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode database: %w", err)
+	}
+
+	var raw struct {
+		EngineConfig json.RawMessage `json:"engine_config"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode database engine config: %w", err)
+	}
+
+	return &db, raw.EngineConfig, nil
+}
+
+func sendDatabaseRequest[T any](
+	ctx context.Context,
+	client *linodego.Client,
+	method, endpoint string,
+	options any,
+	engineConfig map[string]any,
+) (*T, error) {
+	body, err := requestBody(options, engineConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := doRequest(ctx, client, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result T
+	if err := json.Unmarshal(response, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode database: %w", err)
+	}
+
+	return &result, nil
+}
+
+// requestBody merges engineConfig into the JSON representation of options.
+// Null settings are kept so nullable settings can be cleared.
+func requestBody(options any, engineConfig map[string]any) (string, error) {
+	body, err := toMap(options)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request options: %w", err)
+	}
+
+	if len(engineConfig) > 0 {
+		body[apiKey] = engineConfig
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+func doRequest(
+	ctx context.Context,
+	client *linodego.Client,
+	method, endpoint string,
+	body any,
+) ([]byte, error) {
+	req := client.R(ctx)
+	if body != nil {
+		req.SetBody(body)
+	}
+
+	resp, err := req.Execute(method, endpoint)
+	if err != nil {
+		return nil, linodego.NewError(err)
+	}
+
+	if resp.IsError() {
+		return nil, linodego.NewError(resp)
+	}
+
+	return resp.Body(), nil
+}
+
+func instancesEndpoint(engine linodego.DatabaseEngineType) string {
+	return fmt.Sprintf("databases/%s/instances", engine)
+}
+
+func instanceEndpoint(engine linodego.DatabaseEngineType, id int) string {
+	return fmt.Sprintf("%s/%d", instancesEndpoint(engine), id)
+}
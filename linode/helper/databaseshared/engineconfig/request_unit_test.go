@@ -0,0 +1,167 @@
+//go:build unit
+
+package engineconfig_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer returns a client for a server which records the body of the last
+// request and responds with a database that has the given engine config.
+func newTestServer(t *testing.T, engineConfig map[string]any, body *map[string]any) *linodego.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body != nil && r.Method != http.MethodGet {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":            123,
+			"label":         "my-db",
+			"engine_config": engineConfig,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	return &client
+}
+
+func TestCreateDatabase_unknownSetting(t *testing.T) {
+	var body map[string]any
+	client := newTestServer(t, nil, &body)
+
+	value := testObject(
+		t,
+		map[string]attr.Value{
+			"jit":            types.BoolNull(),
+			"max_buckets":    types.Int64Null(),
+			"stopword_table": types.StringValue("db/table"),
+		},
+		types.Int64Value(64),
+		types.Float64Null(),
+	)
+
+	db, err := engineconfig.CreateDatabase[linodego.PostgresDatabase](
+		context.Background(),
+		client,
+		linodego.DatabaseEngineTypePostgres,
+		linodego.PostgresCreateOptions{Label: "my-db"},
+		engineconfig.Expand(value, testSettings),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 123, db.ID)
+
+	// Settings unknown to the bundled API client are sent as-is
+	require.Equal(t, "my-db", body["label"])
+	require.Equal(t, map[string]any{
+		"pg": map[string]any{
+			"stopword_table": "db/table",
+		},
+		"work_mem": float64(64),
+	}, body["engine_config"])
+}
+
+func TestUpdateDatabase_clearNullable(t *testing.T) {
+	var body map[string]any
+	client := newTestServer(t, nil, &body)
+
+	value := testObject(
+		t,
+		map[string]attr.Value{
+			"jit":            types.BoolValue(true),
+			"max_buckets":    types.Int64Unknown(),
+			"stopword_table": types.StringNull(),
+		},
+		types.Int64Unknown(),
+		types.Float64Null(),
+	)
+
+	_, err := engineconfig.UpdateDatabase[linodego.PostgresDatabase](
+		context.Background(),
+		client,
+		linodego.DatabaseEngineTypePostgres,
+		123,
+		linodego.PostgresUpdateOptions{},
+		engineconfig.Expand(value, testSettings),
+	)
+	require.NoError(t, err)
+
+	// Nullable settings are cleared by explicitly sending null
+	pg := body["engine_config"].(map[string]any)["pg"].(map[string]any)
+	require.Contains(t, pg, "stopword_table")
+	require.Nil(t, pg["stopword_table"])
+	require.Equal(t, true, pg["jit"])
+}
+
+func TestUpdateDatabase_noEngineConfig(t *testing.T) {
+	var body map[string]any
+	client := newTestServer(t, nil, &body)
+
+	_, err := engineconfig.UpdateDatabase[linodego.PostgresDatabase](
+		context.Background(),
+		client,
+		linodego.DatabaseEngineTypePostgres,
+		123,
+		linodego.PostgresUpdateOptions{Label: "my-db"},
+		nil,
+	)
+	require.NoError(t, err)
+	require.NotContains(t, body, "engine_config")
+}
+
+func TestGetDatabase_unknownSetting(t *testing.T) {
+	client := newTestServer(t, map[string]any{
+		"pg": map[string]any{
+			"stopword_table": "db/table",
+		},
+		"work_mem": 32,
+	}, nil)
+
+	db, raw, err := engineconfig.GetDatabase[linodego.PostgresDatabase](
+		context.Background(),
+		client,
+		linodego.DatabaseEngineTypePostgres,
+		123,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "my-db", db.Label)
+
+	var d diag.Diagnostics
+
+	result := engineconfig.Flatten(
+		types.ObjectUnknown(engineconfig.AttributeTypes(testSettings)),
+		raw,
+		testSettings,
+		false,
+		&d,
+	)
+	require.False(t, d.HasError(), d.Errors())
+
+	expected := testObject(
+		t,
+		map[string]attr.Value{
+			"jit":            types.BoolNull(),
+			"max_buckets":    types.Int64Null(),
+			"stopword_table": types.StringValue("db/table"),
+		},
+		types.Int64Value(32),
+		types.Float64Null(),
+	)
+
+	require.True(t, expected.Equal(result), result.String())
+}
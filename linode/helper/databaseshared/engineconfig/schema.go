@@ -0,0 +1,241 @@
+package engineconfig
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AttributeName is the name of the nested engine configuration attribute.
+const AttributeName = "engine_config"
+
+// ResourceAttribute returns the optional and computed `engine_config` resource
+// attribute for the given settings. Validation and restart hints are derived
+// from the setting metadata.
+func ResourceAttribute(description string, settings []Setting) rschema.SingleNestedAttribute {
+	return rschema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Attributes:  resourceAttributes(settings),
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// DataSourceAttribute returns the computed `engine_config` data source
+// attribute for the given settings.
+func DataSourceAttribute(description string, settings []Setting) dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes:  dataSourceAttributes(settings),
+	}
+}
+
+// AttributeTypes returns the object attribute types of the given settings.
+func AttributeTypes(settings []Setting) map[string]attr.Type {
+	result := make(map[string]attr.Type, len(settings))
+
+	for _, s := range settings {
+		switch s.Type {
+		case SettingTypeGroup:
+			result[s.Name] = types.ObjectType{AttrTypes: AttributeTypes(s.Children)}
+		case SettingTypeBool:
+			result[s.Name] = types.BoolType
+		case SettingTypeFloat64:
+			result[s.Name] = types.Float64Type
+		case SettingTypeInt64:
+			result[s.Name] = types.Int64Type
+		case SettingTypeString:
+			result[s.Name] = types.StringType
+		}
+	}
+
+	return result
+}
+
+func resourceAttributes(settings []Setting) map[string]rschema.Attribute {
+	result := make(map[string]rschema.Attribute, len(settings))
+
+	for _, s := range settings {
+		if attribute := s.resourceAttribute(); attribute != nil {
+			result[s.Name] = attribute
+		}
+	}
+
+	return result
+}
+
+func dataSourceAttributes(settings []Setting) map[string]dschema.Attribute {
+	result := make(map[string]dschema.Attribute, len(settings))
+
+	for _, s := range settings {
+		if attribute := s.dataSourceAttribute(); attribute != nil {
+			result[s.Name] = attribute
+		}
+	}
+
+	return result
+}
+
+func (s Setting) resourceAttribute() rschema.Attribute {
+	switch s.Type {
+	case SettingTypeGroup:
+		return rschema.SingleNestedAttribute{
+			Description: s.description(),
+			Optional:    true,
+			Computed:    true,
+			Attributes:  resourceAttributes(s.Children),
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case SettingTypeBool:
+		modifiers := []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
+		if s.RequiresRestart {
+			modifiers = append(modifiers, restartHintModifier{})
+		}
+
+		return rschema.BoolAttribute{
+			Description:   s.description(),
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: modifiers,
+		}
+	case SettingTypeFloat64:
+		modifiers := []planmodifier.Float64{float64planmodifier.UseStateForUnknown()}
+		if s.RequiresRestart {
+			modifiers = append(modifiers, restartHintModifier{})
+		}
+
+		return rschema.Float64Attribute{
+			Description:   s.description(),
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: modifiers,
+			Validators:    s.float64Validators(),
+		}
+	case SettingTypeInt64:
+		modifiers := []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}
+		if s.RequiresRestart {
+			modifiers = append(modifiers, restartHintModifier{})
+		}
+
+		return rschema.Int64Attribute{
+			Description:   s.description(),
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: modifiers,
+			Validators:    s.int64Validators(),
+		}
+	case SettingTypeString:
+		modifiers := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		if s.RequiresRestart {
+			modifiers = append(modifiers, restartHintModifier{})
+		}
+
+		return rschema.StringAttribute{
+			Description:   s.description(),
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: modifiers,
+			Validators:    s.stringValidators(),
+		}
+	}
+
+	return nil
+}
+
+func (s Setting) dataSourceAttribute() dschema.Attribute {
+	switch s.Type {
+	case SettingTypeGroup:
+		return dschema.SingleNestedAttribute{
+			Description: s.description(),
+			Computed:    true,
+			Attributes:  dataSourceAttributes(s.Children),
+		}
+	case SettingTypeBool:
+		return dschema.BoolAttribute{Description: s.description(), Computed: true}
+	case SettingTypeFloat64:
+		return dschema.Float64Attribute{Description: s.description(), Computed: true}
+	case SettingTypeInt64:
+		return dschema.Int64Attribute{Description: s.description(), Computed: true}
+	case SettingTypeString:
+		return dschema.StringAttribute{Description: s.description(), Computed: true}
+	}
+
+	return nil
+}
+
+func (s Setting) int64Validators() []validator.Int64 {
+	switch {
+	case s.Minimum != nil && s.Maximum != nil:
+		return []validator.Int64{int64validator.Between(int64(*s.Minimum), int64(*s.Maximum))}
+	case s.Minimum != nil:
+		return []validator.Int64{int64validator.AtLeast(int64(*s.Minimum))}
+	case s.Maximum != nil:
+		return []validator.Int64{int64validator.AtMost(int64(*s.Maximum))}
+	}
+
+	return nil
+}
+
+func (s Setting) float64Validators() []validator.Float64 {
+	switch {
+	case s.Minimum != nil && s.Maximum != nil:
+		return []validator.Float64{float64validator.Between(*s.Minimum, *s.Maximum)}
+	case s.Minimum != nil:
+		return []validator.Float64{float64validator.AtLeast(*s.Minimum)}
+	case s.Maximum != nil:
+		return []validator.Float64{float64validator.AtMost(*s.Maximum)}
+	}
+
+	return nil
+}
+
+func (s Setting) stringValidators() []validator.String {
+	var result []validator.String
+
+	if len(s.Enum) > 0 {
+		result = append(result, stringvalidator.OneOf(s.Enum...))
+	}
+
+	switch {
+	case s.MinLength != nil && s.MaxLength != nil:
+		result = append(result, stringvalidator.LengthBetween(int(*s.MinLength), int(*s.MaxLength)))
+	case s.MinLength != nil:
+		result = append(result, stringvalidator.LengthAtLeast(int(*s.MinLength)))
+	case s.MaxLength != nil:
+		result = append(result, stringvalidator.LengthAtMost(int(*s.MaxLength)))
+	}
+
+	if s.Pattern != "" {
+		// The API reports patterns in a JSON schema dialect; skip any
+		// pattern that isn't compatible with Go's regexp syntax rather than
+		// rejecting otherwise valid values.
+		if re, err := regexp.Compile(s.Pattern); err == nil {
+			result = append(
+				result,
+				stringvalidator.RegexMatches(re, fmt.Sprintf("must match the pattern %q", s.Pattern)),
+			)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,52 @@
+//go:build unit
+
+package engineconfig_test
+
+import (
+	"context"
+	"testing"
+
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/databaseshared/engineconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAttribute_generatedSettings(t *testing.T) {
+	for name, settings := range map[string][]engineconfig.Setting{
+		"mysql":      engineconfig.MySQLSettings,
+		"postgresql": engineconfig.PostgreSQLSettings,
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := rschema.Schema{
+				Attributes: map[string]rschema.Attribute{
+					engineconfig.AttributeName: engineconfig.ResourceAttribute("test", settings),
+				},
+			}
+
+			d := s.ValidateImplementation(context.Background())
+			require.False(t, d.HasError(), d.Errors())
+		})
+	}
+}
+
+func TestResourceAttribute_validation(t *testing.T) {
+	attribute := engineconfig.ResourceAttribute("test", engineconfig.PostgreSQLSettings)
+
+	pg, ok := attribute.Attributes["pg"].(rschema.SingleNestedAttribute)
+	require.True(t, ok)
+
+	maxWorkers, ok := pg.Attributes["autovacuum_max_workers"].(rschema.Int64Attribute)
+	require.True(t, ok)
+	require.Len(t, maxWorkers.Validators, 1)
+	// UseStateForUnknown and the restart hint
+	require.Len(t, maxWorkers.PlanModifiers, 2)
+	require.Contains(t, maxWorkers.Description, "restart")
+
+	compression, ok := pg.Attributes["default_toast_compression"].(rschema.StringAttribute)
+	require.True(t, ok)
+	require.Len(t, compression.Validators, 1)
+	require.Len(t, compression.PlanModifiers, 1)
+
+	_, ok = pg.Attributes["pg_stat_monitor_pgsm_max_buckets"].(rschema.Int64Attribute)
+	require.True(t, ok)
+}
@@ -0,0 +1,66 @@
+package engineconfig
+
+import "fmt"
+
+// SettingType is the Terraform value type an engine setting is exposed as.
+type SettingType string
+
+const (
+	SettingTypeBool    SettingType = "bool"
+	SettingTypeFloat64 SettingType = "float64"
+	SettingTypeGroup   SettingType = "group"
+	SettingTypeInt64   SettingType = "int64"
+	SettingTypeString  SettingType = "string"
+)
+
+// Setting describes a single engine configuration option as reported by the
+// `/databases/<engine>/config` endpoint. The per-engine setting lists in this
+// package are generated from that metadata; see generate.go.
+type Setting struct {
+	// Name is the Terraform attribute name of the setting.
+	Name string
+
+	// APIName is the key used by the Linode API, which may contain
+	// characters that are not valid in attribute names (e.g. `.`).
+	APIName string
+
+	Type        SettingType
+	Description string
+
+	Minimum   *float64
+	Maximum   *float64
+	MinLength *int64
+	MaxLength *int64
+	Pattern   string
+	Enum      []string
+
+	// Nullable settings may be explicitly cleared by setting them to null.
+	Nullable bool
+
+	// RequiresRestart indicates that changing this setting restarts the
+	// database cluster.
+	RequiresRestart bool
+
+	// Children contains the nested settings of a SettingTypeGroup setting.
+	Children []Setting
+}
+
+func (s Setting) description() string {
+	if s.Type == SettingTypeGroup && s.Description == "" {
+		return fmt.Sprintf("The `%s` engine settings.", s.APIName)
+	}
+
+	if s.RequiresRestart {
+		return s.Description + " Changing this setting will restart the database cluster."
+	}
+
+	return s.Description
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
@@ -0,0 +1,146 @@
+package databaseshared
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+var ResourceAttributeForkDetached = resourceSchema.BoolAttribute{
+	Description: "If true, this database is no longer tied to the database it was forked from. " +
+		"Changes to fork_source and fork_restore_time, including removing them, " +
+		"will not force a new resource.",
+	Optional: true,
+	Computed: true,
+	Default:  booldefault.StaticBool(false),
+}
+
+// ForkSourceRequiresReplace forces a new resource when `fork_source` changes
+// unless the fork has been detached.
+func ForkSourceRequiresReplace() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.Int64Request,
+			resp *int64planmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = !forkDetached(ctx, req.Plan, &resp.Diagnostics)
+		},
+		"Triggers replacement when `fork_source` changes unless `fork_detached` is set",
+		"Changing `fork_source` forces a new resource unless `fork_detached` is set.",
+	)
+}
+
+// ForkRestoreTimeRequiresReplace forces a new resource when `fork_restore_time`
+// changes unless the fork has been detached.
+func ForkRestoreTimeRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.StringRequest,
+			resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = !helper.CompareRFC3339TimeStrings(
+				req.PlanValue.ValueString(),
+				req.StateValue.ValueString(),
+			) && !forkDetached(ctx, req.Plan, &resp.Diagnostics)
+		},
+		"Triggers replacement when `fork_restore_time` changes unless `fork_detached` is set",
+		"Changing `fork_restore_time` forces a new resource unless `fork_detached` is set.",
+	)
+}
+
+func forkDetached(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) bool {
+	var detached types.Bool
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("fork_detached"), &detached)...)
+
+	return detached.ValueBool()
+}
+
+// ValidateForkRestoreTime checks whether the given restore time is within
+// the restore window of the given source database.
+func ValidateForkRestoreTime(
+	ctx context.Context,
+	client *linodego.Client,
+	engine linodego.DatabaseEngineType,
+	forkSource types.Int64,
+	forkRestoreTime timetypes.RFC3339,
+	diags *diag.Diagnostics,
+) {
+	if client == nil ||
+		forkSource.IsNull() || forkSource.IsUnknown() ||
+		forkRestoreTime.IsNull() || forkRestoreTime.IsUnknown() {
+		return
+	}
+
+	restoreTime, d := forkRestoreTime.ValueRFC3339Time()
+	diags.Append(d...)
+
+	sourceID := helper.FrameworkSafeInt64ToInt(forkSource.ValueInt64(), diags)
+	if diags.HasError() {
+		return
+	}
+
+	var oldestRestoreTime *time.Time
+
+	switch engine {
+	case linodego.DatabaseEngineTypeMySQL:
+		db, err := client.GetMySQLDatabase(ctx, sourceID)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("fork_source"),
+				"Failed to get fork source database",
+				err.Error(),
+			)
+			return
+		}
+		oldestRestoreTime = db.OldestRestoreTime
+	case linodego.DatabaseEngineTypePostgres:
+		db, err := client.GetPostgresDatabase(ctx, sourceID)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("fork_source"),
+				"Failed to get fork source database",
+				err.Error(),
+			)
+			return
+		}
+		oldestRestoreTime = db.OldestRestoreTime
+	}
+
+	if oldestRestoreTime == nil {
+		diags.AddAttributeError(
+			path.Root("fork_restore_time"),
+			"Database Not Restorable",
+			fmt.Sprintf("Database %d does not have any restorable backups yet.", sourceID),
+		)
+		return
+	}
+
+	if restoreTime.Before(*oldestRestoreTime) || restoreTime.After(time.Now()) {
+		diags.AddAttributeError(
+			path.Root("fork_restore_time"),
+			"Restore Time Outside of Restore Window",
+			fmt.Sprintf(
+				"fork_restore_time must be between %s and the current time for database %d, got %s.",
+				oldestRestoreTime.Format(time.RFC3339),
+				sourceID,
+				restoreTime.Format(time.RFC3339),
+			),
+		)
+	}
+}
@@ -0,0 +1,140 @@
+//go:build unit
+
+package databaseshared
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+// newForkSourceClient returns a client for a server which reports the given
+// oldest restore time for every database.
+func newForkSourceClient(t *testing.T, oldestRestoreTime *time.Time, requests *int) *linodego.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		db := map[string]any{"id": 123}
+		if oldestRestoreTime != nil {
+			db["oldest_restore_time"] = oldestRestoreTime.UTC().Format("2006-01-02T15:04:05")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(db)
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	return &client
+}
+
+func TestValidateForkRestoreTime(t *testing.T) {
+	oldest := time.Now().UTC().Add(-24 * time.Hour).Truncate(time.Second)
+
+	testCases := []struct {
+		name        string
+		restoreTime time.Time
+		expectError string
+	}{
+		{name: "oldest restore time", restoreTime: oldest},
+		{name: "within window", restoreTime: oldest.Add(time.Hour)},
+		{
+			name:        "before window",
+			restoreTime: oldest.Add(-time.Second),
+			expectError: "Restore Time Outside of Restore Window",
+		},
+		{
+			name:        "in the future",
+			restoreTime: time.Now().Add(time.Hour),
+			expectError: "Restore Time Outside of Restore Window",
+		},
+	}
+
+	for _, engine := range []linodego.DatabaseEngineType{
+		linodego.DatabaseEngineTypeMySQL,
+		linodego.DatabaseEngineTypePostgres,
+	} {
+		for _, testCase := range testCases {
+			t.Run(string(engine)+"/"+testCase.name, func(t *testing.T) {
+				requests := 0
+				client := newForkSourceClient(t, &oldest, &requests)
+
+				var d diag.Diagnostics
+
+				ValidateForkRestoreTime(
+					context.Background(),
+					client,
+					engine,
+					types.Int64Value(123),
+					timetypes.NewRFC3339TimeValue(testCase.restoreTime),
+					&d,
+				)
+				require.Equal(t, 1, requests)
+
+				if testCase.expectError == "" {
+					require.False(t, d.HasError(), d.Errors())
+					return
+				}
+
+				require.True(t, d.HasError())
+				require.Equal(t, testCase.expectError, d.Errors()[0].Summary())
+			})
+		}
+	}
+}
+
+func TestValidateForkRestoreTime_notRestorable(t *testing.T) {
+	requests := 0
+	client := newForkSourceClient(t, nil, &requests)
+
+	var d diag.Diagnostics
+
+	ValidateForkRestoreTime(
+		context.Background(),
+		client,
+		linodego.DatabaseEngineTypeMySQL,
+		types.Int64Value(123),
+		timetypes.NewRFC3339TimeValue(time.Now().Add(-time.Hour)),
+		&d,
+	)
+	require.True(t, d.HasError())
+	require.Equal(t, "Database Not Restorable", d.Errors()[0].Summary())
+}
+
+func TestValidateForkRestoreTime_skipped(t *testing.T) {
+	requests := 0
+	client := newForkSourceClient(t, nil, &requests)
+
+	var d diag.Diagnostics
+
+	// Nothing is validated until both the source and the restore time are known
+	ValidateForkRestoreTime(
+		context.Background(),
+		client,
+		linodego.DatabaseEngineTypeMySQL,
+		types.Int64Unknown(),
+		timetypes.NewRFC3339TimeValue(time.Now()),
+		&d,
+	)
+	ValidateForkRestoreTime(
+		context.Background(),
+		client,
+		linodego.DatabaseEngineTypeMySQL,
+		types.Int64Value(123),
+		timetypes.NewRFC3339Null(),
+		&d,
+	)
+	require.False(t, d.HasError(), d.Errors())
+	require.Zero(t, requests)
+}
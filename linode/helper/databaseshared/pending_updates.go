@@ -2,16 +2,22 @@ package databaseshared
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper"
 )
@@ -69,6 +75,21 @@ var DataSourceAttributePendingUpdates = dataSourceSchema.SetNestedAttribute{
 	},
 }
 
+var ResourceAttributeApplyPendingUpdates = resourceSchema.BoolAttribute{
+	Description: "If true, any pending maintenance updates of this database will be applied " +
+		"during the next apply rather than in the configured maintenance window.",
+	Optional: true,
+	Computed: true,
+	Default:  booldefault.StaticBool(false),
+}
+
+var ResourceAttributeAppliedUpdates = resourceSchema.SetNestedAttribute{
+	Description:   "The maintenance updates applied to this database during the last apply_pending_updates run.",
+	Computed:      true,
+	PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+	NestedObject:  ResourceAttributePendingUpdates.NestedObject,
+}
+
 var ObjectTypePendingUpdates = ResourceAttributePendingUpdates.NestedObject.Type().(types.ObjectType)
 
 func FlattenPendingUpdates(
@@ -108,3 +129,183 @@ func FlattenPendingUpdates(
 
 	return result, nil
 }
+
+// PlanApplyPendingUpdates marks pending_updates and applied_updates as unknown
+// when apply_pending_updates is enabled and the database has pending updates,
+// so the patch operation is surfaced in the plan.
+func PlanApplyPendingUpdates(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to apply on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var apply types.Bool
+	var pending types.Set
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("apply_pending_updates"), &apply)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("pending_updates"), &pending)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !apply.ValueBool() || len(pending.Elements()) < 1 {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.Plan.SetAttribute(ctx, path.Root("pending_updates"), types.SetUnknown(ObjectTypePendingUpdates))...,
+	)
+	resp.Diagnostics.Append(
+		resp.Plan.SetAttribute(ctx, path.Root("applied_updates"), types.SetUnknown(ObjectTypePendingUpdates))...,
+	)
+}
+
+type pendingUpdatesFunc func(context.Context, int) (linodego.DatabaseStatus, []linodego.DatabaseMaintenanceWindowPending, error)
+
+// ApplyPendingUpdatesSync synchronously applies the pending maintenance updates of a database
+// and returns the updates that are no longer pending once the database is active again.
+func ApplyPendingUpdatesSync(
+	ctx context.Context,
+	client *linodego.Client,
+	databaseID int,
+	databaseEngine linodego.DatabaseEngineType,
+	timeout time.Duration,
+) ([]linodego.DatabaseMaintenanceWindowPending, error) {
+	var patch func(context.Context, int) error
+	var getPending pendingUpdatesFunc
+
+	switch databaseEngine {
+	case linodego.DatabaseEngineTypeMySQL:
+		patch = client.PatchMySQLDatabase
+		getPending = func(ctx context.Context, id int) (linodego.DatabaseStatus, []linodego.DatabaseMaintenanceWindowPending, error) {
+			db, err := client.GetMySQLDatabase(ctx, id)
+			if err != nil {
+				return "", nil, err
+			}
+			return db.Status, db.Updates.Pending, nil
+		}
+	case linodego.DatabaseEngineTypePostgres:
+		patch = client.PatchPostgresDatabase
+		getPending = func(ctx context.Context, id int) (linodego.DatabaseStatus, []linodego.DatabaseMaintenanceWindowPending, error) {
+			db, err := client.GetPostgresDatabase(ctx, id)
+			if err != nil {
+				return "", nil, err
+			}
+			return db.Status, db.Updates.Pending, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported database engine: %s", databaseEngine)
+	}
+
+	_, pendingBefore, err := getPending(ctx, databaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending updates of database: %w", err)
+	}
+
+	if len(pendingBefore) < 1 {
+		tflog.Debug(ctx, "No pending updates to apply")
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "Patching database", map[string]any{
+		"pending_updates": len(pendingBefore),
+	})
+	if err := patch(ctx, databaseID); err != nil {
+		return nil, fmt.Errorf("failed to apply pending updates of database: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return waitForPendingUpdates(
+		ctx, getPending, databaseID, pendingBefore, client.GetPollDelay(), pendingUpdatesMaxIdlePolls,
+	)
+}
+
+// pendingUpdatesMaxIdlePolls is the number of consecutive polls after which a patch
+// that has not visibly started is considered to have been ignored by the API.
+const pendingUpdatesMaxIdlePolls = 20
+
+// waitForPendingUpdates waits for a patched database to finish applying its pending updates
+// and returns the updates that are no longer pending.
+func waitForPendingUpdates(
+	ctx context.Context,
+	getPending pendingUpdatesFunc,
+	databaseID int,
+	pendingBefore []linodego.DatabaseMaintenanceWindowPending,
+	pollDelay time.Duration,
+	maxIdlePolls int,
+) ([]linodego.DatabaseMaintenanceWindowPending, error) {
+	ticker := time.NewTicker(pollDelay)
+	defer ticker.Stop()
+
+	// The database may briefly remain active before the patch starts,
+	// so wait until it has either left the active status or dropped
+	// some of its pending updates.
+	leftActive := false
+	idlePolls := 0
+
+	for {
+		select {
+		case <-ticker.C:
+			status, pendingAfter, err := getPending(ctx, databaseID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pending updates of database: %w", err)
+			}
+
+			if status != linodego.DatabaseStatusActive {
+				leftActive = true
+				continue
+			}
+
+			applied := DiffPendingUpdates(pendingBefore, pendingAfter)
+			if len(applied) > 0 {
+				return applied, nil
+			}
+
+			if leftActive {
+				return nil, fmt.Errorf(
+					"database finished updating but none of its %d pending updates were applied",
+					len(pendingAfter),
+				)
+			}
+
+			idlePolls++
+			if idlePolls >= maxIdlePolls {
+				return nil, fmt.Errorf(
+					"database remained active with %d unchanged pending updates after being patched; "+
+						"the updates may not be applicable yet",
+					len(pendingAfter),
+				)
+			}
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for pending updates to be applied: %w", ctx.Err())
+		}
+	}
+}
+
+// DiffPendingUpdates returns the updates in before that are no longer present in after.
+func DiffPendingUpdates(before, after []linodego.DatabaseMaintenanceWindowPending) []linodego.DatabaseMaintenanceWindowPending {
+	return slices.DeleteFunc(slices.Clone(before), func(update linodego.DatabaseMaintenanceWindowPending) bool {
+		return slices.ContainsFunc(after, func(other linodego.DatabaseMaintenanceWindowPending) bool {
+			return pendingUpdateEqual(update, other)
+		})
+	})
+}
+
+func pendingUpdateEqual(a, b linodego.DatabaseMaintenanceWindowPending) bool {
+	timeEqual := func(a, b *time.Time) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Equal(*b)
+	}
+
+	return a.Description == b.Description &&
+		timeEqual(a.Deadline, b.Deadline) &&
+		timeEqual(a.PlannedFor, b.PlannedFor)
+}
@@ -0,0 +1,165 @@
+//go:build unit
+
+package databaseshared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func testPendingUpdates() []linodego.DatabaseMaintenanceWindowPending {
+	deadline := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	plannedFor := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	return []linodego.DatabaseMaintenanceWindowPending{
+		{Description: "OS update", Deadline: &deadline, PlannedFor: &plannedFor},
+		{Description: "Engine update", PlannedFor: &plannedFor},
+	}
+}
+
+func TestDiffPendingUpdates(t *testing.T) {
+	before := testPendingUpdates()
+
+	require.Empty(t, DiffPendingUpdates(before, before))
+	require.Equal(t, before, DiffPendingUpdates(before, nil))
+	require.Equal(t, before[:1], DiffPendingUpdates(before, before[1:]))
+
+	// Updates are compared by value rather than by pointer
+	rescheduled := testPendingUpdates()
+	newPlannedFor := rescheduled[1].PlannedFor.Add(24 * time.Hour)
+	rescheduled[1].PlannedFor = &newPlannedFor
+
+	require.Equal(t, before[1:], DiffPendingUpdates(before, rescheduled))
+	require.Empty(t, DiffPendingUpdates(nil, before))
+}
+
+// testGetPending returns a pendingUpdatesFunc which returns the given results in order,
+// repeating the last one.
+func testGetPending(
+	statuses []linodego.DatabaseStatus,
+	pending [][]linodego.DatabaseMaintenanceWindowPending,
+	calls *int,
+) pendingUpdatesFunc {
+	return func(context.Context, int) (linodego.DatabaseStatus, []linodego.DatabaseMaintenanceWindowPending, error) {
+		i := min(*calls, len(statuses)-1)
+		*calls++
+		return statuses[i], pending[i], nil
+	}
+}
+
+func TestWaitForPendingUpdates_leftActive(t *testing.T) {
+	before := testPendingUpdates()
+	calls := 0
+
+	applied, err := waitForPendingUpdates(
+		context.Background(),
+		testGetPending(
+			[]linodego.DatabaseStatus{
+				linodego.DatabaseStatusActive,
+				linodego.DatabaseStatusUpdating,
+				linodego.DatabaseStatusActive,
+			},
+			[][]linodego.DatabaseMaintenanceWindowPending{before, before, before[1:]},
+			&calls,
+		),
+		123,
+		before,
+		time.Millisecond,
+		10,
+	)
+	require.NoError(t, err)
+	require.Equal(t, before[:1], applied)
+	require.Equal(t, 3, calls)
+}
+
+func TestWaitForPendingUpdates_leftActiveWithoutApplying(t *testing.T) {
+	before := testPendingUpdates()
+	calls := 0
+
+	_, err := waitForPendingUpdates(
+		context.Background(),
+		testGetPending(
+			[]linodego.DatabaseStatus{
+				linodego.DatabaseStatusActive,
+				linodego.DatabaseStatusUpdating,
+				linodego.DatabaseStatusActive,
+			},
+			[][]linodego.DatabaseMaintenanceWindowPending{before, before, before},
+			&calls,
+		),
+		123,
+		before,
+		time.Millisecond,
+		10,
+	)
+	require.ErrorContains(t, err, "none of its 2 pending updates were applied")
+	require.Equal(t, 3, calls)
+}
+
+func TestWaitForPendingUpdates_appliedWhileActive(t *testing.T) {
+	before := testPendingUpdates()
+	calls := 0
+
+	applied, err := waitForPendingUpdates(
+		context.Background(),
+		testGetPending(
+			[]linodego.DatabaseStatus{linodego.DatabaseStatusActive},
+			[][]linodego.DatabaseMaintenanceWindowPending{nil},
+			&calls,
+		),
+		123,
+		before,
+		time.Millisecond,
+		10,
+	)
+	require.NoError(t, err)
+	require.Equal(t, before, applied)
+	require.Equal(t, 1, calls)
+}
+
+func TestWaitForPendingUpdates_noProgress(t *testing.T) {
+	before := testPendingUpdates()
+	calls := 0
+
+	_, err := waitForPendingUpdates(
+		context.Background(),
+		testGetPending(
+			[]linodego.DatabaseStatus{linodego.DatabaseStatusActive},
+			[][]linodego.DatabaseMaintenanceWindowPending{before},
+			&calls,
+		),
+		123,
+		before,
+		time.Millisecond,
+		5,
+	)
+	require.ErrorContains(t, err, "remained active with 2 unchanged pending updates")
+	require.Equal(t, 5, calls)
+}
+
+func TestWaitForPendingUpdates_timeout(t *testing.T) {
+	before := testPendingUpdates()
+	calls := 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// The idle poll limit does not apply while the database is being patched
+	_, err := waitForPendingUpdates(
+		ctx,
+		testGetPending(
+			[]linodego.DatabaseStatus{linodego.DatabaseStatusUpdating},
+			[][]linodego.DatabaseMaintenanceWindowPending{before},
+			&calls,
+		),
+		123,
+		before,
+		time.Millisecond,
+		1,
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
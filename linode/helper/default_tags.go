@@ -0,0 +1,244 @@
+package helper
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TagsValue is a framework set or list of tags.
+type TagsValue interface {
+	attr.Value
+	Elements() []attr.Value
+}
+
+func containsTag(tags []string, tag string) bool {
+	return slices.ContainsFunc(tags, func(t string) bool {
+		return strings.EqualFold(t, tag)
+	})
+}
+
+// MergeDefaultTags returns the tags of a resource along with the default tags of the provider
+// which aren't already present. Tags are compared case-insensitively.
+func MergeDefaultTags(tags, defaultTags []string) []string {
+	result := slices.Clone(tags)
+
+	for _, tag := range defaultTags {
+		if !containsTag(result, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// ExcludeDefaultTags returns the tags of a resource returned by the API excluding the default tags
+// of the provider, unless they are also in configuredTags, i.e. they are configured on the resource itself.
+func ExcludeDefaultTags(tags, defaultTags, configuredTags []string) []string {
+	result := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		if containsTag(defaultTags, tag) && !containsTag(configuredTags, tag) {
+			continue
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// preserveTagsCase returns the given tags using the case of the matching tags in oldTags,
+// so that changes in case don't trigger diffs.
+func preserveTagsCase(tags, oldTags []string) []string {
+	result := make([]string, len(tags))
+
+	for i, tag := range tags {
+		result[i] = tag
+
+		if index := slices.IndexFunc(oldTags, func(t string) bool {
+			return strings.EqualFold(t, tag)
+		}); index >= 0 {
+			result[i] = oldTags[index]
+		}
+	}
+
+	return result
+}
+
+// FrameworkTags returns the tags in a framework set or list, and whether all of them are known.
+func FrameworkTags(value TagsValue) ([]string, bool) {
+	if value.IsUnknown() {
+		return nil, false
+	}
+
+	elements := value.Elements()
+	result := make([]string, 0, len(elements))
+
+	for _, element := range elements {
+		tag, ok := element.(types.String)
+		if !ok || tag.IsUnknown() {
+			return nil, false
+		}
+
+		if !tag.IsNull() {
+			result = append(result, tag.ValueString())
+		}
+	}
+
+	return result, true
+}
+
+// DefaultTagValues returns the tags configured in the default_tags block of the provider.
+func (m *FrameworkProviderModel) DefaultTagValues() []string {
+	if m == nil || len(m.DefaultTags) < 1 {
+		return nil
+	}
+
+	tags, _ := FrameworkTags(m.DefaultTags[0].Tags)
+
+	return tags
+}
+
+// FrameworkTagsAll returns the tags to apply to a resource, including the default tags of the provider.
+func FrameworkTagsAll(value TagsValue, meta *FrameworkProviderMeta) []string {
+	tags, _ := FrameworkTags(value)
+
+	if meta == nil {
+		return tags
+	}
+
+	return MergeDefaultTags(tags, meta.Config.DefaultTagValues())
+}
+
+// FrameworkPlanTagsAll sets the planned tags_all attribute of a resource to its planned tags
+// merged with the default tags of the provider, preserving the case of the tags in the state.
+func FrameworkPlanTagsAll(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	tags TagsValue,
+	meta *FrameworkProviderMeta,
+) {
+	if req.Plan.Raw.IsNull() || meta == nil {
+		return
+	}
+
+	planTags, known := FrameworkTags(tags)
+	if !known {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.StringType))...,
+		)
+		return
+	}
+
+	tagsAll := MergeDefaultTags(planTags, meta.Config.DefaultTagValues())
+
+	if !req.State.Raw.IsNull() {
+		var stateTagsAll types.Set
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		oldTags, _ := FrameworkTags(stateTagsAll)
+		tagsAll = preserveTagsCase(tagsAll, oldTags)
+	}
+
+	value, diags := types.SetValueFrom(ctx, types.StringType, tagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), value)...)
+}
+
+// FrameworkExcludeDefaultTags returns the tags returned by the API for a resource excluding
+// the default tags of the provider which aren't in the prior tags of the resource.
+func FrameworkExcludeDefaultTags(tags []string, priorTags TagsValue, meta *FrameworkProviderMeta) []string {
+	if meta == nil {
+		return tags
+	}
+
+	configuredTags, _ := FrameworkTags(priorTags)
+
+	return ExcludeDefaultTags(tags, meta.Config.DefaultTagValues(), configuredTags)
+}
+
+// FrameworkFlattenTags sets the tags_all attribute of a resource to the tags returned by the API,
+// and the tags attribute to the same tags excluding the default tags of the provider which aren't
+// in the prior tags of the resource.
+func FrameworkFlattenTags(
+	tags, tagsAll *types.Set,
+	priorTags TagsValue,
+	apiTags []string,
+	meta *FrameworkProviderMeta,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	*tags = KeepOrUpdateStringSet(*tags, FrameworkExcludeDefaultTags(apiTags, priorTags, meta), preserveKnown, diags)
+	*tagsAll = KeepOrUpdateStringSet(*tagsAll, apiTags, preserveKnown, diags)
+}
+
+func sdkv2DefaultTags(meta any) []string {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok || providerMeta.Config == nil {
+		return nil
+	}
+
+	return providerMeta.Config.DefaultTags
+}
+
+// SDKv2TagsAll returns the tags to apply to a resource, including the default tags of the provider.
+func SDKv2TagsAll(d *schema.ResourceData, meta any) []string {
+	return MergeDefaultTags(ExpandStringSet(d.Get("tags").(*schema.Set)), sdkv2DefaultTags(meta))
+}
+
+// SDKv2SetTags sets the tags_all attribute of a resource to the tags returned by the API,
+// and the tags attribute to the same tags excluding the default tags of the provider
+// which aren't configured on the resource itself.
+func SDKv2SetTags(d *schema.ResourceData, meta any, tags []string) error {
+	configuredTags := ExpandStringSet(d.Get("tags").(*schema.Set))
+
+	if err := d.Set("tags_all", tags); err != nil {
+		return err
+	}
+
+	return d.Set("tags", ExcludeDefaultTags(tags, sdkv2DefaultTags(meta), configuredTags))
+}
+
+// SDKv2PlanTagsAll returns a CustomizeDiffFunc setting the planned tags_all attribute of a resource
+// to its planned tags merged with the default tags of the provider, preserving the case of the tags
+// in the state.
+//
+// NOTE: This must run after any CustomizeDiffFuncs modifying the tags attribute.
+func SDKv2PlanTagsAll() schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		if !diff.NewValueKnown("tags") {
+			return diff.SetNewComputed("tags_all")
+		}
+
+		oldTagsAll, _ := diff.GetChange("tags_all")
+		oldTags := ExpandStringSet(oldTagsAll.(*schema.Set))
+
+		tagsAll := preserveTagsCase(
+			MergeDefaultTags(ExpandStringSet(diff.Get("tags").(*schema.Set)), sdkv2DefaultTags(meta)),
+			oldTags,
+		)
+
+		if diff.Id() != "" && CompareStringSets(tagsAll, oldTags) {
+			return nil
+		}
+
+		return diff.SetNew("tags_all", tagsAll)
+	}
+}
@@ -0,0 +1,86 @@
+//go:build unit
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	require.Equal(t,
+		[]string{"web", "Team:Infra", "cost-center:123"},
+		MergeDefaultTags([]string{"web", "Team:Infra"}, []string{"team:infra", "cost-center:123"}),
+	)
+
+	require.Equal(t,
+		[]string{"team:infra"},
+		MergeDefaultTags(nil, []string{"team:infra"}),
+	)
+
+	require.Empty(t, MergeDefaultTags(nil, nil))
+}
+
+func TestExcludeDefaultTags(t *testing.T) {
+	defaultTags := []string{"team:infra", "cost-center:123"}
+
+	require.Equal(t,
+		[]string{"web"},
+		ExcludeDefaultTags([]string{"web", "Team:Infra", "cost-center:123"}, defaultTags, nil),
+	)
+
+	require.Equal(t,
+		[]string{"web", "cost-center:123"},
+		ExcludeDefaultTags(
+			[]string{"web", "team:infra", "cost-center:123"}, defaultTags, []string{"Cost-Center:123"},
+		),
+	)
+}
+
+func TestPreserveTagsCase(t *testing.T) {
+	require.Equal(t,
+		[]string{"Team:Infra", "web"},
+		preserveTagsCase([]string{"team:infra", "web"}, []string{"Team:Infra"}),
+	)
+}
+
+func TestFrameworkTags(t *testing.T) {
+	tags, known := FrameworkTags(types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("web"),
+	}))
+	require.True(t, known)
+	require.Equal(t, []string{"web"}, tags)
+
+	_, known = FrameworkTags(types.SetUnknown(types.StringType))
+	require.False(t, known)
+
+	_, known = FrameworkTags(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringUnknown(),
+	}))
+	require.False(t, known)
+
+	tags, known = FrameworkTags(types.ListNull(types.StringType))
+	require.True(t, known)
+	require.Empty(t, tags)
+}
+
+func TestFrameworkTagsAll(t *testing.T) {
+	meta := &FrameworkProviderMeta{
+		Config: &FrameworkProviderModel{
+			DefaultTags: defaultTagsModels([]string{"team:infra"}),
+		},
+	}
+
+	require.Equal(t,
+		[]string{"web", "team:infra"},
+		FrameworkTagsAll(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web")}), meta),
+	)
+
+	require.Equal(t,
+		[]string{"web"},
+		FrameworkTagsAll(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web")}), nil),
+	)
+}
@@ -246,3 +246,14 @@ func KeepOrUpdateSetNestedAttributeWithTypes[T any](
 	}
 	return &updated
 }
+
+// ValuesKnown returns whether all the given values are known and not null.
+func ValuesKnown(values ...attr.Value) bool {
+	for _, value := range values {
+		if value.IsUnknown() || value.IsNull() {
+			return false
+		}
+	}
+
+	return true
+}
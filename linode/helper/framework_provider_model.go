@@ -1,6 +1,10 @@
 package helper
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 )
@@ -9,12 +13,15 @@ import (
 func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *FrameworkProviderModel {
 	return &FrameworkProviderModel{
 		AccessToken:                  types.StringValue(config.AccessToken),
+		TokenCommand:                 tokenCommandValue(config.TokenCommand),
 		APIURL:                       types.StringValue(config.APIURL),
 		APIVersion:                   types.StringValue(config.APIVersion),
 		APICAPath:                    types.StringValue(config.APICAPath),
 		UAPrefix:                     types.StringValue(config.UAPrefix),
+		ChildAccountEUUID:            types.StringValue(config.ChildAccountEUUID),
 		ConfigPath:                   types.StringValue(config.ConfigPath),
 		ConfigProfile:                types.StringValue(config.ConfigProfile),
+		LishPrivateKey:               lishPrivateKeyValue(config.LishPrivateKey),
 		SkipInstanceReadyPoll:        types.BoolValue(config.SkipInstanceReadyPoll),
 		SkipInstanceDeletePoll:       types.BoolValue(config.SkipInstanceDeletePoll),
 		SkipLKEClusterDeletePoll:     types.BoolValue(config.SkipLKEClusterDeletePoll),
@@ -22,9 +29,13 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 		DisableInternalCache:         types.BoolValue(config.DisableInternalCache),
 		MinRetryDelayMilliseconds:    types.Int64Value(int64(config.MinRetryDelayMilliseconds)),
 		MaxRetryDelayMilliseconds:    types.Int64Value(int64(config.MaxRetryDelayMilliseconds)),
+		MaxRequestsPerSecond:         types.Float64Value(config.MaxRequestsPerSecond),
+		MaxConcurrentRequests:        types.Int64Value(int64(config.MaxConcurrentRequests)),
 		EventPollMilliseconds:        types.Int64Value(int64(config.EventPollMilliseconds)),
 		LKEEventPollMilliseconds:     types.Int64Value(int64(config.LKEEventPollMilliseconds)),
 		LKENodeReadyPollMilliseconds: types.Int64Value(int64(config.LKENodeReadyPollMilliseconds)),
+		Retry:                        retryPolicyModels(config.RetryPolicies),
+		DefaultTags:                  defaultTagsModels(config.DefaultTags),
 		ObjAccessKey:                 types.StringValue(config.ObjAccessKey),
 		ObjSecretKey:                 types.StringValue(config.ObjSecretKey),
 		ObjUseTempKeys:               types.BoolValue(config.ObjUseTempKeys),
@@ -33,15 +44,20 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 }
 
 type FrameworkProviderModel struct {
-	AccessToken types.String `tfsdk:"token"`
-	APIURL      types.String `tfsdk:"url"`
-	APIVersion  types.String `tfsdk:"api_version"`
-	APICAPath   types.String `tfsdk:"api_ca_path"`
-	UAPrefix    types.String `tfsdk:"ua_prefix"`
+	AccessToken  types.String `tfsdk:"token"`
+	TokenCommand types.List   `tfsdk:"token_command"`
+	APIURL       types.String `tfsdk:"url"`
+	APIVersion   types.String `tfsdk:"api_version"`
+	APICAPath    types.String `tfsdk:"api_ca_path"`
+	UAPrefix     types.String `tfsdk:"ua_prefix"`
+
+	ChildAccountEUUID types.String `tfsdk:"child_account_euuid"`
 
 	ConfigPath    types.String `tfsdk:"config_path"`
 	ConfigProfile types.String `tfsdk:"config_profile"`
 
+	LishPrivateKey types.String `tfsdk:"lish_private_key"`
+
 	SkipInstanceReadyPoll    types.Bool `tfsdk:"skip_instance_ready_poll"`
 	SkipInstanceDeletePoll   types.Bool `tfsdk:"skip_instance_delete_poll"`
 	SkipLKEClusterDeletePoll types.Bool `tfsdk:"skip_lke_cluster_delete_poll"`
@@ -53,17 +69,99 @@ type FrameworkProviderModel struct {
 	MinRetryDelayMilliseconds types.Int64 `tfsdk:"min_retry_delay_ms"`
 	MaxRetryDelayMilliseconds types.Int64 `tfsdk:"max_retry_delay_ms"`
 
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
 	EventPollMilliseconds    types.Int64 `tfsdk:"event_poll_ms"`
 	LKEEventPollMilliseconds types.Int64 `tfsdk:"lke_event_poll_ms"`
 
 	LKENodeReadyPollMilliseconds types.Int64 `tfsdk:"lke_node_ready_poll_ms"`
 
+	Retry []FrameworkProviderRetryModel `tfsdk:"retry"`
+
+	DefaultTags []FrameworkProviderDefaultTagsModel `tfsdk:"default_tags"`
+
 	ObjAccessKey         types.String `tfsdk:"obj_access_key"`
 	ObjSecretKey         types.String `tfsdk:"obj_secret_key"`
 	ObjUseTempKeys       types.Bool   `tfsdk:"obj_use_temp_keys"`
 	ObjBucketForceDelete types.Bool   `tfsdk:"obj_bucket_force_delete"`
 }
 
+func tokenCommandValue(command []string) types.List {
+	if len(command) < 1 {
+		return types.ListNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, len(command))
+	for i, arg := range command {
+		elements[i] = types.StringValue(arg)
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func lishPrivateKeyValue(key string) types.String {
+	if key == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(key)
+}
+
+type FrameworkProviderRetryModel struct {
+	StatusCodes         types.List   `tfsdk:"status_codes"`
+	PathRegex           types.String `tfsdk:"path_regex"`
+	MaxAttempts         types.Int64  `tfsdk:"max_attempts"`
+	BackoffMilliseconds types.Int64  `tfsdk:"backoff_ms"`
+}
+
+// RetryPolicy returns the retry policy configured by this model.
+func (m *FrameworkProviderRetryModel) RetryPolicy(ctx context.Context, diags *diag.Diagnostics) RetryPolicy {
+	policy := RetryPolicy{
+		PathRegex:           m.PathRegex.ValueString(),
+		MaxAttempts:         int(m.MaxAttempts.ValueInt64()),
+		BackoffMilliseconds: int(m.BackoffMilliseconds.ValueInt64()),
+	}
+
+	diags.Append(m.StatusCodes.ElementsAs(ctx, &policy.StatusCodes, false)...)
+
+	return policy
+}
+
+func retryPolicyModels(policies []RetryPolicy) []FrameworkProviderRetryModel {
+	result := make([]FrameworkProviderRetryModel, len(policies))
+
+	for i, policy := range policies {
+		statusCodes := make([]attr.Value, len(policy.StatusCodes))
+		for j, statusCode := range policy.StatusCodes {
+			statusCodes[j] = types.Int64Value(int64(statusCode))
+		}
+
+		result[i] = FrameworkProviderRetryModel{
+			StatusCodes:         types.ListValueMust(types.Int64Type, statusCodes),
+			PathRegex:           types.StringValue(policy.PathRegex),
+			MaxAttempts:         types.Int64Value(int64(policy.MaxAttempts)),
+			BackoffMilliseconds: types.Int64Value(int64(policy.BackoffMilliseconds)),
+		}
+	}
+
+	return result
+}
+
+type FrameworkProviderDefaultTagsModel struct {
+	Tags types.Set `tfsdk:"tags"`
+}
+
+func defaultTagsModels(tags []string) []FrameworkProviderDefaultTagsModel {
+	if len(tags) < 1 {
+		return nil
+	}
+
+	return []FrameworkProviderDefaultTagsModel{
+		{Tags: types.SetValueMust(types.StringType, StringSliceToFrameworkValueSlice(tags))},
+	}
+}
+
 type FrameworkProviderMeta struct {
 	Client *linodego.Client
 	Config *FrameworkProviderModel
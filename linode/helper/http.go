@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -14,6 +15,74 @@ import (
 // HTTPClientModifier is the signature for functions used to modify an HTTP client before use.
 type HTTPClientModifier func(client *http.Client) error
 
+// TokenSource provides the bearer tokens used to authenticate API requests.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// tokenTransport authenticates requests using the tokens of a TokenSource.
+type tokenTransport struct {
+	source    TokenSource
+	transport http.RoundTripper
+	skip      func(r *http.Request) bool
+}
+
+// NewTokenTransport wraps the given RoundTripper so that all requests are authenticated
+// with a token from the given source. Requests matching skip are sent unmodified.
+func NewTokenTransport(
+	source TokenSource,
+	transport http.RoundTripper,
+	skip func(r *http.Request) bool,
+) http.RoundTripper {
+	return &tokenTransport{
+		source:    source,
+		transport: transport,
+		skip:      skip,
+	}
+}
+
+func (t *tokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.skip != nil && t.skip(r) {
+		return t.transport.RoundTrip(r)
+	}
+
+	token, err := t.source.Token(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// RoundTrippers must not modify the original request
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+
+	return t.transport.RoundTrip(r)
+}
+
+// NewAuthTransport wraps the given RoundTripper with the transports authenticating API requests.
+// Tokens from tokenSource, if any, are set on every request. If childAccountEUUID is set, they are
+// then replaced with proxy tokens for the child account, which are issued using the tokenSource
+// tokens. The returned ChildAccountTokenSource is nil if childAccountEUUID is empty.
+func NewAuthTransport(
+	transport http.RoundTripper,
+	tokenSource TokenSource,
+	childAccountEUUID string,
+) (http.RoundTripper, *ChildAccountTokenSource) {
+	var childAccountTokens *ChildAccountTokenSource
+
+	// The child account transport must be the inner layer so
+	// its proxy tokens aren't overwritten by tokenSource tokens.
+	if childAccountEUUID != "" {
+		childAccountTokens = NewChildAccountTokenSource(childAccountEUUID)
+		transport = childAccountTokens.Transport(transport)
+	}
+
+	if tokenSource != nil {
+		transport = NewTokenTransport(tokenSource, transport, nil)
+	}
+
+	return transport, childAccountTokens
+}
+
 // AddRootCAToTransport applies the cert file at the given path to the given *http.Transport
 func AddRootCAToTransport(cert string, transport *http.Transport) error {
 	certData, err := os.ReadFile(filepath.Clean(cert))
@@ -0,0 +1,25 @@
+package int64planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+const requiresReplaceIfVersionChangedDescription = "Changing this value after it has been set " +
+	"requires the resource to be replaced."
+
+// RequiresReplaceIfVersionChanged returns a plan modifier for the version attribute of a
+// write-only attribute that requires the resource to be replaced when the version changes.
+// Setting the version for the first time doesn't replace the resource, so existing
+// resources can adopt the write-only attribute.
+func RequiresReplaceIfVersionChanged() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		requiresReplaceIfVersionChangedDescription,
+		requiresReplaceIfVersionChangedDescription,
+	)
+}
@@ -0,0 +1,67 @@
+//go:build unit
+
+package int64planmodifiers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/int64planmodifiers"
+)
+
+func TestRequiresReplaceIfVersionChanged(t *testing.T) {
+	testCases := map[string]struct {
+		stateValue      types.Int64
+		planValue       types.Int64
+		requiresReplace bool
+	}{
+		"version-set": {
+			stateValue:      types.Int64Null(),
+			planValue:       types.Int64Value(1),
+			requiresReplace: false,
+		},
+		"version-changed": {
+			stateValue:      types.Int64Value(1),
+			planValue:       types.Int64Value(2),
+			requiresReplace: true,
+		},
+		"version-removed": {
+			stateValue:      types.Int64Value(1),
+			planValue:       types.Int64Null(),
+			requiresReplace: true,
+		},
+		"version-unchanged": {
+			stateValue:      types.Int64Value(1),
+			planValue:       types.Int64Value(1),
+			requiresReplace: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+			req := planmodifier.Int64Request{
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				StateValue:  testCase.stateValue,
+				PlanValue:   testCase.planValue,
+				ConfigValue: testCase.planValue,
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: req.PlanValue,
+			}
+
+			int64planmodifiers.RequiresReplaceIfVersionChanged().PlanModifyInt64(context.Background(), req, resp)
+
+			if resp.RequiresReplace != testCase.requiresReplace {
+				t.Errorf("expected requires replace %t, got %t", testCase.requiresReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package helper
+
+import "sync"
+
+// KeyedMutex provides a separate mutex for each key. It is used to serialize
+// read-modify-write API updates of an object that is managed by several resources,
+// e.g. the grants of a user, so their updates don't overwrite each other.
+//
+// Locks only serialize updates made within a single provider process. They do
+// not protect against concurrent changes from other Terraform runs or tools.
+type KeyedMutex struct {
+	locks sync.Map
+}
+
+// Lock locks the mutex of the given key and returns a function that unlocks it.
+func (m *KeyedMutex) Lock(key string) func() {
+	lock, _ := m.locks.LoadOrStore(key, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+
+	mutex.Lock()
+	return mutex.Unlock
+}
@@ -0,0 +1,59 @@
+//go:build unit
+
+package helper
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	var m KeyedMutex
+
+	unlock := m.Lock("a")
+
+	// Other keys are not blocked
+	m.Lock("b")()
+
+	locked := make(chan struct{})
+
+	go func() {
+		defer close(locked)
+		m.Lock("a")()
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("expected key to be locked")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	unlock()
+	<-locked
+}
+
+func TestKeyedMutex_concurrent(t *testing.T) {
+	var m KeyedMutex
+	var wg sync.WaitGroup
+
+	counter := 0
+
+	for range 50 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			unlock := m.Lock("key")
+			defer unlock()
+
+			counter++
+		}()
+	}
+
+	wg.Wait()
+	require.Equal(t, 50, counter)
+}
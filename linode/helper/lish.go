@@ -0,0 +1,122 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	cloudManagerURL = "https://cloud.linode.com"
+
+	lishDialTimeout = 30 * time.Second
+)
+
+// ansiEscapePattern matches the terminal escape sequences emitted by the Lish console.
+var ansiEscapePattern = regexp.MustCompile(`\x1b(\[[0-9;?]*[ -/]*[@-~]|[()][0-9A-Za-z]|[=>])`)
+
+// LishGateway returns the hostname of the Lish SSH gateway for the given region.
+func LishGateway(region string) string {
+	return fmt.Sprintf("lish-%s.linode.com", region)
+}
+
+// LishSSHCommand returns the SSH command that connects to the console of an instance through Lish.
+func LishSSHCommand(username, region, label string) string {
+	return fmt.Sprintf("ssh -t %s@%s %s", username, LishGateway(region), label)
+}
+
+// WeblishURL returns the URL of the Weblish console of an instance in Cloud Manager.
+func WeblishURL(instanceID int) string {
+	return fmt.Sprintf("%s/linodes/%d/lish/weblish", cloudManagerURL, instanceID)
+}
+
+// GlishURL returns the URL of the Glish graphical console of an instance in Cloud Manager.
+func GlishURL(instanceID int) string {
+	return fmt.Sprintf("%s/linodes/%d/lish/glish", cloudManagerURL, instanceID)
+}
+
+// GetLishConsoleOutput returns up to the given number of the most recent lines of
+// the console output of an instance, read with the Lish `logview` command.
+func GetLishConsoleOutput(
+	ctx context.Context,
+	privateKey, username, region, label string,
+	lines int,
+) ([]string, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Lish private key: %w", err)
+	}
+
+	config := &ssh.ClientConfig{
+		User: username,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		// Public key authentication is bound to the session, so a spoofed gateway
+		// could only forge the console output and never gains access to the instance.
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), // #nosec G106
+		Timeout:         lishDialTimeout,
+	}
+
+	addr := net.JoinHostPort(LishGateway(region), "22")
+
+	dialer := net.Dialer{Timeout: lishDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Lish gateway %s: %w", addr, err)
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to authenticate to Lish gateway %s: %w", addr, err)
+	}
+
+	client := ssh.NewClient(sshConn, chans, reqs)
+	defer client.Close()
+
+	// Closing the client aborts the command if the context ends first
+	stop := context.AfterFunc(ctx, func() { client.Close() })
+	defer stop()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open Lish session: %w", err)
+	}
+	defer session.Close()
+
+	output, err := session.Output(fmt.Sprintf("%s logview", label))
+	if err != nil && len(output) == 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("failed to read console output of %s through Lish: %w", label, err)
+	}
+
+	return LastConsoleLines(string(output), lines), nil
+}
+
+// LastConsoleLines returns up to the given number of the last non-empty lines of
+// the given console output, with terminal escape sequences removed.
+func LastConsoleLines(output string, count int) []string {
+	output = ansiEscapePattern.ReplaceAllString(output, "")
+
+	result := make([]string, 0, count)
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, " \r\t")
+		if line == "" {
+			continue
+		}
+		result = append(result, line)
+	}
+
+	if len(result) > count {
+		result = result[len(result)-count:]
+	}
+
+	return result
+}
@@ -0,0 +1,41 @@
+//go:build unit
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLishConnectionInfo(t *testing.T) {
+	require.Equal(t, "lish-us-east.linode.com", LishGateway("us-east"))
+	require.Equal(
+		t,
+		"ssh -t tester@lish-us-east.linode.com my-linode",
+		LishSSHCommand("tester", "us-east", "my-linode"),
+	)
+	require.Equal(t, "https://cloud.linode.com/linodes/123/lish/weblish", WeblishURL(123))
+	require.Equal(t, "https://cloud.linode.com/linodes/123/lish/glish", GlishURL(123))
+}
+
+func TestLastConsoleLines(t *testing.T) {
+	output := "\x1b[2J\x1b[HBooting `Debian 12 Disk Profile'\r\n\r\n" +
+		"[    0.000000] Linux version 6.1.0\r\n" +
+		"\x1b[0;32m  OK  \x1b[0m] Reached target Basic System.\r\n" +
+		"Debian GNU/Linux 12 localhost ttyS0\r\n\r\n"
+
+	require.Equal(t, []string{
+		"  OK  ] Reached target Basic System.",
+		"Debian GNU/Linux 12 localhost ttyS0",
+	}, LastConsoleLines(output, 2))
+
+	require.Equal(t, []string{
+		"Booting `Debian 12 Disk Profile'",
+		"[    0.000000] Linux version 6.1.0",
+		"  OK  ] Reached target Basic System.",
+		"Debian GNU/Linux 12 localhost ttyS0",
+	}, LastConsoleLines(output, 10))
+
+	require.Empty(t, LastConsoleLines("\r\n\r\n", 10))
+}
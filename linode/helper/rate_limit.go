@@ -0,0 +1,206 @@
+package helper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitOptions configures the client-side limits applied to API requests.
+type RateLimitOptions struct {
+	// MaxRequestsPerSecond is the sustained rate of API requests. Zero disables rate limiting.
+	MaxRequestsPerSecond float64
+
+	// MaxConcurrentRequests is the number of API requests that can be in flight
+	// at the same time. Zero disables the concurrency limit.
+	MaxConcurrentRequests int64
+}
+
+// IsEnabled returns whether any limits are configured.
+func (o RateLimitOptions) IsEnabled() bool {
+	return o.MaxRequestsPerSecond > 0 || o.MaxConcurrentRequests > 0
+}
+
+// RateLimiter limits the rate and concurrency of API requests and pauses
+// all requests when the API responds with a Retry-After header.
+type RateLimiter struct {
+	limiter     *rate.Limiter
+	concurrency *semaphore.Weighted
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+
+	// now can be overridden in tests.
+	now func() time.Time
+}
+
+// RateLimitIdentity returns the values identifying the account targeted by a provider configuration,
+// used to share rate limiters between provider instances.
+func RateLimitIdentity(
+	apiURL, accessToken string,
+	tokenCommand []string,
+	childAccountEUUID, configPath, configProfile string,
+) []string {
+	if apiURL == "" {
+		apiURL = DefaultLinodeURL
+	}
+
+	return []string{
+		apiURL,
+		accessToken,
+		strings.Join(tokenCommand, " "),
+		childAccountEUUID,
+		configPath,
+		configProfile,
+	}
+}
+
+// rateLimiters holds the rate limiters shared between the SDKv2 and framework providers.
+var rateLimiters sync.Map
+
+// NewRateLimiter creates a new rate limiter with the given options.
+func NewRateLimiter(opts RateLimitOptions) *RateLimiter {
+	result := &RateLimiter{
+		now: time.Now,
+	}
+
+	if opts.MaxRequestsPerSecond > 0 {
+		burst := int(math.Max(1, math.Floor(opts.MaxRequestsPerSecond)))
+		result.limiter = rate.NewLimiter(rate.Limit(opts.MaxRequestsPerSecond), burst)
+	}
+
+	if opts.MaxConcurrentRequests > 0 {
+		result.concurrency = semaphore.NewWeighted(opts.MaxConcurrentRequests)
+	}
+
+	return result
+}
+
+// SharedRateLimiter returns the rate limiter for the given account identity, creating it if necessary.
+// Provider instances configured with the same options and identity (e.g. the SDKv2 and framework
+// providers) share a single rate limiter.
+func SharedRateLimiter(opts RateLimitOptions, identity ...string) *RateLimiter {
+	hash := sha256.Sum256([]byte(strings.Join(identity, "\x00")))
+	key := fmt.Sprintf("%s/%v/%d", hex.EncodeToString(hash[:]), opts.MaxRequestsPerSecond, opts.MaxConcurrentRequests)
+
+	limiter, _ := rateLimiters.LoadOrStore(key, NewRateLimiter(opts))
+
+	return limiter.(*RateLimiter)
+}
+
+// Transport wraps the given RoundTripper so that all requests are subject to this rate limiter.
+func (l *RateLimiter) Transport(transport http.RoundTripper) http.RoundTripper {
+	return &rateLimitedTransport{
+		limiter:   l,
+		transport: transport,
+	}
+}
+
+// wait blocks until a request can be sent or the context is cancelled.
+func (l *RateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	delay := l.blockedUntil.Sub(l.now())
+	l.mu.Unlock()
+
+	if delay > 0 {
+		tflog.Debug(ctx, "Waiting for API rate limit to reset", map[string]any{
+			"delay": delay.String(),
+		})
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if l.limiter != nil {
+		return l.limiter.Wait(ctx)
+	}
+
+	return nil
+}
+
+// blockFor pauses all requests for the given duration.
+func (l *RateLimiter) blockFor(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := l.now().Add(d); until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+type rateLimitedTransport struct {
+	limiter   *RateLimiter
+	transport http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	if t.limiter.concurrency != nil {
+		if err := t.limiter.concurrency.Acquire(ctx, 1); err != nil {
+			return nil, err
+		}
+		defer t.limiter.concurrency.Release(1)
+	}
+
+	if err := t.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), t.limiter.now()); ok {
+			tflog.Warn(ctx, "API rate limit exceeded, pausing requests", map[string]any{
+				"retry_after": retryAfter.String(),
+			})
+
+			t.limiter.blockFor(retryAfter)
+		}
+	}
+
+	return resp, nil
+}
+
+// parseRetryAfter parses the value of a Retry-After header,
+// which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,133 @@
+//go:build unit
+
+package helper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter("5", now)
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, d)
+
+	d, ok = parseRetryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Equal(t, 10*time.Second, d)
+
+	d, ok = parseRetryAfter(now.Add(-10*time.Second).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter("", now)
+	require.False(t, ok)
+
+	_, ok = parseRetryAfter("-1", now)
+	require.False(t, ok)
+
+	_, ok = parseRetryAfter("soon", now)
+	require.False(t, ok)
+}
+
+func TestRateLimiter_maxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	limiter := NewRateLimiter(RateLimitOptions{MaxConcurrentRequests: 2})
+	client := &http.Client{Transport: limiter.Transport(http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, int64(2), maxInFlight.Load())
+}
+
+func TestRateLimiter_retryAfter(t *testing.T) {
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	limiter := NewRateLimiter(RateLimitOptions{MaxRequestsPerSecond: 100})
+	client := &http.Client{Transport: limiter.Transport(http.DefaultTransport)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	// Subsequent requests wait for the Retry-After delay
+	start := time.Now()
+
+	resp, err = client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+
+	// Cancelled requests don't wait for the delay
+	limiter.blockFor(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	opts := RateLimitOptions{MaxRequestsPerSecond: 10}
+
+	require.Same(t, SharedRateLimiter(opts, "url", "token"), SharedRateLimiter(opts, "url", "token"))
+	require.NotSame(t, SharedRateLimiter(opts, "url", "token"), SharedRateLimiter(opts, "url", "other"))
+	require.NotSame(
+		t,
+		SharedRateLimiter(opts, "url", "token"),
+		SharedRateLimiter(RateLimitOptions{MaxConcurrentRequests: 1}, "url", "token"),
+	)
+}
@@ -0,0 +1,223 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+)
+
+// RegionRequirements are the capabilities and plan types a resource requires from its region.
+type RegionRequirements struct {
+	// Capabilities are the region capabilities required by the resource, e.g. linodego.CapabilityVPCs.
+	Capabilities []string
+
+	// PlanTypes are the plan types which must be available in the region, e.g. g6-standard-1.
+	PlanTypes []string
+}
+
+// Require adds a capability to the requirements.
+func (r *RegionRequirements) Require(capability string) {
+	if !slices.Contains(r.Capabilities, capability) {
+		r.Capabilities = append(r.Capabilities, capability)
+	}
+}
+
+// RequirePlanType adds a plan type to the requirements if it is not empty.
+func (r *RegionRequirements) RequirePlanType(planType string) {
+	if planType != "" && !slices.Contains(r.PlanTypes, planType) {
+		r.PlanTypes = append(r.PlanTypes, planType)
+	}
+}
+
+type accountAvailabilityKey struct {
+	client *linodego.Client
+	region string
+}
+
+type cachedAccountAvailability struct {
+	availability *linodego.AccountAvailability
+	expires      time.Time
+}
+
+// accountAvailabilityCacheTTL matches the expiration of the responses cached by linodego.
+const accountAvailabilityCacheTTL = linodego.APIDefaultCacheExpiration
+
+// Account availabilities aren't cached by linodego, so they are cached here to avoid
+// a request for each planned resource. Expired entries are evicted whenever a new
+// entry is stored, so entries of clients which are no longer used don't accumulate.
+var accountAvailabilityCache sync.Map
+
+// timeNow returns the current time, and is replaced in tests.
+var timeNow = time.Now
+
+func getAccountAvailability(
+	ctx context.Context,
+	client *linodego.Client,
+	region string,
+) (*linodego.AccountAvailability, error) {
+	key := accountAvailabilityKey{client: client, region: region}
+
+	if cached, ok := accountAvailabilityCache.Load(key); ok {
+		if entry := cached.(cachedAccountAvailability); timeNow().Before(entry.expires) {
+			return entry.availability, nil
+		}
+	}
+
+	availability, err := client.GetAccountAvailability(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timeNow()
+
+	accountAvailabilityCache.Range(func(key, value any) bool {
+		if !now.Before(value.(cachedAccountAvailability).expires) {
+			accountAvailabilityCache.Delete(key)
+		}
+		return true
+	})
+
+	accountAvailabilityCache.Store(key, cachedAccountAvailability{
+		availability: availability,
+		expires:      now.Add(accountAvailabilityCacheTTL),
+	})
+
+	return availability, nil
+}
+
+// CheckRegionRequirements returns the reasons the given region doesn't meet the requirements,
+// based on the capabilities of the region, the services available to the account in the region
+// and the availability of plan types in the region.
+//
+// Failures to retrieve this information (e.g. due to missing permissions) are logged and ignored,
+// so that a plan is never blocked by this check unless a requirement is known to be unmet.
+func CheckRegionRequirements(
+	ctx context.Context,
+	client *linodego.Client,
+	region string,
+	requirements RegionRequirements,
+) []string {
+	var problems []string
+
+	ctx = tflog.SetField(ctx, "region", region)
+
+	regionInfo, err := client.GetRegion(ctx, region)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			return []string{fmt.Sprintf("Region %s does not exist.", region)}
+		}
+
+		tflog.Warn(ctx, "Failed to get region, skipping region capability validation", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	if regionInfo != nil {
+		for _, capability := range requirements.Capabilities {
+			if !slices.ContainsFunc(regionInfo.Capabilities, func(c string) bool {
+				return strings.EqualFold(c, capability)
+			}) {
+				problems = append(problems, fmt.Sprintf("Region %s does not support %s.", region, capability))
+			}
+		}
+	}
+
+	if len(requirements.Capabilities) > 0 {
+		availability, err := getAccountAvailability(ctx, client, region)
+		if err != nil {
+			tflog.Warn(ctx, "Failed to get account availability, skipping account availability validation", map[string]any{
+				"error": err.Error(),
+			})
+		} else {
+			for _, capability := range requirements.Capabilities {
+				if slices.ContainsFunc(availability.Unavailable, func(c string) bool {
+					return strings.EqualFold(c, capability)
+				}) {
+					problems = append(
+						problems,
+						fmt.Sprintf("%s is not available to this account in region %s.", capability, region),
+					)
+				}
+			}
+		}
+	}
+
+	if len(requirements.PlanTypes) > 0 {
+		// This endpoint is cached by linodego
+		planAvailabilities, err := client.ListRegionsAvailability(ctx, nil)
+		if err != nil {
+			tflog.Warn(ctx, "Failed to list region availabilities, skipping plan availability validation", map[string]any{
+				"error": err.Error(),
+			})
+		}
+
+		for _, planType := range requirements.PlanTypes {
+			if slices.ContainsFunc(planAvailabilities, func(a linodego.RegionAvailability) bool {
+				return a.Region == region && a.Plan == planType && !a.Available
+			}) {
+				problems = append(problems, fmt.Sprintf("Plan type %s is not available in region %s.", planType, region))
+			}
+		}
+	}
+
+	return problems
+}
+
+// ValidateRegionRequirements adds an error at the given region attribute path for each requirement
+// the region doesn't meet. The validation is skipped if the region is not known.
+func ValidateRegionRequirements(
+	ctx context.Context,
+	client *linodego.Client,
+	regionPath path.Path,
+	region types.String,
+	requirements RegionRequirements,
+	diags *diag.Diagnostics,
+) {
+	if client == nil || region.IsNull() || region.IsUnknown() || region.ValueString() == "" {
+		return
+	}
+
+	for _, problem := range CheckRegionRequirements(ctx, client, region.ValueString(), requirements) {
+		diags.AddAttributeError(regionPath, "Region Requirements Not Met", problem)
+	}
+}
+
+// SDKv2ValidateRegionRequirements returns a CustomizeDiffFunc validating the requirements of a
+// resource being created against its region attribute.
+func SDKv2ValidateRegionRequirements(
+	regionKey string,
+	getRequirements func(diff *schema.ResourceDiff) RegionRequirements,
+) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		if diff.Id() != "" || !diff.NewValueKnown(regionKey) {
+			return nil
+		}
+
+		region := diff.Get(regionKey).(string)
+		if region == "" {
+			return nil
+		}
+
+		providerMeta, ok := meta.(*ProviderMeta)
+		if !ok {
+			return nil
+		}
+
+		problems := CheckRegionRequirements(ctx, &providerMeta.Client, region, getRequirements(diff))
+		if len(problems) > 0 {
+			return fmt.Errorf("%s: %s", regionKey, strings.Join(problems, " "))
+		}
+
+		return nil
+	}
+}
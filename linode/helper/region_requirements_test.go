@@ -0,0 +1,158 @@
+//go:build unit
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegionRequirementsTestClient(t *testing.T, routes map[string]any) *linodego.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+
+		if status, ok := body.(int); ok {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Unauthorized"}]}`))
+			return
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+	client.SetAPIVersion("v4")
+	client.SetRetryCount(0)
+
+	return &client
+}
+
+func TestCheckRegionRequirements(t *testing.T) {
+	client := newRegionRequirementsTestClient(t, map[string]any{
+		"/v4/regions/us-east": map[string]any{
+			"id":           "us-east",
+			"capabilities": []string{"Linodes", "Block Storage", "VPCs"},
+		},
+		"/v4/account/availability/us-east": map[string]any{
+			"region":      "us-east",
+			"available":   []string{"Linodes", "Block Storage"},
+			"unavailable": []string{"VPCs"},
+		},
+		"/v4/regions/availability": map[string]any{
+			"data": []map[string]any{
+				{"region": "us-east", "plan": "g6-standard-1", "available": true},
+				{"region": "us-east", "plan": "g1-gpu-rtx6000-1", "available": false},
+				{"region": "us-ord", "plan": "g6-standard-2", "available": false},
+			},
+			"page":    1,
+			"pages":   1,
+			"results": 3,
+		},
+	})
+
+	problems := CheckRegionRequirements(context.Background(), client, "us-east", RegionRequirements{
+		Capabilities: []string{linodego.CapabilityLinodes},
+		PlanTypes:    []string{"g6-standard-1", "g6-standard-2"},
+	})
+	require.Empty(t, problems)
+
+	problems = CheckRegionRequirements(context.Background(), client, "us-east", RegionRequirements{
+		Capabilities: []string{linodego.CapabilityLinodes, linodego.CapabilityVPCs, linodego.CapabilityPlacementGroup},
+		PlanTypes:    []string{"g1-gpu-rtx6000-1"},
+	})
+	require.Equal(t, []string{
+		"Region us-east does not support Placement Group.",
+		"VPCs is not available to this account in region us-east.",
+		"Plan type g1-gpu-rtx6000-1 is not available in region us-east.",
+	}, problems)
+
+	problems = CheckRegionRequirements(context.Background(), client, "us-west", RegionRequirements{
+		Capabilities: []string{linodego.CapabilityLinodes},
+	})
+	require.Equal(t, []string{"Region us-west does not exist."}, problems)
+}
+
+func TestCheckRegionRequirements_ignoreErrors(t *testing.T) {
+	client := newRegionRequirementsTestClient(t, map[string]any{
+		"/v4/regions/us-east":              http.StatusForbidden,
+		"/v4/account/availability/us-east": http.StatusForbidden,
+		"/v4/regions/availability":         http.StatusForbidden,
+	})
+
+	problems := CheckRegionRequirements(context.Background(), client, "us-east", RegionRequirements{
+		Capabilities: []string{linodego.CapabilityVPCs},
+		PlanTypes:    []string{"g6-standard-1"},
+	})
+	require.Empty(t, problems)
+}
+
+func TestGetAccountAvailabilityExpiry(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"region":      "us-east",
+			"unavailable": []string{},
+		}))
+	}))
+	t.Cleanup(server.Close)
+
+	newClient := func() *linodego.Client {
+		client := linodego.NewClient(server.Client())
+		client.SetBaseURL(server.URL)
+		client.SetAPIVersion("v4")
+		client.SetRetryCount(0)
+
+		return &client
+	}
+
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = time.Now })
+
+	ctx := context.Background()
+	client := newClient()
+
+	_, err := getAccountAvailability(ctx, client, "us-east")
+	require.NoError(t, err)
+	_, err = getAccountAvailability(ctx, client, "us-east")
+	require.NoError(t, err)
+	require.Equal(t, 1, requests)
+
+	// Expired entries are fetched again
+	now = now.Add(accountAvailabilityCacheTTL)
+
+	_, err = getAccountAvailability(ctx, client, "us-east")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+
+	// Expired entries of other clients are evicted
+	now = now.Add(accountAvailabilityCacheTTL)
+
+	_, err = getAccountAvailability(ctx, newClient(), "us-east")
+	require.NoError(t, err)
+
+	_, ok := accountAvailabilityCache.Load(accountAvailabilityKey{client: client, region: "us-east"})
+	require.False(t, ok)
+}
@@ -6,6 +6,8 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -77,22 +79,124 @@ func OBJBucketDelete500Retry() func(response *resty.Response, err error) bool {
 
 func GenericRetryCondition(statusCode int, pathPattern *regexp.Regexp) func(response *resty.Response, err error) bool {
 	return func(response *resty.Response, _ error) bool {
-		if response.StatusCode() != statusCode || response.Request == nil {
+		if response.StatusCode() != statusCode {
 			return false
 		}
 
-		requestURL, err := url.ParseRequestURI(response.Request.URL)
+		return requestPathMatches(response, pathPattern)
+	}
+}
+
+func requestPathMatches(response *resty.Response, pathPattern *regexp.Regexp) bool {
+	if response.Request == nil {
+		return false
+	}
+
+	requestURL, err := url.ParseRequestURI(response.Request.URL)
+	if err != nil {
+		log.Printf("[WARN] failed to parse request URL: %s", err)
+		return false
+	}
+
+	// Check whether the string matches
+	return pathPattern.MatchString(requestURL.Path)
+}
+
+// DefaultRetryPolicyMaxAttempts is the number of attempts made for a request
+// matching a RetryPolicy that doesn't specify MaxAttempts.
+const DefaultRetryPolicyMaxAttempts = 3
+
+// RetryPolicy is a user-defined condition for retrying failed API requests,
+// configured through the retry blocks of the provider.
+type RetryPolicy struct {
+	// StatusCodes are the response status codes to retry.
+	StatusCodes []int
+
+	// PathRegex restricts the policy to requests whose URL path matches it.
+	// If empty, requests to all paths are retried.
+	PathRegex string
+
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one.
+	MaxAttempts int
+
+	// BackoffMilliseconds is the delay before the first retry, doubled on each
+	// subsequent retry. If zero, the retry delays of the client are used.
+	BackoffMilliseconds int
+}
+
+type compiledRetryPolicy struct {
+	RetryPolicy
+
+	pathPattern *regexp.Regexp
+}
+
+func compileRetryPolicies(policies []RetryPolicy) ([]compiledRetryPolicy, error) {
+	result := make([]compiledRetryPolicy, len(policies))
+
+	for i, policy := range policies {
+		pathPattern, err := regexp.Compile(policy.PathRegex)
 		if err != nil {
-			log.Printf("[WARN] failed to parse request URL: %s", err)
-			return false
+			return nil, fmt.Errorf("failed to compile path_regex of retry policy %d: %w", i, err)
+		}
+
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = DefaultRetryPolicyMaxAttempts
+		}
+
+		result[i] = compiledRetryPolicy{
+			RetryPolicy: policy,
+			pathPattern: pathPattern,
 		}
+	}
+
+	return result, nil
+}
 
-		// Check whether the string matches
-		return pathPattern.MatchString(requestURL.Path)
+// shouldRetry returns whether the request of the given response should be retried under this policy.
+func (p *compiledRetryPolicy) shouldRetry(response *resty.Response) bool {
+	if !slices.Contains(p.StatusCodes, response.StatusCode()) || !requestPathMatches(response, p.pathPattern) {
+		return false
 	}
+
+	return response.Request.Attempt < p.MaxAttempts
+}
+
+// backoff returns the delay before retrying the request of the given response.
+func (p *compiledRetryPolicy) backoff(response *resty.Response) time.Duration {
+	retry := max(response.Request.Attempt-1, 0)
+
+	return time.Duration(p.BackoffMilliseconds) * time.Millisecond << min(retry, 16)
 }
 
-func ApplyAllRetryConditions(client *linodego.Client) {
+// retryPolicyBackoff returns a RetryAfter function that delays retries of requests
+// matching a policy with a backoff, and otherwise respects the Retry-After header
+// of the response like the default behavior of linodego.
+func retryPolicyBackoff(policies []compiledRetryPolicy) linodego.RetryAfter {
+	return func(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+		for _, policy := range policies {
+			if policy.BackoffMilliseconds > 0 && policy.shouldRetry(response) {
+				return policy.backoff(response), nil
+			}
+		}
+
+		retryAfter := response.Header().Get("Retry-After")
+		if retryAfter == "" {
+			return 0, nil
+		}
+
+		seconds, err := strconv.Atoi(retryAfter)
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(seconds) * time.Second, nil
+	}
+}
+
+// ApplyAllRetryConditions adds the built-in retry conditions and the given
+// user-defined retry policies to the client.
+func ApplyAllRetryConditions(client *linodego.Client, policies ...RetryPolicy) error {
 	client.AddRetryCondition(Database502Retry())
 	client.AddRetryCondition(LinodeInstance500Retry())
 	client.AddRetryCondition(ImageUpload500Retry())
@@ -100,6 +204,23 @@ func ApplyAllRetryConditions(client *linodego.Client) {
 	client.AddRetryCondition(OBJKeyDelete500Retry())
 	client.AddRetryCondition(OBJBucketCreate500Retry())
 	client.AddRetryCondition(OBJBucketDelete500Retry())
+
+	compiledPolicies, err := compileRetryPolicies(policies)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range compiledPolicies {
+		client.AddRetryCondition(func(response *resty.Response, _ error) bool {
+			return policy.shouldRetry(response)
+		})
+	}
+
+	if slices.ContainsFunc(policies, func(p RetryPolicy) bool { return p.BackoffMilliseconds > 0 }) {
+		client.SetRetryAfter(retryPolicyBackoff(compiledPolicies))
+	}
+
+	return nil
 }
 
 // WithRetries runs the given retryFunc at most maxRetries times
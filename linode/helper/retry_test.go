@@ -0,0 +1,131 @@
+//go:build unit
+
+package helper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTestClient(t *testing.T, failures int, statusCode int) (*linodego.Client, *atomic.Int64) {
+	t.Helper()
+
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if requests.Add(1) <= int64(failures) {
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Flaky region"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": "us-east"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.SetRetryMaxWaitTime(10 * time.Millisecond)
+
+	return &client, &requests
+}
+
+func TestApplyAllRetryConditions_policy(t *testing.T) {
+	client, requests := newRetryTestClient(t, 2, http.StatusBadGateway)
+
+	err := ApplyAllRetryConditions(client, RetryPolicy{
+		StatusCodes: []int{http.StatusBadGateway},
+		PathRegex:   "regions/[a-z-]+$",
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetRegion(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.EqualValues(t, 3, requests.Load())
+}
+
+func TestApplyAllRetryConditions_maxAttempts(t *testing.T) {
+	client, requests := newRetryTestClient(t, 5, http.StatusBadGateway)
+
+	err := ApplyAllRetryConditions(client, RetryPolicy{
+		StatusCodes: []int{http.StatusBadGateway},
+		MaxAttempts: 2,
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetRegion(context.Background(), "us-east")
+	require.Error(t, err)
+	require.EqualValues(t, 2, requests.Load())
+}
+
+func TestApplyAllRetryConditions_noMatch(t *testing.T) {
+	client, requests := newRetryTestClient(t, 1, http.StatusBadGateway)
+
+	err := ApplyAllRetryConditions(
+		client,
+		RetryPolicy{StatusCodes: []int{http.StatusGatewayTimeout}},
+		RetryPolicy{StatusCodes: []int{http.StatusBadGateway}, PathRegex: "linode/instances"},
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetRegion(context.Background(), "us-east")
+	require.Error(t, err)
+	require.EqualValues(t, 1, requests.Load())
+}
+
+func TestApplyAllRetryConditions_invalidRegex(t *testing.T) {
+	client := linodego.NewClient(nil)
+
+	err := ApplyAllRetryConditions(&client, RetryPolicy{
+		StatusCodes: []int{http.StatusBadGateway},
+		PathRegex:   "[",
+	})
+	require.ErrorContains(t, err, "path_regex")
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policies, err := compileRetryPolicies([]RetryPolicy{
+		{StatusCodes: []int{http.StatusBadGateway}, PathRegex: "regions", BackoffMilliseconds: 100, MaxAttempts: 5},
+	})
+	require.NoError(t, err)
+
+	retryAfter := retryPolicyBackoff(policies)
+
+	response := func(statusCode, attempt int, header http.Header) *resty.Response {
+		return &resty.Response{
+			Request: &resty.Request{
+				URL:     "https://api.linode.com/v4/regions/us-east",
+				Attempt: attempt,
+			},
+			RawResponse: &http.Response{StatusCode: statusCode, Header: header},
+		}
+	}
+
+	delay, err := retryAfter(nil, response(http.StatusBadGateway, 1, http.Header{}))
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, delay)
+
+	delay, err = retryAfter(nil, response(http.StatusBadGateway, 3, http.Header{}))
+	require.NoError(t, err)
+	require.Equal(t, 400*time.Millisecond, delay)
+
+	// Requests not matching a policy respect the Retry-After header
+	delay, err = retryAfter(nil, response(http.StatusTooManyRequests, 1, http.Header{"Retry-After": {"2"}}))
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, delay)
+
+	delay, err = retryAfter(nil, response(http.StatusTooManyRequests, 1, http.Header{}))
+	require.NoError(t, err)
+	require.Zero(t, delay)
+}
@@ -0,0 +1,127 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// TokenCommandRefreshBuffer is how long before expiry a token returned
+// by the token command is replaced with a new one.
+const TokenCommandRefreshBuffer = 1 * time.Minute
+
+// tokenCommandOutput is the JSON document a token command may print
+// to report the expiry of the returned token.
+type tokenCommandOutput struct {
+	Token  string     `json:"token"`
+	Expiry *time.Time `json:"expiry"`
+}
+
+// TokenCommandSource runs an external command to retrieve the API token
+// and caches the token until the expiry reported by the command.
+type TokenCommandSource struct {
+	command []string
+
+	mu     sync.Mutex
+	token  string
+	expiry *time.Time
+
+	// now and run can be overridden in tests.
+	now func() time.Time
+	run func(ctx context.Context, command []string) ([]byte, error)
+}
+
+// NewTokenCommandSource creates a token source running the given command,
+// where the first element is the executable and the rest are its arguments.
+func NewTokenCommandSource(command []string) *TokenCommandSource {
+	return &TokenCommandSource{
+		command: command,
+		now:     time.Now,
+		run:     runTokenCommand,
+	}
+}
+
+// Token returns the cached token or runs the token command
+// if the cached token is missing or about to expire.
+func (s *TokenCommandSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry == nil || s.now().Add(TokenCommandRefreshBuffer).Before(*s.expiry)) {
+		return s.token, nil
+	}
+
+	if len(s.command) < 1 {
+		return "", fmt.Errorf("token command must not be empty")
+	}
+
+	tflog.Debug(ctx, "Running token command", map[string]any{
+		"command": s.command[0],
+	})
+
+	output, err := s.run(ctx, s.command)
+	if err != nil {
+		return "", err
+	}
+
+	token, expiry, err := parseTokenCommandOutput(output)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse output of token command %s: %w", s.command[0], err)
+	}
+
+	s.token = token
+	s.expiry = expiry
+
+	return s.token, nil
+}
+
+func runTokenCommand(ctx context.Context, command []string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	// #nosec G204 -- the command is explicitly configured by the user
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf(
+			"failed to run token command %s: %w: %s",
+			command[0], err, strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// parseTokenCommandOutput parses the output of a token command, which is either
+// the raw token or a JSON object with a token and an optional RFC3339 expiry.
+func parseTokenCommandOutput(output []byte) (string, *time.Time, error) {
+	trimmed := bytes.TrimSpace(output)
+
+	if !bytes.HasPrefix(trimmed, []byte("{")) {
+		if len(trimmed) < 1 {
+			return "", nil, fmt.Errorf("token command returned no token")
+		}
+
+		return string(trimmed), nil, nil
+	}
+
+	var result tokenCommandOutput
+
+	if err := json.Unmarshal(trimmed, &result); err != nil {
+		return "", nil, err
+	}
+
+	if result.Token == "" {
+		return "", nil, fmt.Errorf("token command returned no token")
+	}
+
+	return result.Token, result.Expiry, nil
+}
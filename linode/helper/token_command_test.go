@@ -0,0 +1,86 @@
+//go:build unit
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTokenCommandOutput(t *testing.T) {
+	token, expiry, err := parseTokenCommandOutput([]byte("  mytoken\n"))
+	require.NoError(t, err)
+	require.Equal(t, "mytoken", token)
+	require.Nil(t, expiry)
+
+	token, expiry, err = parseTokenCommandOutput([]byte(`{"token": "mytoken", "expiry": "2025-01-01T00:15:00Z"}`))
+	require.NoError(t, err)
+	require.Equal(t, "mytoken", token)
+	require.Equal(t, time.Date(2025, 1, 1, 0, 15, 0, 0, time.UTC), expiry.UTC())
+
+	token, expiry, err = parseTokenCommandOutput([]byte(`{"token": "mytoken"}`))
+	require.NoError(t, err)
+	require.Equal(t, "mytoken", token)
+	require.Nil(t, expiry)
+
+	_, _, err = parseTokenCommandOutput([]byte("\n"))
+	require.Error(t, err)
+
+	_, _, err = parseTokenCommandOutput([]byte(`{"expiry": "2025-01-01T00:15:00Z"}`))
+	require.Error(t, err)
+
+	_, _, err = parseTokenCommandOutput([]byte(`{"token": `))
+	require.Error(t, err)
+}
+
+func TestTokenCommandSource(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	runs := 0
+
+	source := NewTokenCommandSource([]string{"get-token", "--profile", "ci"})
+	source.now = func() time.Time { return now }
+	source.run = func(ctx context.Context, command []string) ([]byte, error) {
+		require.Equal(t, []string{"get-token", "--profile", "ci"}, command)
+
+		runs++
+
+		return []byte(fmt.Sprintf(
+			`{"token": "token-%d", "expiry": %q}`,
+			runs,
+			now.Add(10*time.Minute).Format(time.RFC3339),
+		)), nil
+	}
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+
+	// Cached tokens are reused until they are about to expire
+	now = now.Add(5 * time.Minute)
+
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+	require.Equal(t, 1, runs)
+
+	now = now.Add(5 * time.Minute)
+
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token-2", token)
+	require.Equal(t, 2, runs)
+}
+
+func TestTokenCommandSource_commandError(t *testing.T) {
+	source := NewTokenCommandSource([]string{"false"})
+
+	_, err := source.Token(context.Background())
+	require.Error(t, err)
+
+	_, err = NewTokenCommandSource(nil).Token(context.Background())
+	require.Error(t, err)
+}
@@ -0,0 +1,20 @@
+package fakeapi
+
+import (
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+// Client returns a Linode client for this server, to be used in test checks.
+func (s *Server) Client() *linodego.Client {
+	client := linodego.NewClient(s.server.Client())
+	client.SetBaseURL(s.URL)
+	client.SetAPIVersion("v4beta")
+	client.SetToken("fake-token")
+	client.SetPollDelay(50 * time.Millisecond)
+	client.SetRetryWaitTime(10 * time.Millisecond)
+	client.SetRetryMaxWaitTime(50 * time.Millisecond)
+
+	return &client
+}
@@ -0,0 +1,76 @@
+package fakeapi
+
+var (
+	domainKind       *resourceKind
+	domainRecordKind *resourceKind
+)
+
+func init() {
+	domainKind = &resourceKind{
+		pattern:    "domains",
+		entityType: "domain",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("domain", "type"); err != nil {
+				return nil, err
+			}
+
+			if req.body["type"] == "master" {
+				if err := req.require("soa_email"); err != nil {
+					return nil, err
+				}
+			}
+
+			return object{
+				"domain":      req.body["domain"],
+				"type":        req.body["type"],
+				"status":      req.string("status", "active"),
+				"soa_email":   req.string("soa_email", ""),
+				"description": req.string("description", ""),
+				"group":       req.string("group", ""),
+				"ttl_sec":     req.value("ttl_sec", 0),
+				"retry_sec":   req.value("retry_sec", 0),
+				"expire_sec":  req.value("expire_sec", 0),
+				"refresh_sec": req.value("refresh_sec", 0),
+				"master_ips":  req.list("master_ips"),
+				"axfr_ips":    req.list("axfr_ips"),
+				"tags":        req.list("tags"),
+			}, nil
+		},
+	}
+
+	domainRecordKind = &resourceKind{
+		pattern: "domains/{domainId}/records",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("type"); err != nil {
+				return nil, err
+			}
+
+			return object{
+				"type":     req.body["type"],
+				"name":     req.string("name", ""),
+				"target":   req.string("target", ""),
+				"priority": req.value("priority", 0),
+				"weight":   req.value("weight", 0),
+				"port":     req.value("port", 0),
+				"service":  req.value("service", nil),
+				"protocol": req.value("protocol", nil),
+				"ttl_sec":  req.value("ttl_sec", 0),
+				"tag":      req.value("tag", nil),
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			if domain, ok := s.get("domains", pathID(path, 1)); ok {
+				s.emitEvent("domain_record_create", "domain", domain.data, false)
+			}
+		},
+		beforeDelete: func(s *Server, req *request, rec *record) *apiError {
+			if domain, ok := s.get("domains", toInt(req.http.PathValue("domainId"))); ok {
+				s.emitEvent("domain_record_delete", "domain", domain.data, false)
+			}
+
+			return nil
+		},
+	}
+
+	resourceKinds = append(resourceKinds, domainKind, domainRecordKind)
+}
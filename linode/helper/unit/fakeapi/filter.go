@@ -0,0 +1,235 @@
+package fakeapi
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+)
+
+// listResponse is the paginated response of a list endpoint.
+type listResponse struct {
+	Data    []object `json:"data"`
+	Page    int      `json:"page"`
+	Pages   int      `json:"pages"`
+	Results int      `json:"results"`
+}
+
+// paginate filters, orders and paginates the given objects according to
+// the X-Filter header and the page and page_size query parameters of the request.
+func paginate(r *http.Request, objects []object) (*listResponse, *apiError) {
+	var filter object
+
+	if header := r.Header.Get("X-Filter"); header != "" {
+		if err := json.Unmarshal([]byte(header), &filter); err != nil {
+			return nil, errBadRequest("X-Filter", fmt.Sprintf("Invalid filter: %s", err))
+		}
+	}
+
+	filtered := make([]object, 0, len(objects))
+
+	for _, o := range objects {
+		if matchesFilter(o, filter) {
+			filtered = append(filtered, o)
+		}
+	}
+
+	if orderBy, ok := filter["+order_by"].(string); ok {
+		descending := filter["+order"] == "desc"
+
+		slices.SortStableFunc(filtered, func(a, b object) int {
+			result, _ := compareValues(lookupField(a, orderBy), lookupField(b, orderBy))
+			if descending {
+				return -result
+			}
+
+			return result
+		})
+	}
+
+	page := queryInt(r, "page", 1)
+	pageSize := min(queryInt(r, "page_size", defaultPageSize), maxPageSize)
+
+	pages := max(1, int(math.Ceil(float64(len(filtered))/float64(pageSize))))
+
+	start := min((page-1)*pageSize, len(filtered))
+	end := min(start+pageSize, len(filtered))
+
+	return &listResponse{
+		Data:    filtered[start:end],
+		Page:    page,
+		Pages:   pages,
+		Results: len(filtered),
+	}, nil
+}
+
+func queryInt(r *http.Request, name string, defaultValue int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil || value < 1 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// matchesFilter returns whether the object matches the given API filter.
+func matchesFilter(o object, filter object) bool {
+	for key, value := range filter {
+		switch key {
+		case "+order_by", "+order":
+			continue
+		case "+and", "+or":
+			conditions, _ := value.([]any)
+
+			matched := 0
+			for _, condition := range conditions {
+				if conditionFilter, ok := condition.(map[string]any); ok && matchesFilter(o, conditionFilter) {
+					matched++
+				}
+			}
+
+			if key == "+and" && matched != len(conditions) {
+				return false
+			}
+
+			if key == "+or" && matched == 0 && len(conditions) > 0 {
+				return false
+			}
+		default:
+			field := lookupField(o, key)
+
+			operators, ok := value.(map[string]any)
+			if !ok {
+				operators = map[string]any{"+eq": value}
+			}
+
+			for operator, operand := range operators {
+				if !matchesOperator(field, operator, operand) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func matchesOperator(field any, operator string, operand any) bool {
+	// Filtering a list field matches any of its elements, e.g. tags
+	if values, ok := field.([]any); ok && operator != "+neq" {
+		return slices.ContainsFunc(values, func(v any) bool {
+			return matchesOperator(v, operator, operand)
+		})
+	}
+
+	switch operator {
+	case "+eq":
+		return valuesEqual(field, operand)
+	case "+neq":
+		return !valuesEqual(field, operand)
+	case "+contains":
+		fieldString, ok1 := field.(string)
+		operandString, ok2 := operand.(string)
+
+		return ok1 && ok2 && strings.Contains(strings.ToLower(fieldString), strings.ToLower(operandString))
+	}
+
+	result, ok := compareValues(field, operand)
+	if !ok {
+		return false
+	}
+
+	switch operator {
+	case "+gt":
+		return result > 0
+	case "+gte":
+		return result >= 0
+	case "+lt":
+		return result < 0
+	case "+lte":
+		return result <= 0
+	}
+
+	return false
+}
+
+// lookupField returns the value of a field using dot notation for nested fields, e.g. entity.id.
+func lookupField(o object, key string) any {
+	var current any = o
+
+	for part := range strings.SplitSeq(key, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		current = m[part]
+	}
+
+	return current
+}
+
+func valuesEqual(a, b any) bool {
+	result, ok := compareValues(a, b)
+	return ok && result == 0
+}
+
+// compareValues compares two JSON values of the same type.
+func compareValues(a, b any) (int, bool) {
+	if af, ok := toFloat(a); ok {
+		bf, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+
+		return cmp.Compare(af, bf), true
+	}
+
+	switch av := a.(type) {
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+
+		return strings.Compare(av, bv), true
+	case bool:
+		bv, ok := b.(bool)
+		if !ok || av != bv {
+			return 0, false
+		}
+
+		return 0, true
+	case nil:
+		if b == nil {
+			return 0, true
+		}
+	}
+
+	return 0, false
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+}
@@ -0,0 +1,463 @@
+package fakeapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+const swapDiskSize = 512
+
+var (
+	instanceKind       *resourceKind
+	instanceDiskKind   *resourceKind
+	instanceConfigKind *resourceKind
+)
+
+func instancePath(id int) string {
+	return fmt.Sprintf("linode/instances/%d", id)
+}
+
+func publicIPv4(id int) string {
+	return fmt.Sprintf("192.0.2.%d", id%254+1)
+}
+
+func privateIPv4(id int) string {
+	return fmt.Sprintf("192.168.%d.%d", id/254%254, id%254+1)
+}
+
+func publicIPv6(id int) string {
+	return fmt.Sprintf("2001:db8::%x/128", id)
+}
+
+// typeSpecs returns the specs of an instance of the given type.
+func typeSpecs(linodeType object) object {
+	return object{
+		"disk":     linodeType["disk"],
+		"memory":   linodeType["memory"],
+		"vcpus":    linodeType["vcpus"],
+		"gpus":     linodeType["gpus"],
+		"transfer": linodeType["transfer"],
+	}
+}
+
+func createInstance(s *Server, req *request, path string) (object, *apiError) {
+	if err := req.require("region", "type"); err != nil {
+		return nil, err
+	}
+
+	linodeType, ok := findStatic(linodeTypes, req.string("type", ""))
+	if !ok {
+		return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+	}
+
+	if _, ok := findStatic(regions, req.string("region", "")); !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	id := s.newID()
+
+	ipv4 := []any{publicIPv4(id)}
+	if req.bool("private_ip", false) {
+		ipv4 = append(ipv4, privateIPv4(id))
+	}
+
+	_, hasUserData := req.body["metadata"]
+
+	return object{
+		"id":     id,
+		"label":  req.string("label", fmt.Sprintf("linode%d", id)),
+		"region": req.body["region"],
+		"type":   req.body["type"],
+		"image":  req.value("image", nil),
+		"status": "provisioning",
+		"group":  req.string("group", ""),
+		"tags":   req.list("tags"),
+		"ipv4":   ipv4,
+		"ipv6":   publicIPv6(id),
+		"specs":  typeSpecs(linodeType),
+		"alerts": object{
+			"cpu":            90 * linodeType["vcpus"].(int),
+			"io":             10000,
+			"network_in":     10,
+			"network_out":    10,
+			"transfer_quota": 80,
+		},
+		"backups": object{
+			"enabled":         req.bool("backups_enabled", false),
+			"available":       false,
+			"last_successful": nil,
+			"schedule": object{
+				"day":    "Scheduling",
+				"window": "Scheduling",
+			},
+		},
+		"hypervisor":           "kvm",
+		"watchdog_enabled":     true,
+		"disk_encryption":      req.string("disk_encryption", "disabled"),
+		"lke_cluster_id":       nil,
+		"placement_group":      nil,
+		"has_user_data":        hasUserData,
+		"host_uuid":            "fake-host",
+		"capabilities":         []any{},
+		"interface_generation": req.string("interface_generation", "legacy_config"),
+	}, nil
+}
+
+// afterCreateInstance creates the default disks and config of an instance
+// deployed from an image and schedules it to finish provisioning.
+func afterCreateInstance(s *Server, req *request, path string, rec *record) {
+	booted := req.bool("booted", req.body["image"] != nil)
+
+	if image, ok := req.body["image"].(string); ok {
+		diskSize := rec.data["specs"].(object)["disk"].(int) - swapDiskSize
+
+		mainDisk := s.insertDisk(rec.id, object{
+			"label":      fmt.Sprintf("%s Disk", strings.TrimPrefix(image, "linode/")),
+			"size":       diskSize,
+			"filesystem": "ext4",
+		})
+		swapDisk := s.insertDisk(rec.id, object{
+			"label":      fmt.Sprintf("%d MB Swap Image", swapDiskSize),
+			"size":       swapDiskSize,
+			"filesystem": "swap",
+		})
+
+		interfaces := req.list("interfaces")
+
+		s.insert(instancePath(rec.id)+"/configs", instanceConfigKind, newInstanceConfig(
+			fmt.Sprintf("My %s Disk Profile", strings.TrimPrefix(image, "linode/")),
+			object{
+				"sda": object{"disk_id": mainDisk.id, "volume_id": nil},
+				"sdb": object{"disk_id": swapDisk.id, "volume_id": nil},
+			},
+			interfaces,
+			s.now(),
+		))
+	}
+
+	if firewallID, ok := req.int("firewall_id"); ok && firewallID != 0 {
+		_ = s.addFirewallDevice(firewallID, "linode", rec.id)
+	}
+
+	finalStatus := "offline"
+	if booted {
+		finalStatus = "running"
+	}
+
+	// Without an image there is nothing to provision, so the instance is immediately
+	// ready for the disks and configs created by the caller.
+	if req.body["image"] == nil && !booted {
+		rec.data["status"] = finalStatus
+		return
+	}
+
+	s.transition(rec, "status", finalStatus)
+}
+
+func newInstanceConfig(label string, devices object, interfaces []any, now string) object {
+	return object{
+		"label":        label,
+		"comments":     "",
+		"devices":      devices,
+		"kernel":       "linode/grub2",
+		"initrd":       nil,
+		"memory_limit": 0,
+		"root_device":  "/dev/sda",
+		"run_level":    "default",
+		"virt_mode":    "paravirt",
+		"interfaces":   interfaces,
+		"helpers": object{
+			"devtmpfs_automount": true,
+			"distro":             true,
+			"modules_dep":        true,
+			"network":            true,
+			"updatedb_disabled":  true,
+		},
+		"created": now,
+		"updated": now,
+	}
+}
+
+// insertDisk stores a ready disk for the given instance.
+func (s *Server) insertDisk(linodeID int, data object) *record {
+	now := s.now()
+
+	data["status"] = "ready"
+	data["disk_encryption"] = "disabled"
+	data["created"] = now
+	data["updated"] = now
+
+	return s.insert(instancePath(linodeID)+"/disks", instanceDiskKind, data)
+}
+
+// instanceAction returns an action moving an instance through a transitional status.
+// If finalStatus is empty, the instance returns to its status before the action.
+func instanceAction(action, transitionalStatus, finalStatus string) actionFunc {
+	return func(s *Server, req *request, rec *record) (any, *apiError) {
+		if status := rec.data["status"]; status == "provisioning" || status == transitionalStatus {
+			return nil, errBadRequest("", fmt.Sprintf("Linode busy: %s", status))
+		}
+
+		if action == "linode_resize" {
+			linodeType, ok := findStatic(linodeTypes, req.string("type", ""))
+			if !ok {
+				return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+			}
+
+			rec.data["type"] = linodeType["id"]
+			rec.data["specs"] = typeSpecs(linodeType)
+		}
+
+		if finalStatus == "" {
+			s.transition(rec, "status", rec.data["status"])
+		} else {
+			s.transition(rec, "status", finalStatus)
+		}
+
+		rec.data["status"] = transitionalStatus
+
+		s.emitEvent(action, "linode", rec.data, true)
+
+		return object{}, nil
+	}
+}
+
+func init() {
+	instanceKind = &resourceKind{
+		pattern:     "linode/instances",
+		entityType:  "linode",
+		create:      createInstance,
+		afterCreate: afterCreateInstance,
+		beforeDelete: func(s *Server, req *request, rec *record) *apiError {
+			s.detachEntity("linode", rec.id)
+
+			for _, volume := range s.collection("volumes", volumeKind).records {
+				if toInt(volume.data["linode_id"]) == rec.id {
+					volume.data["linode_id"] = nil
+					volume.data["linode_label"] = nil
+				}
+			}
+
+			return nil
+		},
+		actions: map[string]actionFunc{
+			"boot":     instanceAction("linode_boot", "booting", "running"),
+			"reboot":   instanceAction("linode_reboot", "rebooting", "running"),
+			"shutdown": instanceAction("linode_shutdown", "shutting_down", "offline"),
+			"resize":   instanceAction("linode_resize", "resizing", ""),
+		},
+	}
+
+	instanceDiskKind = &resourceKind{
+		pattern: "linode/instances/{linodeId}/disks",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("label", "size"); err != nil {
+				return nil, err
+			}
+
+			filesystem := "ext4"
+			if req.body["image"] == nil {
+				filesystem = "raw"
+			}
+
+			return object{
+				"label":           req.body["label"],
+				"size":            req.body["size"],
+				"filesystem":      req.string("filesystem", filesystem),
+				"status":          "not ready",
+				"disk_encryption": "disabled",
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			s.transition(rec, "status", "ready")
+			s.emitDiskEvent("disk_create", pathID(path, 2), rec, true)
+		},
+		beforeDelete: func(s *Server, req *request, rec *record) *apiError {
+			s.emitDiskEvent("disk_delete", toInt(req.http.PathValue("linodeId")), rec, false)
+			return nil
+		},
+		actions: map[string]actionFunc{
+			"resize": func(s *Server, req *request, rec *record) (any, *apiError) {
+				if err := req.require("size"); err != nil {
+					return nil, err
+				}
+
+				rec.data["size"] = req.body["size"]
+				rec.data["status"] = "resizing"
+				s.transition(rec, "status", "ready")
+				s.emitDiskEvent("disk_resize", toInt(req.http.PathValue("linodeId")), rec, true)
+
+				return object{}, nil
+			},
+		},
+	}
+
+	instanceConfigKind = &resourceKind{
+		pattern: "linode/instances/{linodeId}/configs",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("label"); err != nil {
+				return nil, err
+			}
+
+			config := newInstanceConfig(req.string("label", ""), object{}, req.list("interfaces"), s.now())
+
+			for key, value := range req.body {
+				if value != nil {
+					config[key] = value
+				}
+			}
+
+			return config, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			if linode, ok := s.get("linode/instances", pathID(path, 2)); ok {
+				s.emitEvent("linode_config_create", "linode", linode.data, false)
+			}
+		},
+	}
+
+	resourceKinds = append(resourceKinds, instanceKind, instanceDiskKind, instanceConfigKind)
+
+	customRoutes["GET linode/instances/{id}/ips"] = func(s *Server, req *request) (any, *apiError) {
+		_, rec, err := s.lookup(instanceKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return instanceIPs(rec), nil
+	}
+
+	customRoutes["GET linode/instances/{id}/backups"] = func(s *Server, req *request) (any, *apiError) {
+		if _, _, err := s.lookup(instanceKind, req); err != nil {
+			return nil, err
+		}
+
+		return object{
+			"automatic": []any{},
+			"snapshot": object{
+				"current":     nil,
+				"in_progress": nil,
+			},
+		}, nil
+	}
+
+	customRoutes["GET linode/instances/{id}/firewalls"] = listHandler(func(s *Server, req *request) ([]object, *apiError) {
+		_, rec, err := s.lookup(instanceKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.entityFirewalls("linode", rec.id), nil
+	})
+
+	customRoutes["GET linode/instances/{id}/volumes"] = listHandler(func(s *Server, req *request) ([]object, *apiError) {
+		_, rec, err := s.lookup(instanceKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.filterRecords("volumes", func(o object) bool {
+			return toInt(o["linode_id"]) == rec.id
+		}), nil
+	})
+}
+
+// emitDiskEvent emits an event for the instance of a disk with the disk as the secondary entity.
+func (s *Server) emitDiskEvent(action string, linodeID int, disk *record, async bool) {
+	linode, ok := s.get("linode/instances", linodeID)
+	if !ok {
+		return
+	}
+
+	event := s.emitEvent(action, "linode", linode.data, async)
+	event.data["secondary_entity"] = object{
+		"id":    disk.id,
+		"label": disk.data["label"],
+		"type":  "disk",
+		"url":   "",
+	}
+}
+
+func instanceIPs(rec *record) object {
+	address := func(ip string, public bool, prefix int, subnetMask string) object {
+		return object{
+			"address":     ip,
+			"gateway":     nil,
+			"subnet_mask": subnetMask,
+			"prefix":      prefix,
+			"type":        "ipv4",
+			"public":      public,
+			"rdns":        nil,
+			"linode_id":   rec.id,
+			"region":      rec.data["region"],
+			"reserved":    false,
+		}
+	}
+
+	public, private := []any{}, []any{}
+
+	for _, ip := range rec.data["ipv4"].([]any) {
+		ip := ip.(string)
+
+		if strings.HasPrefix(ip, "192.168.") {
+			private = append(private, address(ip, false, 17, "255.255.128.0"))
+			continue
+		}
+
+		result := address(ip, true, 24, "255.255.255.0")
+		result["gateway"] = "192.0.2.1"
+		result["rdns"] = fmt.Sprintf("%s.ip.linodeusercontent.com", strings.ReplaceAll(ip, ".", "-"))
+		public = append(public, result)
+	}
+
+	slaac := strings.TrimSuffix(rec.data["ipv6"].(string), "/128")
+
+	return object{
+		"ipv4": object{
+			"public":   public,
+			"private":  private,
+			"shared":   []any{},
+			"reserved": []any{},
+			"vpc":      []any{},
+		},
+		"ipv6": object{
+			"slaac": object{
+				"address":     slaac,
+				"gateway":     "fe80::1",
+				"subnet_mask": "ffff:ffff:ffff:ffff::",
+				"prefix":      64,
+				"type":        "ipv6",
+				"public":      true,
+				"rdns":        nil,
+				"linode_id":   rec.id,
+				"region":      rec.data["region"],
+			},
+			"link_local": object{
+				"address":     "fe80::1",
+				"gateway":     "fe80::1",
+				"subnet_mask": "ffff:ffff:ffff:ffff::",
+				"prefix":      64,
+				"type":        "ipv6",
+				"public":      false,
+				"rdns":        nil,
+				"linode_id":   rec.id,
+				"region":      rec.data["region"],
+			},
+			"global": []any{},
+		},
+	}
+}
+
+// toInt converts a JSON number or string to an integer, returning 0 for other values.
+func toInt(v any) int {
+	if s, ok := v.(string); ok {
+		var result int
+		_, _ = fmt.Sscanf(s, "%d", &result)
+
+		return result
+	}
+
+	f, _ := toFloat(v)
+
+	return int(f)
+}
@@ -0,0 +1,283 @@
+package fakeapi
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+var (
+	lkeClusterKind  *resourceKind
+	lkeNodePoolKind *resourceKind
+)
+
+func lkeClusterPath(id int) string {
+	return fmt.Sprintf("lke/clusters/%d", id)
+}
+
+// newLKENodes returns the nodes of a node pool with the given status.
+func newLKENodes(poolID, count int, status string) []any {
+	nodes := make([]any, count)
+
+	for i := range nodes {
+		nodes[i] = object{
+			"id":          fmt.Sprintf("%d-%08x", poolID, i),
+			"instance_id": poolID*100 + i,
+			"status":      status,
+		}
+	}
+
+	return nodes
+}
+
+// deleteLKENodes emits the deletion events of the instances of the nodes of a node pool.
+func (s *Server) deleteLKENodes(pool *record) {
+	nodes, _ := pool.data["nodes"].([]any)
+
+	for _, node := range nodes {
+		instanceID := node.(object)["instance_id"]
+		s.emitEvent("linode_delete", "linode", object{"id": instanceID, "label": fmt.Sprintf("lke-node-%v", instanceID)}, false)
+	}
+}
+
+// scheduleLKENodes replaces the nodes of a node pool with new nodes which become ready once read.
+func (s *Server) scheduleLKENodes(rec *record, count int) {
+	rec.data["count"] = count
+	rec.data["nodes"] = newLKENodes(rec.id, count, "not_ready")
+	s.transition(rec, "nodes", newLKENodes(rec.id, count, "ready"))
+}
+
+func init() {
+	lkeClusterKind = &resourceKind{
+		pattern:    "lke/clusters",
+		entityType: "lkecluster",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("label", "region", "k8s_version", "node_pools"); err != nil {
+				return nil, err
+			}
+
+			if _, ok := findStatic(lkeVersions, req.body["k8s_version"]); !ok {
+				return nil, errBadRequest("k8s_version", "Invalid Kubernetes version")
+			}
+
+			controlPlane := object{
+				"high_availability":  false,
+				"audit_logs_enabled": false,
+			}
+
+			if value, ok := req.body["control_plane"].(map[string]any); ok {
+				for key, v := range value {
+					controlPlane[key] = v
+				}
+			}
+
+			return object{
+				"label":         req.body["label"],
+				"region":        req.body["region"],
+				"k8s_version":   req.body["k8s_version"],
+				"status":        "ready",
+				"tier":          req.string("tier", "standard"),
+				"apl_enabled":   req.bool("apl_enabled", false),
+				"control_plane": controlPlane,
+				"tags":          req.list("tags"),
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			s.createChildren(req, "node_pools", lkeClusterPath(rec.id)+"/pools", lkeNodePoolKind)
+		},
+		update: func(s *Server, req *request, rec *record) *apiError {
+			for _, field := range []string{"label", "k8s_version", "tags"} {
+				if value, ok := req.body[field]; ok {
+					rec.data[field] = value
+				}
+			}
+
+			if value, ok := req.body["control_plane"].(map[string]any); ok {
+				controlPlane := rec.data["control_plane"].(object)
+				for key, v := range value {
+					controlPlane[key] = v
+				}
+			}
+
+			return nil
+		},
+		beforeDelete: func(s *Server, req *request, rec *record) *apiError {
+			if c, ok := s.collections[lkeClusterPath(rec.id)+"/pools"]; ok {
+				for _, pool := range c.sortedRecords() {
+					s.deleteLKENodes(pool)
+				}
+			}
+
+			return nil
+		},
+		actions: map[string]actionFunc{
+			"recycle": func(s *Server, req *request, rec *record) (any, *apiError) {
+				if c, ok := s.collections[lkeClusterPath(rec.id)+"/pools"]; ok {
+					for _, pool := range c.records {
+						s.scheduleLKENodes(pool, toInt(pool.data["count"]))
+					}
+				}
+
+				s.emitEvent("lke_cluster_recycle", "lkecluster", rec.data, true)
+
+				return object{}, nil
+			},
+		},
+	}
+
+	lkeNodePoolKind = &resourceKind{
+		pattern: "lke/clusters/{clusterId}/pools",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("type", "count"); err != nil {
+				return nil, err
+			}
+
+			if _, ok := findStatic(linodeTypes, req.string("type", "")); !ok {
+				return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+			}
+
+			count, _ := req.int("count")
+
+			autoscaler := object{
+				"enabled": false,
+				"min":     count,
+				"max":     count,
+			}
+
+			if value, ok := req.body["autoscaler"].(map[string]any); ok {
+				for key, v := range value {
+					autoscaler[key] = v
+				}
+			}
+
+			return object{
+				"type":            req.body["type"],
+				"count":           count,
+				"autoscaler":      autoscaler,
+				"tags":            req.list("tags"),
+				"labels":          req.value("labels", object{}),
+				"taints":          req.list("taints"),
+				"disk_encryption": "enabled",
+				"disks":           []any{},
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			s.scheduleLKENodes(rec, toInt(rec.data["count"]))
+		},
+		update: func(s *Server, req *request, rec *record) *apiError {
+			for _, field := range []string{"autoscaler", "tags", "labels", "taints"} {
+				if value, ok := req.body[field]; ok {
+					rec.data[field] = value
+				}
+			}
+
+			if count, ok := req.int("count"); ok && count != toInt(rec.data["count"]) {
+				s.scheduleLKENodes(rec, count)
+			}
+
+			return nil
+		},
+		beforeDelete: func(s *Server, req *request, rec *record) *apiError {
+			s.deleteLKENodes(rec)
+			return nil
+		},
+	}
+
+	resourceKinds = append(resourceKinds, lkeClusterKind, lkeNodePoolKind)
+
+	customRoutes["GET lke/clusters/{id}/kubeconfig"] = func(s *Server, req *request) (any, *apiError) {
+		_, rec, err := s.lookup(lkeClusterKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		// The Kubernetes API of the cluster is served by this server, see the nodes route below
+		kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: %s/%s/%s/k8s
+  name: lke%d
+contexts:
+- context:
+    cluster: lke%d
+    user: lke%d-admin
+  name: lke%d-ctx
+current-context: lke%d-ctx
+users:
+- name: lke%d-admin
+  user:
+    token: fake-token
+`, s.URL, req.http.PathValue("version"), lkeClusterPath(rec.id), rec.id, rec.id, rec.id, rec.id, rec.id, rec.id)
+
+		return object{"kubeconfig": base64.StdEncoding.EncodeToString([]byte(kubeconfig))}, nil
+	}
+
+	// nodes is the only endpoint of the Kubernetes API of a cluster, used to wait for its nodes to be ready
+	customRoutes["GET lke/clusters/{id}/k8s/api/v1/nodes"] = func(s *Server, req *request) (any, *apiError) {
+		_, rec, err := s.lookup(lkeClusterKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items := []any{}
+
+		if pools, ok := s.collections[lkeClusterPath(rec.id)+"/pools"]; ok {
+			for _, pool := range pools.sortedRecords() {
+				s.observe(pool)
+
+				nodes, _ := pool.data["nodes"].([]any)
+				for _, node := range nodes {
+					ready := "False"
+					if node.(object)["status"] == "ready" {
+						ready = "True"
+					}
+
+					items = append(items, object{
+						"metadata": object{"name": fmt.Sprintf("lke%d-%v", rec.id, node.(object)["id"])},
+						"status": object{
+							"conditions": []any{object{"type": "Ready", "status": ready}},
+						},
+					})
+				}
+			}
+		}
+
+		return object{"kind": "NodeList", "apiVersion": "v1", "items": items}, nil
+	}
+
+	customRoutes["GET lke/clusters/{id}/api-endpoints"] = listHandler(func(s *Server, req *request) ([]object, *apiError) {
+		_, rec, err := s.lookup(lkeClusterKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return []object{
+			{"endpoint": fmt.Sprintf("https://%d.fake-lke.example.com:443", rec.id)},
+		}, nil
+	})
+
+	customRoutes["GET lke/clusters/{id}/dashboard"] = func(s *Server, req *request) (any, *apiError) {
+		_, rec, err := s.lookup(lkeClusterKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return object{"url": fmt.Sprintf("https://%d.dashboard.fake-lke.example.com", rec.id)}, nil
+	}
+
+	customRoutes["GET lke/clusters/{id}/control_plane_acl"] = func(s *Server, req *request) (any, *apiError) {
+		if _, _, err := s.lookup(lkeClusterKind, req); err != nil {
+			return nil, err
+		}
+
+		return object{
+			"acl": object{
+				"enabled": false,
+				"addresses": object{
+					"ipv4": []any{},
+					"ipv6": []any{},
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,420 @@
+package fakeapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	firewallKind       *resourceKind
+	firewallDeviceKind *resourceKind
+
+	nodeBalancerKind       *resourceKind
+	nodeBalancerConfigKind *resourceKind
+	nodeBalancerNodeKind   *resourceKind
+
+	vpcKind       *resourceKind
+	vpcSubnetKind *resourceKind
+)
+
+func firewallPath(id int) string {
+	return fmt.Sprintf("networking/firewalls/%d", id)
+}
+
+func nodeBalancerPath(id int) string {
+	return fmt.Sprintf("nodebalancers/%d", id)
+}
+
+// newFirewallRules returns the rules of a firewall from the given request body.
+func newFirewallRules(body object, version int) object {
+	rules := object{
+		"inbound":         []any{},
+		"outbound":        []any{},
+		"inbound_policy":  "ACCEPT",
+		"outbound_policy": "ACCEPT",
+	}
+
+	for key, value := range body {
+		if value != nil {
+			rules[key] = value
+		}
+	}
+
+	rules["version"] = version
+	rules["fingerprint"] = fmt.Sprintf("%08x", version)
+
+	return rules
+}
+
+// addFirewallDevice assigns the given entity to a firewall.
+func (s *Server) addFirewallDevice(firewallID int, entityType string, entityID int) *apiError {
+	if _, ok := s.get("networking/firewalls", firewallID); !ok {
+		return errBadRequest("firewall_id", fmt.Sprintf("Firewall %d not found", firewallID))
+	}
+
+	req := &request{body: object{"id": entityID, "type": entityType}}
+
+	_, err := s.createResource(firewallPath(firewallID)+"/devices", firewallDeviceKind, req)
+
+	return err
+}
+
+// detachEntity removes the given entity from all firewalls.
+func (s *Server) detachEntity(entityType string, entityID int) {
+	for _, c := range s.collections {
+		if c.kind != firewallDeviceKind {
+			continue
+		}
+
+		for id, rec := range c.records {
+			entity := rec.data["entity"].(object)
+			if entity["type"] == entityType && entity["id"] == entityID {
+				delete(c.records, id)
+			}
+		}
+	}
+}
+
+// entityFirewalls returns the firewalls the given entity is assigned to.
+func (s *Server) entityFirewalls(entityType string, entityID int) []object {
+	return s.filterRecords("networking/firewalls", func(o object) bool {
+		devices, ok := s.collections[firewallPath(toInt(o["id"]))+"/devices"]
+		if !ok {
+			return false
+		}
+
+		for _, device := range devices.records {
+			entity := device.data["entity"].(object)
+			if entity["type"] == entityType && entity["id"] == entityID {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// createChildren creates a nested resource for each element of a list in the request body.
+func (s *Server) createChildren(req *request, field, path string, kind *resourceKind) {
+	for _, body := range req.list(field) {
+		_, _ = s.createResource(path, kind, req.subRequest(body))
+	}
+}
+
+func init() {
+	firewallKind = &resourceKind{
+		pattern:    "networking/firewalls",
+		entityType: "firewall",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("label"); err != nil {
+				return nil, err
+			}
+
+			rules, _ := req.body["rules"].(map[string]any)
+
+			return object{
+				"label":  req.body["label"],
+				"status": "enabled",
+				"rules":  newFirewallRules(rules, 1),
+				"tags":   req.list("tags"),
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			devices, _ := req.body["devices"].(map[string]any)
+
+			for field, entityType := range map[string]string{"linodes": "linode", "nodebalancers": "nodebalancer"} {
+				ids, _ := devices[field].([]any)
+
+				for _, id := range ids {
+					_ = s.addFirewallDevice(rec.id, entityType, toInt(id))
+				}
+			}
+		},
+		update: func(s *Server, req *request, rec *record) *apiError {
+			for _, field := range []string{"label", "status", "tags"} {
+				if value, ok := req.body[field]; ok {
+					rec.data[field] = value
+				}
+			}
+
+			return nil
+		},
+		view: func(s *Server, rec *record, result object) {
+			entities := []any{}
+
+			if devices, ok := s.collections[firewallPath(rec.id)+"/devices"]; ok {
+				for _, device := range devices.sortedRecords() {
+					entities = append(entities, device.data["entity"])
+				}
+			}
+
+			result["entities"] = entities
+		},
+	}
+
+	firewallDeviceKind = &resourceKind{
+		pattern: "networking/firewalls/{firewallId}/devices",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("id", "type"); err != nil {
+				return nil, err
+			}
+
+			entityType := req.string("type", "")
+			entityID, _ := req.int("id")
+
+			entity, err := s.getEntity(entityType, entityID)
+			if err != nil {
+				return nil, err
+			}
+
+			return object{
+				"entity": object{
+					"id":    entityID,
+					"type":  entityType,
+					"label": entity.data["label"],
+					"url":   fmt.Sprintf("/v4/%s/%d", entityPaths[entityType], entityID),
+				},
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			if firewall, ok := s.get("networking/firewalls", pathID(path, 2)); ok {
+				s.emitEvent("firewall_device_add", "firewall", firewall.data, false)
+			}
+		},
+	}
+
+	nodeBalancerKind = &resourceKind{
+		pattern:    "nodebalancers",
+		entityType: "nodebalancer",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("region"); err != nil {
+				return nil, err
+			}
+
+			if _, ok := findStatic(regions, req.string("region", "")); !ok {
+				return nil, errBadRequest("region", "region is not valid")
+			}
+
+			id := s.newID()
+			ipv4 := publicIPv4(id)
+
+			return object{
+				"id":                   id,
+				"label":                req.string("label", fmt.Sprintf("nodebalancer%d", id)),
+				"region":               req.body["region"],
+				"hostname":             fmt.Sprintf("nb-%s.%s.nodebalancer.linode.com", strings.ReplaceAll(ipv4, ".", "-"), req.body["region"]),
+				"ipv4":                 ipv4,
+				"ipv6":                 strings.TrimSuffix(publicIPv6(id), "/128"),
+				"client_conn_throttle": req.value("client_conn_throttle", 0),
+				"type":                 req.string("type", "common"),
+				"tags":                 req.list("tags"),
+				"transfer": object{
+					"in":    nil,
+					"out":   nil,
+					"total": nil,
+				},
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			s.createChildren(req, "configs", nodeBalancerPath(rec.id)+"/configs", nodeBalancerConfigKind)
+
+			if firewallID, ok := req.int("firewall_id"); ok && firewallID != 0 {
+				_ = s.addFirewallDevice(firewallID, "nodebalancer", rec.id)
+			}
+		},
+		beforeDelete: func(s *Server, req *request, rec *record) *apiError {
+			s.detachEntity("nodebalancer", rec.id)
+			return nil
+		},
+	}
+
+	nodeBalancerConfigKind = &resourceKind{
+		pattern: "nodebalancers/{nodeBalancerId}/configs",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			config := object{
+				"nodebalancer_id": pathID(path, 1),
+				"port":            80,
+				"protocol":        "http",
+				"proxy_protocol":  "none",
+				"algorithm":       "roundrobin",
+				"stickiness":      "none",
+				"check":           "none",
+				"check_interval":  0,
+				"check_timeout":   30,
+				"check_attempts":  3,
+				"check_path":      "",
+				"check_body":      "",
+				"check_passive":   true,
+				"cipher_suite":    "recommended",
+				"ssl_commonname":  "",
+				"ssl_fingerprint": "",
+				"ssl_cert":        nil,
+				"ssl_key":         nil,
+			}
+
+			for key, value := range req.body {
+				if value != nil && key != "nodes" {
+					config[key] = value
+				}
+			}
+
+			return config, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			nodesPath := fmt.Sprintf("%s/configs/%d/nodes", nodeBalancerPath(toInt(rec.data["nodebalancer_id"])), rec.id)
+			s.createChildren(req, "nodes", nodesPath, nodeBalancerNodeKind)
+		},
+		view: func(s *Server, rec *record, result object) {
+			path := fmt.Sprintf("%s/configs/%d/nodes", nodeBalancerPath(toInt(rec.data["nodebalancer_id"])), rec.id)
+
+			nodes := 0
+			if c, ok := s.collections[path]; ok {
+				nodes = len(c.records)
+			}
+
+			result["nodes_status"] = object{"up": 0, "down": nodes}
+		},
+		actions: map[string]actionFunc{
+			"rebuild": func(s *Server, req *request, rec *record) (any, *apiError) {
+				for key, value := range req.body {
+					if value != nil && key != "nodes" && key != "id" {
+						rec.data[key] = value
+					}
+				}
+
+				path := fmt.Sprintf("%s/configs/%d/nodes", nodeBalancerPath(toInt(rec.data["nodebalancer_id"])), rec.id)
+				delete(s.collections, path)
+				s.createChildren(req, "nodes", path, nodeBalancerNodeKind)
+
+				rec.data["updated"] = s.now()
+
+				return s.view(s.collections[nodeBalancerPath(toInt(rec.data["nodebalancer_id"]))+"/configs"], rec), nil
+			},
+		},
+	}
+
+	nodeBalancerNodeKind = &resourceKind{
+		pattern: "nodebalancers/{nodeBalancerId}/configs/{configId}/nodes",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("address", "label"); err != nil {
+				return nil, err
+			}
+
+			return object{
+				"nodebalancer_id": pathID(path, 1),
+				"config_id":       pathID(path, 3),
+				"address":         req.body["address"],
+				"label":           req.body["label"],
+				"weight":          req.value("weight", 100),
+				"mode":            req.string("mode", "accept"),
+				"status":          "Unknown",
+			}, nil
+		},
+	}
+
+	vpcKind = &resourceKind{
+		pattern:    "vpcs",
+		entityType: "vpc",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("label", "region"); err != nil {
+				return nil, err
+			}
+
+			return object{
+				"label":       req.body["label"],
+				"region":      req.body["region"],
+				"description": req.string("description", ""),
+			}, nil
+		},
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			s.createChildren(req, "subnets", fmt.Sprintf("vpcs/%d/subnets", rec.id), vpcSubnetKind)
+		},
+		update: func(s *Server, req *request, rec *record) *apiError {
+			for _, field := range []string{"label", "description"} {
+				if value, ok := req.body[field]; ok {
+					rec.data[field] = value
+				}
+			}
+
+			return nil
+		},
+		view: func(s *Server, rec *record, result object) {
+			subnets := []any{}
+
+			if c, ok := s.collections[fmt.Sprintf("vpcs/%d/subnets", rec.id)]; ok {
+				for _, subnet := range c.sortedRecords() {
+					subnets = append(subnets, subnet.data)
+				}
+			}
+
+			result["subnets"] = subnets
+		},
+	}
+
+	vpcSubnetKind = &resourceKind{
+		pattern: "vpcs/{vpcId}/subnets",
+		create: func(s *Server, req *request, path string) (object, *apiError) {
+			if err := req.require("label", "ipv4"); err != nil {
+				return nil, err
+			}
+
+			return object{
+				"label":   req.body["label"],
+				"ipv4":    req.body["ipv4"],
+				"linodes": []any{},
+			}, nil
+		},
+		update: func(s *Server, req *request, rec *record) *apiError {
+			if value, ok := req.body["label"]; ok {
+				rec.data["label"] = value
+			}
+
+			return nil
+		},
+	}
+
+	resourceKinds = append(
+		resourceKinds,
+		firewallKind,
+		firewallDeviceKind,
+		nodeBalancerKind,
+		nodeBalancerConfigKind,
+		nodeBalancerNodeKind,
+		vpcKind,
+		vpcSubnetKind,
+	)
+
+	customRoutes["GET networking/firewalls/{id}/rules"] = func(s *Server, req *request) (any, *apiError) {
+		_, rec, err := s.lookup(firewallKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return rec.data["rules"], nil
+	}
+
+	customRoutes["PUT networking/firewalls/{id}/rules"] = func(s *Server, req *request) (any, *apiError) {
+		_, rec, err := s.lookup(firewallKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		version := toInt(rec.data["rules"].(object)["version"]) + 1
+
+		rec.data["rules"] = newFirewallRules(req.body, version)
+		rec.data["updated"] = s.now()
+
+		s.emitEvent("firewall_rules_update", "firewall", rec.data, false)
+
+		return rec.data["rules"], nil
+	}
+
+	customRoutes["GET nodebalancers/{id}/firewalls"] = listHandler(func(s *Server, req *request) ([]object, *apiError) {
+		_, rec, err := s.lookup(nodeBalancerKind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.entityFirewalls("nodebalancer", rec.id), nil
+	})
+}
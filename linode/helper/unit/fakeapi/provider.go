@@ -0,0 +1,43 @@
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/linode/terraform-provider-linode/v3/linode"
+	"github.com/linode/terraform-provider-linode/v3/version"
+)
+
+// ProtoV6ProviderFactories returns provider factories for the muxed Linode provider,
+// to be used with a configuration including ProviderConfig.
+func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"linode": func() (tfprotov6.ProviderServer, error) {
+			ctx := context.Background()
+
+			upgradedSDKProvider, err := tf5to6server.UpgradeServer(
+				ctx,
+				linode.Provider().GRPCProvider,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to upgrade SDKv2 GRPC provider: %w", err)
+			}
+
+			providers := []func() tfprotov6.ProviderServer{
+				providerserver.NewProtocol6(linode.CreateFrameworkProvider(version.ProviderVersion)),
+				func() tfprotov6.ProviderServer { return upgradedSDKProvider },
+			}
+
+			muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+			if err != nil {
+				return nil, err
+			}
+
+			return muxServer.ProviderServer(), nil
+		},
+	}
+}
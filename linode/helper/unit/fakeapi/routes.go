@@ -0,0 +1,418 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// handlerFunc handles a request to the fake API, returning the JSON response body.
+// Handlers are called with the server lock held.
+type handlerFunc func(s *Server, req *request) (any, *apiError)
+
+// actionFunc handles a POST request to an action of a resource, e.g. /linode/instances/{id}/boot.
+type actionFunc func(s *Server, req *request, rec *record) (any, *apiError)
+
+// request is a decoded request to the fake API.
+type request struct {
+	http *http.Request
+	body object
+}
+
+// pathInt returns the integer value of a path wildcard.
+func (r *request) pathInt(name string) (int, *apiError) {
+	value, err := strconv.Atoi(r.http.PathValue(name))
+	if err != nil {
+		return 0, errNotFound()
+	}
+
+	return value, nil
+}
+
+// resourceKind describes a collection of resources served by the fake API.
+type resourceKind struct {
+	// pattern is the path of the collection relative to the API version,
+	// with wildcards for the IDs of parent resources, e.g. linode/instances/{linodeId}/disks.
+	pattern string
+
+	// entityType is the type of the events emitted for the resource.
+	// No events are emitted if it is empty.
+	entityType string
+
+	// readOnly kinds only support the list and get endpoints.
+	readOnly bool
+
+	// create returns a new resource built from the request body
+	// to be stored in the collection at the given concrete path.
+	create func(s *Server, req *request, path string) (object, *apiError)
+
+	// afterCreate runs once the new resource has been stored, e.g. to schedule
+	// status transitions or create child resources.
+	afterCreate func(s *Server, req *request, path string, rec *record)
+
+	// update applies the request body to the resource. By default, all fields
+	// in the request body are copied to the resource.
+	update func(s *Server, req *request, rec *record) *apiError
+
+	// beforeDelete runs before the resource is deleted, e.g. to detach other resources.
+	beforeDelete func(s *Server, req *request, rec *record) *apiError
+
+	// view adds computed fields to the representation of the resource.
+	view func(s *Server, rec *record, result object)
+
+	// actions are the POST endpoints of the resource, keyed by name.
+	actions map[string]actionFunc
+}
+
+// collectionPath returns the concrete path of the collection targeted by the request.
+func (k *resourceKind) collectionPath(r *http.Request) string {
+	segments := strings.Split(k.pattern, "/")
+
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = r.PathValue(strings.Trim(segment, "{}"))
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// parentExists returns whether all parent resources of the targeted collection exist.
+func (s *Server) parentExists(path string) bool {
+	segments := strings.Split(path, "/")
+
+	for i := 1; i < len(segments); i++ {
+		id, err := strconv.Atoi(segments[i])
+		if err != nil {
+			continue
+		}
+
+		c, ok := s.collections[strings.Join(segments[:i], "/")]
+		if !ok {
+			return false
+		}
+
+		if _, ok := c.records[id]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) registerRoutes(mux *http.ServeMux) {
+	for _, kind := range resourceKinds {
+		s.registerKind(mux, kind)
+	}
+
+	for pattern, handler := range customRoutes {
+		method, path, _ := strings.Cut(pattern, " ")
+		mux.Handle(fmt.Sprintf("%s /{version}/%s", method, path), s.handler(handler))
+	}
+
+	mux.Handle("/", s.handler(func(s *Server, req *request) (any, *apiError) {
+		return nil, &apiError{
+			status: http.StatusNotFound,
+			reason: fmt.Sprintf("Not found: %s %s is not supported by the fake API", req.http.Method, req.http.URL.Path),
+		}
+	}))
+}
+
+func (s *Server) registerKind(mux *http.ServeMux, kind *resourceKind) {
+	collectionRoute := "/{version}/" + kind.pattern
+	resourceRoute := collectionRoute + "/{id}"
+
+	mux.Handle("GET "+collectionRoute, s.handler(func(s *Server, req *request) (any, *apiError) {
+		path := kind.collectionPath(req.http)
+		if !s.parentExists(path) {
+			return nil, errNotFound()
+		}
+
+		c := s.collection(path, kind)
+
+		objects := make([]object, 0, len(c.records))
+		for _, rec := range c.sortedRecords() {
+			s.observe(rec)
+			objects = append(objects, s.view(c, rec))
+		}
+
+		return paginate(req.http, objects)
+	}))
+
+	mux.Handle("GET "+resourceRoute, s.handler(func(s *Server, req *request) (any, *apiError) {
+		c, rec, err := s.lookup(kind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		s.observe(rec)
+
+		return s.view(c, rec), nil
+	}))
+
+	if kind.readOnly {
+		return
+	}
+
+	mux.Handle("POST "+collectionRoute, s.handler(func(s *Server, req *request) (any, *apiError) {
+		path := kind.collectionPath(req.http)
+		if !s.parentExists(path) {
+			return nil, errNotFound()
+		}
+
+		rec, err := s.createResource(path, kind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.view(s.collection(path, kind), rec), nil
+	}))
+
+	mux.Handle("PUT "+resourceRoute, s.handler(func(s *Server, req *request) (any, *apiError) {
+		c, rec, err := s.lookup(kind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if kind.update != nil {
+			if err := kind.update(s, req, rec); err != nil {
+				return nil, err
+			}
+		} else {
+			for key, value := range req.body {
+				if key == "id" || key == "created" || key == "updated" {
+					continue
+				}
+
+				// Like the Linode API, lists are left unchanged when null
+				if _, isList := rec.data[key].([]any); isList && value == nil {
+					continue
+				}
+
+				rec.data[key] = value
+			}
+		}
+
+		rec.data["updated"] = s.now()
+
+		if kind.entityType != "" {
+			s.emitEvent(kind.entityType+"_update", kind.entityType, rec.data, false)
+		}
+
+		return s.view(c, rec), nil
+	}))
+
+	mux.Handle("DELETE "+resourceRoute, s.handler(func(s *Server, req *request) (any, *apiError) {
+		_, rec, err := s.lookup(kind, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if kind.beforeDelete != nil {
+			if err := kind.beforeDelete(s, req, rec); err != nil {
+				return nil, err
+			}
+		}
+
+		s.remove(kind.collectionPath(req.http), rec.id)
+
+		if kind.entityType != "" {
+			s.emitEvent(kind.entityType+"_delete", kind.entityType, rec.data, false)
+		}
+
+		return object{}, nil
+	}))
+
+	for name, action := range kind.actions {
+		mux.Handle("POST "+resourceRoute+"/"+name, s.handler(func(s *Server, req *request) (any, *apiError) {
+			_, rec, err := s.lookup(kind, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return action(s, req, rec)
+		}))
+	}
+}
+
+// createResource creates a new resource in the collection at the given concrete path.
+func (s *Server) createResource(path string, kind *resourceKind, req *request) (*record, *apiError) {
+	data, err := kind.create(s, req, path)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	data["created"] = now
+	data["updated"] = now
+
+	rec := s.insert(path, kind, data)
+
+	if kind.afterCreate != nil {
+		kind.afterCreate(s, req, path, rec)
+	}
+
+	if kind.entityType != "" {
+		s.emitEvent(kind.entityType+"_create", kind.entityType, rec.data, len(rec.transitions) > 0)
+	}
+
+	return rec, nil
+}
+
+// lookup returns the resource targeted by the request.
+func (s *Server) lookup(kind *resourceKind, req *request) (*collection, *record, *apiError) {
+	id, err := req.pathInt("id")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, ok := s.collections[kind.collectionPath(req.http)]
+	if !ok {
+		return nil, nil, errNotFound()
+	}
+
+	rec, ok := c.records[id]
+	if !ok {
+		return nil, nil, errNotFound()
+	}
+
+	return c, rec, nil
+}
+
+// handler decodes the request, runs the given handler with the server lock held
+// and encodes its response or error.
+func (s *Server) handler(inner handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &request{http: r, body: object{}}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse(errBadRequest("", err.Error())))
+			return
+		}
+
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &req.body); err != nil {
+				writeJSON(w, http.StatusBadRequest, errorResponse(errBadRequest("", fmt.Sprintf("Invalid JSON: %s", err))))
+				return
+			}
+		}
+
+		s.mu.Lock()
+		result, apiErr := inner(s, req)
+		if result != nil && apiErr == nil {
+			// Encode while the lock is held since the result may reference stored records
+			body, err = json.Marshal(result)
+		}
+		s.mu.Unlock()
+
+		if apiErr != nil {
+			writeJSON(w, apiErr.status, errorResponse(apiErr))
+			return
+		}
+
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, errorResponse(&apiError{reason: err.Error()}))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	})
+}
+
+func errorResponse(err *apiError) object {
+	apiErr := object{"reason": err.reason}
+	if err.field != "" {
+		apiErr["field"] = err.field
+	}
+
+	return object{"errors": []object{apiErr}}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// subRequest returns a request with the given body, used to create nested resources.
+func (r *request) subRequest(body any) *request {
+	result := &request{http: r.http, body: object{}}
+
+	if m, ok := body.(map[string]any); ok {
+		result.body = m
+	}
+
+	return result
+}
+
+// require returns an error if any of the given fields are missing from the request body.
+func (r *request) require(fields ...string) *apiError {
+	for _, field := range fields {
+		if value, ok := r.body[field]; !ok || value == nil || value == "" {
+			return errBadRequest(field, fmt.Sprintf("%s is required", field))
+		}
+	}
+
+	return nil
+}
+
+// value returns the value of a field in the request body or the given default value.
+func (r *request) value(field string, defaultValue any) any {
+	if value, ok := r.body[field]; ok && value != nil {
+		return value
+	}
+
+	return defaultValue
+}
+
+// string returns the string value of a field in the request body or the given default value.
+func (r *request) string(field, defaultValue string) string {
+	if value, ok := r.body[field].(string); ok && value != "" {
+		return value
+	}
+
+	return defaultValue
+}
+
+// int returns the integer value of a field in the request body.
+func (r *request) int(field string) (int, bool) {
+	value, ok := toFloat(r.body[field])
+	return int(value), ok
+}
+
+// bool returns the boolean value of a field in the request body or the given default value.
+func (r *request) bool(field string, defaultValue bool) bool {
+	if value, ok := r.body[field].(bool); ok {
+		return value
+	}
+
+	return defaultValue
+}
+
+// list returns the list value of a field in the request body, or an empty list.
+func (r *request) list(field string) []any {
+	if value, ok := r.body[field].([]any); ok {
+		return value
+	}
+
+	return []any{}
+}
+
+// pathID returns the ID at the given segment of a concrete collection path,
+// e.g. the ID of the instance for linode/instances/123/disks.
+func pathID(path string, segment int) int {
+	segments := strings.Split(path, "/")
+	if segment >= len(segments) {
+		return 0
+	}
+
+	id, _ := strconv.Atoi(segments[segment])
+
+	return id
+}
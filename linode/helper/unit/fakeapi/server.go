@@ -0,0 +1,363 @@
+// Package fakeapi implements a stateful in-memory fake of the Linode APIv4,
+// allowing resources to be tested without creating real infrastructure.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// DefaultStatusTransitionReads is the default number of times a pending
+// resource or event is read before it transitions to its final status.
+const DefaultStatusTransitionReads = 1
+
+const timeFormat = "2006-01-02T15:04:05"
+
+type object = map[string]any
+
+// transition is a deferred change to a field of a record,
+// e.g. an instance going from provisioning to running.
+type transition struct {
+	field string
+	value any
+	reads int
+}
+
+type record struct {
+	id          int
+	data        object
+	transitions []*transition
+}
+
+type collection struct {
+	kind    *resourceKind
+	records map[int]*record
+}
+
+type apiError struct {
+	status int
+	reason string
+	field  string
+}
+
+func (e *apiError) Error() string {
+	return e.reason
+}
+
+func errNotFound() *apiError {
+	return &apiError{status: http.StatusNotFound, reason: "Not found"}
+}
+
+func errBadRequest(field, reason string) *apiError {
+	return &apiError{status: http.StatusBadRequest, field: field, reason: reason}
+}
+
+// Server is an in-process fake of the Linode APIv4.
+//
+// Resources are stored in memory and created in a pending status (e.g. provisioning),
+// transitioning to their final status after being read StatusTransitionReads times.
+// Each create, delete and action emits an event to the account events feed,
+// which completes the same way.
+type Server struct {
+	// StatusTransitionReads is the number of times a pending resource or
+	// event is read before it transitions to its final status.
+	StatusTransitionReads int
+
+	// URL is the base URL of the server.
+	URL string
+
+	server *httptest.Server
+	clock  func() time.Time
+
+	mu          sync.Mutex
+	nextID      int
+	collections map[string]*collection
+	events      *collection
+}
+
+// NewServer starts a new fake API server which is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		StatusTransitionReads: DefaultStatusTransitionReads,
+		clock:                 time.Now,
+		nextID:                1000,
+		collections:           make(map[string]*collection),
+	}
+
+	s.events = s.collection("account/events", eventKind)
+
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// ProviderConfig returns a provider block configuring the Linode provider to use this server.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "linode" {
+  url                    = %q
+  api_version            = "v4beta"
+  token                  = "fake-token"
+  event_poll_ms          = 50
+  lke_event_poll_ms      = 50
+  lke_node_ready_poll_ms = 50
+  min_retry_delay_ms     = 10
+  max_retry_delay_ms     = 50
+}
+`, s.URL)
+}
+
+// Get returns a copy of the resource at the given path (e.g. "volumes/1234"),
+// without advancing any status transitions.
+func (s *Server) Get(path string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parent, idValue, ok := cutLast(strings.Trim(path, "/"))
+	if !ok {
+		return nil, false
+	}
+
+	id, err := strconv.Atoi(idValue)
+	if err != nil {
+		return nil, false
+	}
+
+	c, ok := s.collections[parent]
+	if !ok {
+		return nil, false
+	}
+
+	rec, ok := c.records[id]
+	if !ok {
+		return nil, false
+	}
+
+	return cloneObject(s.view(c, rec)), true
+}
+
+// List returns copies of all resources in the collection at the given path (e.g. "volumes"),
+// without advancing any status transitions.
+func (s *Server) List(path string) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.collections[strings.Trim(path, "/")]
+	if !ok {
+		return nil
+	}
+
+	result := make([]map[string]any, 0, len(c.records))
+	for _, rec := range c.sortedRecords() {
+		result = append(result, cloneObject(s.view(c, rec)))
+	}
+
+	return result
+}
+
+// Events returns copies of all events emitted by the server, in order of creation.
+func (s *Server) Events() []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]map[string]any, 0, len(s.events.records))
+	for _, rec := range s.events.sortedRecords() {
+		result = append(result, cloneObject(rec.data))
+	}
+
+	return result
+}
+
+func (s *Server) now() string {
+	return s.clock().UTC().Format(timeFormat)
+}
+
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+// collection returns the collection at the given concrete path, creating it if necessary.
+func (s *Server) collection(path string, kind *resourceKind) *collection {
+	c, ok := s.collections[path]
+	if !ok {
+		c = &collection{kind: kind, records: make(map[int]*record)}
+		s.collections[path] = c
+	}
+
+	return c
+}
+
+func (c *collection) sortedRecords() []*record {
+	ids := slices.Sorted(maps.Keys(c.records))
+
+	result := make([]*record, len(ids))
+	for i, id := range ids {
+		result[i] = c.records[id]
+	}
+
+	return result
+}
+
+// insert stores a new record in the collection at the given path.
+func (s *Server) insert(path string, kind *resourceKind, data object) *record {
+	id, ok := data["id"].(int)
+	if !ok {
+		id = s.newID()
+		data["id"] = id
+	}
+
+	rec := &record{id: id, data: data}
+	s.collection(path, kind).records[id] = rec
+
+	return rec
+}
+
+// remove deletes a record along with all of its child collections.
+func (s *Server) remove(path string, id int) {
+	if c, ok := s.collections[path]; ok {
+		delete(c.records, id)
+	}
+
+	prefix := fmt.Sprintf("%s/%d/", path, id)
+
+	for key := range s.collections {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.collections, key)
+		}
+	}
+}
+
+// transition schedules a field of the record to change once it has been read enough times.
+func (s *Server) transition(rec *record, field string, value any) {
+	rec.transitions = append(rec.transitions, &transition{
+		field: field,
+		value: value,
+		reads: s.StatusTransitionReads,
+	})
+}
+
+// observe advances the pending transitions of a record that is being read.
+// A transition is applied once the record has been read in its pending state
+// StatusTransitionReads times.
+func (s *Server) observe(rec *record) {
+	var pending []*transition
+
+	for _, t := range rec.transitions {
+		if t.reads <= 0 {
+			rec.data[t.field] = t.value
+			continue
+		}
+
+		t.reads--
+		pending = append(pending, t)
+	}
+
+	rec.transitions = pending
+}
+
+// view returns the representation of a record, including any computed fields.
+func (s *Server) view(c *collection, rec *record) object {
+	if c.kind.view == nil {
+		return rec.data
+	}
+
+	result := maps.Clone(rec.data)
+	c.kind.view(s, rec, result)
+
+	return result
+}
+
+// emitEvent adds an event for the given entity to the events feed.
+// Events of asynchronous actions start in progress and finish after being read.
+func (s *Server) emitEvent(action, entityType string, entity object, async bool) *record {
+	eventEntity := object{
+		"id":    entity["id"],
+		"label": entityLabel(entity),
+		"type":  entityType,
+		"url":   "",
+	}
+
+	data := object{
+		"action":           action,
+		"created":          s.now(),
+		"duration":         0,
+		"entity":           eventEntity,
+		"secondary_entity": nil,
+		"message":          "",
+		"percent_complete": 100,
+		"rate":             nil,
+		"read":             false,
+		"seen":             false,
+		"status":           "finished",
+		"time_remaining":   nil,
+		"username":         "fake-user",
+	}
+
+	rec := s.insert("account/events", eventKind, data)
+
+	if async {
+		data["status"] = "started"
+		data["percent_complete"] = 0
+
+		s.transition(rec, "status", "finished")
+		s.transition(rec, "percent_complete", 100)
+	}
+
+	return rec
+}
+
+func entityLabel(entity object) any {
+	for _, key := range []string{"label", "domain", "username"} {
+		if v, ok := entity[key]; ok {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func cloneObject(o object) object {
+	data, err := json.Marshal(o)
+	if err != nil {
+		panic(err)
+	}
+
+	var result object
+	if err := json.Unmarshal(data, &result); err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+func cutLast(path string) (string, string, bool) {
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", "", false
+	}
+
+	return path[:i], path[i+1:], true
+}
@@ -0,0 +1,134 @@
+//go:build unit
+
+package fakeapi_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Instance(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer(t)
+	client := server.Client()
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Label:  "fake-instance",
+		Image:  "linode/debian12",
+	})
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceProvisioning, instance.Status)
+	require.Equal(t, 1, instance.Specs.VCPUs)
+	require.NotEmpty(t, instance.IPv4)
+
+	instance, err = client.WaitForInstanceStatus(ctx, instance.ID, linodego.InstanceRunning, 10)
+	require.NoError(t, err)
+	require.Equal(t, linodego.InstanceRunning, instance.Status)
+
+	disks, err := client.ListInstanceDisks(ctx, instance.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, disks, 2)
+
+	configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+
+	filter := linodego.Filter{}
+	filter.AddField(linodego.Eq, "action", linodego.ActionLinodeCreate)
+	filter.AddField(linodego.Eq, "entity.id", instance.ID)
+
+	filterJSON, err := filter.MarshalJSON()
+	require.NoError(t, err)
+
+	events, err := client.ListEvents(ctx, linodego.NewListOptions(0, string(filterJSON)))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, instance.Label, events[0].Entity.Label)
+
+	_, err = client.WaitForEventFinished(
+		ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeCreate, *instance.Created, 10,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteInstance(ctx, instance.ID))
+
+	_, err = client.GetInstance(ctx, instance.ID)
+	require.True(t, linodego.IsNotFound(err))
+
+	_, err = client.ListInstanceDisks(ctx, instance.ID, nil)
+	require.True(t, linodego.IsNotFound(err))
+}
+
+func TestServer_InstanceValidation(t *testing.T) {
+	client := fakeapi.NewServer(t).Client()
+
+	_, err := client.CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-invalid",
+	})
+
+	var apiErr *linodego.Error
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, 400, apiErr.Code)
+}
+
+func TestServer_VolumeAttachment(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer(t)
+	client := server.Client()
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Label:  "fake-instance",
+	})
+	require.NoError(t, err)
+
+	volume, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{
+		Region: "us-east",
+		Label:  "fake-volume",
+	})
+	require.NoError(t, err)
+	require.Equal(t, linodego.VolumeCreating, volume.Status)
+	require.Equal(t, 20, volume.Size)
+
+	volume, err = client.WaitForVolumeStatus(ctx, volume.ID, linodego.VolumeActive, 10)
+	require.NoError(t, err)
+
+	_, err = client.AttachVolume(ctx, volume.ID, &linodego.VolumeAttachOptions{LinodeID: instance.ID})
+	require.NoError(t, err)
+
+	volume, err = client.WaitForVolumeLinodeID(ctx, volume.ID, &instance.ID, 10)
+	require.NoError(t, err)
+
+	stored, ok := server.Get("volumes/" + strconv.Itoa(volume.ID))
+	require.True(t, ok)
+	require.Equal(t, instance.Label, stored["linode_label"])
+
+	require.Error(t, client.DeleteVolume(ctx, volume.ID))
+
+	require.NoError(t, client.DetachVolume(ctx, volume.ID))
+
+	_, err = client.WaitForVolumeLinodeID(ctx, volume.ID, nil, 10)
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteVolume(ctx, volume.ID))
+	require.Empty(t, server.List("volumes"))
+}
+
+func TestServer_NotFound(t *testing.T) {
+	client := fakeapi.NewServer(t).Client()
+
+	_, err := client.GetVolume(context.Background(), 1)
+	require.True(t, linodego.IsNotFound(err))
+
+	_, err = client.GetLKECluster(context.Background(), 1)
+	require.True(t, linodego.IsNotFound(err))
+}
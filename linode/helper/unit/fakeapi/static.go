@@ -0,0 +1,314 @@
+package fakeapi
+
+import (
+	"fmt"
+	"slices"
+)
+
+// resourceKinds are the collections served by the fake API.
+var resourceKinds []*resourceKind
+
+// customRoutes are the endpoints which don't follow the collection pattern,
+// keyed by the method and path relative to the API version.
+var customRoutes = make(map[string]handlerFunc)
+
+var eventKind *resourceKind
+
+var linodeTypes = []object{
+	newLinodeType("g6-nanode-1", "Nanode 1GB", "nanode", 25600, 1024, 1, 1000, 0.0075, 5),
+	newLinodeType("g6-standard-1", "Linode 2GB", "standard", 51200, 2048, 1, 2000, 0.018, 12),
+	newLinodeType("g6-standard-2", "Linode 4GB", "standard", 81920, 4096, 2, 4000, 0.036, 24),
+	newLinodeType("g6-standard-4", "Linode 8GB", "standard", 163840, 8192, 4, 5000, 0.072, 48),
+}
+
+var volumeTypes = []object{
+	newBaseType("volume", "Storage Volume", 0.00015, 0.1),
+}
+
+var nodeBalancerTypes = []object{
+	newBaseType("nodebalancer", "NodeBalancer", 0.015, 10),
+}
+
+var regions = []object{
+	newRegion("us-east", "Newark, NJ", "us"),
+	newRegion("us-ord", "Chicago, IL", "us"),
+	newRegion("eu-west", "London, UK", "gb"),
+}
+
+var lkeVersions = []object{
+	{"id": "1.33"},
+	{"id": "1.32"},
+}
+
+func newLinodeType(id, label, class string, disk, memory, vcpus, transfer int, hourly, monthly float64) object {
+	return object{
+		"id":          id,
+		"label":       label,
+		"class":       class,
+		"disk":        disk,
+		"memory":      memory,
+		"vcpus":       vcpus,
+		"gpus":        0,
+		"transfer":    transfer,
+		"network_out": transfer,
+		"price": object{
+			"hourly":  hourly,
+			"monthly": monthly,
+		},
+		"addons": object{
+			"backups": object{
+				"price": object{
+					"hourly":  hourly / 4,
+					"monthly": monthly / 4,
+				},
+				"region_prices": []any{},
+			},
+		},
+		"region_prices": []any{},
+		"successor":     nil,
+	}
+}
+
+func newBaseType(id, label string, hourly, monthly float64) object {
+	return object{
+		"id":    id,
+		"label": label,
+		"price": object{
+			"hourly":  hourly,
+			"monthly": monthly,
+		},
+		"region_prices": []any{
+			object{
+				"id":      "eu-west",
+				"hourly":  hourly * 1.2,
+				"monthly": monthly * 1.2,
+			},
+		},
+		"transfer": 0,
+	}
+}
+
+func newRegion(id, label, country string) object {
+	return object{
+		"id":      id,
+		"label":   label,
+		"country": country,
+		"capabilities": []any{
+			"Linodes",
+			"Block Storage",
+			"Block Storage Encryption",
+			"Cloud Firewall",
+			"Disk Encryption",
+			"Kubernetes",
+			"LA Disk Encryption",
+			"NodeBalancers",
+			"Placement Group",
+			"Vlans",
+			"VPCs",
+		},
+		"status":    "ok",
+		"site_type": "core",
+		"resolvers": object{
+			"ipv4": "192.0.2.53",
+			"ipv6": "2001:db8::53",
+		},
+		"placement_group_limits": object{
+			"maximum_pgs_per_customer": 100,
+			"maximum_linodes_per_pg":   5,
+		},
+	}
+}
+
+// findStatic returns the object with the given ID from a static list.
+func findStatic(objects []object, id any) (object, bool) {
+	index := slices.IndexFunc(objects, func(o object) bool {
+		return o["id"] == id
+	})
+	if index < 0 {
+		return nil, false
+	}
+
+	return objects[index], true
+}
+
+// staticList returns a handler listing the given objects.
+func staticList(objects []object) handlerFunc {
+	return func(s *Server, req *request) (any, *apiError) {
+		return paginate(req.http, objects)
+	}
+}
+
+// staticGet returns a handler getting an object from the given list by its ID.
+func staticGet(objects []object) handlerFunc {
+	return func(s *Server, req *request) (any, *apiError) {
+		o, ok := findStatic(objects, req.http.PathValue("id"))
+		if !ok {
+			return nil, errNotFound()
+		}
+
+		return o, nil
+	}
+}
+
+func init() {
+	eventKind = &resourceKind{
+		pattern:  "account/events",
+		readOnly: true,
+	}
+
+	resourceKinds = append(resourceKinds, eventKind)
+
+	customRoutes["GET linode/types"] = staticList(linodeTypes)
+	customRoutes["GET linode/types/{id}"] = staticGet(linodeTypes)
+	customRoutes["GET volumes/types"] = staticList(volumeTypes)
+	customRoutes["GET nodebalancers/types"] = staticList(nodeBalancerTypes)
+	customRoutes["GET regions"] = staticList(regions)
+	customRoutes["GET regions/{id}"] = staticGet(regions)
+	customRoutes["GET lke/versions"] = staticList(lkeVersions)
+	customRoutes["GET lke/versions/{id}"] = staticGet(lkeVersions)
+
+	customRoutes["GET regions/availability"] = listHandler(func(s *Server, req *request) ([]object, *apiError) {
+		result := []object{}
+
+		for _, region := range regions {
+			for _, linodeType := range linodeTypes {
+				result = append(result, object{
+					"region":    region["id"],
+					"plan":      linodeType["id"],
+					"available": true,
+				})
+			}
+		}
+
+		return result, nil
+	})
+
+	customRoutes["GET account/availability/{id}"] = func(s *Server, req *request) (any, *apiError) {
+		region, ok := findStatic(regions, req.http.PathValue("id"))
+		if !ok {
+			return nil, errNotFound()
+		}
+
+		return object{
+			"region":      region["id"],
+			"available":   region["capabilities"],
+			"unavailable": []any{},
+		}, nil
+	}
+
+	customRoutes["GET profile"] = func(s *Server, req *request) (any, *apiError) {
+		return object{
+			"uid":                  1,
+			"username":             "fake-user",
+			"email":                "fake-user@example.com",
+			"timezone":             "UTC",
+			"email_notifications":  false,
+			"restricted":           false,
+			"two_factor_auth":      false,
+			"authorized_keys":      []any{},
+			"lish_auth_method":     "keys_only",
+			"ip_whitelist_enabled": false,
+			"referrals": object{
+				"code":      "",
+				"url":       "",
+				"total":     0,
+				"completed": 0,
+				"pending":   0,
+				"credit":    0,
+			},
+		}, nil
+	}
+
+	customRoutes["POST account/events/{id}/seen"] = func(s *Server, req *request) (any, *apiError) {
+		id, err := req.pathInt("id")
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+
+		// Marking an event as seen also marks all earlier events as seen
+		for _, rec := range s.events.records {
+			if rec.id <= id {
+				rec.data["seen"] = true
+				found = rec.id == id || found
+			}
+		}
+
+		if !found {
+			return nil, errNotFound()
+		}
+
+		return object{}, nil
+	}
+}
+
+// entityPaths are the collections of the resources which can be referenced
+// by other resources (e.g. firewall devices), keyed by entity type.
+var entityPaths = map[string]string{
+	"linode":       "linode/instances",
+	"nodebalancer": "nodebalancers",
+}
+
+// getEntity returns the resource of the given entity type and ID.
+func (s *Server) getEntity(entityType string, id int) (*record, *apiError) {
+	path, ok := entityPaths[entityType]
+	if !ok {
+		return nil, errBadRequest("type", fmt.Sprintf("Unsupported entity type %s", entityType))
+	}
+
+	c, ok := s.collections[path]
+	if !ok {
+		return nil, errBadRequest("id", fmt.Sprintf("%s %d not found", entityType, id))
+	}
+
+	rec, ok := c.records[id]
+	if !ok {
+		return nil, errBadRequest("id", fmt.Sprintf("%s %d not found", entityType, id))
+	}
+
+	return rec, nil
+}
+
+// get returns the resource with the given ID in the collection at the given concrete path.
+func (s *Server) get(path string, id int) (*record, bool) {
+	c, ok := s.collections[path]
+	if !ok {
+		return nil, false
+	}
+
+	rec, ok := c.records[id]
+
+	return rec, ok
+}
+
+// filterRecords returns the objects of all resources in the collection
+// at the given concrete path matching the given predicate.
+func (s *Server) filterRecords(path string, predicate func(o object) bool) []object {
+	c, ok := s.collections[path]
+	if !ok {
+		return []object{}
+	}
+
+	result := []object{}
+
+	for _, rec := range c.sortedRecords() {
+		if predicate(rec.data) {
+			result = append(result, s.view(c, rec))
+		}
+	}
+
+	return result
+}
+
+// listHandler returns a handler listing the objects returned by the given function.
+func listHandler(list func(s *Server, req *request) ([]object, *apiError)) handlerFunc {
+	return func(s *Server, req *request) (any, *apiError) {
+		objects, err := list(s, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return paginate(req.http, objects)
+	}
+}
@@ -0,0 +1,1058 @@
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Terraform drives the muxed Linode provider through the Terraform plugin protocol,
+// performing the plan, apply, refresh and import operations of the Terraform CLI
+// for a single resource. This allows resources to be tested against a Server
+// without installing the Terraform CLI.
+type Terraform struct {
+	t               testing.TB
+	provider        tfprotov6.ProviderServer
+	schemas         map[string]*tfprotov6.Schema
+	identitySchemas map[string]*tfprotov6.ResourceIdentitySchema
+}
+
+// State is the state of a resource managed through Terraform.
+type State struct {
+	TypeName string
+	Value    tftypes.Value
+	Private  []byte
+
+	// Identity is the identity of the resource, or a Value without a type
+	// if the resource does not support identity.
+	Identity tftypes.Value
+}
+
+// NewTerraform returns a Terraform configuring the Linode provider to use this server.
+func (s *Server) NewTerraform(t testing.TB) *Terraform {
+	t.Helper()
+
+	ctx := context.Background()
+
+	provider, err := ProtoV6ProviderFactories()["linode"]()
+	if err != nil {
+		t.Fatalf("failed to create provider: %s", err)
+	}
+
+	schemaResp, err := provider.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("failed to get provider schema: %s", err)
+	}
+
+	checkDiagnostics(t, "get provider schema", schemaResp.Diagnostics)
+
+	identityResp, err := provider.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("failed to get resource identity schemas: %s", err)
+	}
+
+	checkDiagnostics(t, "get resource identity schemas", identityResp.Diagnostics)
+
+	tf := &Terraform{
+		t:               t,
+		provider:        provider,
+		schemas:         schemaResp.ResourceSchemas,
+		identitySchemas: identityResp.IdentitySchemas,
+	}
+
+	config := tf.configValue(schemaResp.Provider.Block, map[string]any{
+		"url":                    s.URL,
+		"api_version":            "v4beta",
+		"token":                  "fake-token",
+		"event_poll_ms":          50,
+		"lke_event_poll_ms":      50,
+		"lke_node_ready_poll_ms": 50,
+		"min_retry_delay_ms":     10,
+		"max_retry_delay_ms":     50,
+	})
+
+	configureResp, err := provider.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.9.0",
+		Config:           tf.dynamicValue(config),
+	})
+	if err != nil {
+		t.Fatalf("failed to configure provider: %s", err)
+	}
+
+	checkDiagnostics(t, "configure provider", configureResp.Diagnostics)
+
+	return tf
+}
+
+// Apply plans and applies the given configuration of a resource of the given type,
+// creating the resource if prior is nil. The resource is refreshed afterwards and
+// the test fails if planning the same configuration again results in changes.
+// Like Terraform, the test also fails if the plan is invalid for the configuration
+// or the applied state is inconsistent with the plan.
+func (tf *Terraform) Apply(typeName string, prior *State, config map[string]any) *State {
+	tf.t.Helper()
+
+	schema := tf.schema(typeName)
+	configValue := tf.configValue(schema.Block, config)
+
+	if prior == nil {
+		prior = &State{TypeName: typeName, Value: tftypes.NewValue(schema.ValueType(), nil)}
+	}
+
+	planned := tf.plan(prior, configValue)
+	plannedValue := planned.Value
+
+	resp, err := tf.provider.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:        typeName,
+		PriorState:      tf.dynamicValue(prior.Value),
+		PlannedState:    tf.dynamicValue(plannedValue),
+		Config:          tf.dynamicValue(configValue),
+		PlannedPrivate:  planned.Private,
+		PlannedIdentity: tf.identityData(planned.Identity),
+	})
+	if err != nil {
+		tf.t.Fatalf("failed to apply %s: %s", typeName, err)
+	}
+
+	checkDiagnostics(tf.t, "apply "+typeName, resp.Diagnostics)
+
+	// Terraform only logs the inconsistencies of resources using the legacy type system of the SDKv2
+	newValue := tf.unmarshal(schema, resp.NewState)
+	if errs := compatibleValues(tftypes.NewAttributePath(), plannedValue, newValue); len(errs) > 0 &&
+		!resp.UnsafeToUseLegacyTypeSystem {
+		tf.t.Fatalf("provider produced inconsistent result after applying %s:\n%s", typeName, strings.Join(errs, "\n"))
+	}
+
+	state := tf.Refresh(&State{
+		TypeName: typeName,
+		Value:    newValue,
+		Private:  resp.Private,
+		Identity: tf.unmarshalIdentity(typeName, resp.NewIdentity),
+	})
+
+	tf.ExpectEmptyPlan(state, config)
+
+	return state
+}
+
+// ExpectEmptyPlan fails the test if planning the given configuration
+// for the given resource results in changes.
+func (tf *Terraform) ExpectEmptyPlan(state *State, config map[string]any) {
+	tf.t.Helper()
+
+	configValue := tf.configValue(tf.schema(state.TypeName).Block, config)
+
+	if replanned := tf.plan(state, configValue).Value; !replanned.Equal(state.Value) {
+		tf.t.Fatalf("expected an empty plan for %s, got changes:\n%s", state.TypeName, diffValues(state.Value, replanned))
+	}
+}
+
+// Refresh reads the given resource, returning its new state.
+// The test fails if the resource no longer exists.
+func (tf *Terraform) Refresh(state *State) *State {
+	tf.t.Helper()
+
+	schema := tf.schema(state.TypeName)
+
+	resp, err := tf.provider.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:        state.TypeName,
+		CurrentState:    tf.dynamicValue(state.Value),
+		Private:         state.Private,
+		CurrentIdentity: tf.identityData(state.Identity),
+	})
+	if err != nil {
+		tf.t.Fatalf("failed to read %s: %s", state.TypeName, err)
+	}
+
+	checkDiagnostics(tf.t, "read "+state.TypeName, resp.Diagnostics)
+
+	newValue := tf.unmarshal(schema, resp.NewState)
+	if newValue.IsNull() {
+		tf.t.Fatalf("%s was removed from the state during refresh", state.TypeName)
+	}
+
+	return &State{
+		TypeName: state.TypeName,
+		Value:    newValue,
+		Private:  resp.Private,
+		Identity: tf.unmarshalIdentity(state.TypeName, resp.NewIdentity),
+	}
+}
+
+// UpgradeState upgrades and refreshes a resource of the given type from its JSON state
+// written with the given schema version, as Terraform does for a state file written by
+// an earlier version of the provider.
+func (tf *Terraform) UpgradeState(typeName string, version int64, rawState, private []byte) *State {
+	tf.t.Helper()
+
+	schema := tf.schema(typeName)
+
+	resp, err := tf.provider.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: rawState},
+	})
+	if err != nil {
+		tf.t.Fatalf("failed to upgrade %s: %s", typeName, err)
+	}
+
+	checkDiagnostics(tf.t, "upgrade "+typeName, resp.Diagnostics)
+
+	return tf.Refresh(&State{
+		TypeName: typeName,
+		Value:    tf.unmarshal(schema, resp.UpgradedState),
+		Private:  private,
+		Identity: tf.unmarshalIdentity(typeName, nil),
+	})
+}
+
+// Import imports and refreshes the resource of the given type with the given import ID.
+func (tf *Terraform) Import(typeName, id string) *State {
+	tf.t.Helper()
+
+	return tf.importResource(&tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+}
+
+// ImportIdentity imports and refreshes the resource of the given type with the given identity.
+func (tf *Terraform) ImportIdentity(typeName string, identity map[string]any) *State {
+	tf.t.Helper()
+
+	identitySchema, ok := tf.identitySchemas[typeName]
+	if !ok {
+		tf.t.Fatalf("%s does not support identity", typeName)
+	}
+
+	identityValue, err := goValue(identitySchema.ValueType(), identity)
+	if err != nil {
+		tf.t.Fatalf("invalid identity: %s", err)
+	}
+
+	return tf.importResource(&tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		Identity: tf.identityData(identityValue),
+	})
+}
+
+func (tf *Terraform) importResource(req *tfprotov6.ImportResourceStateRequest) *State {
+	tf.t.Helper()
+
+	typeName := req.TypeName
+	schema := tf.schema(typeName)
+
+	resp, err := tf.provider.ImportResourceState(context.Background(), req)
+	if err != nil {
+		tf.t.Fatalf("failed to import %s: %s", typeName, err)
+	}
+
+	checkDiagnostics(tf.t, "import "+typeName, resp.Diagnostics)
+
+	if len(resp.ImportedResources) != 1 {
+		tf.t.Fatalf("expected 1 imported %s, got %d", typeName, len(resp.ImportedResources))
+	}
+
+	imported := resp.ImportedResources[0]
+
+	return tf.Refresh(&State{
+		TypeName: typeName,
+		Value:    tf.unmarshal(schema, imported.State),
+		Private:  imported.Private,
+		Identity: tf.unmarshalIdentity(typeName, imported.Identity),
+	})
+}
+
+// Destroy plans and applies the deletion of the given resource.
+func (tf *Terraform) Destroy(state *State) {
+	tf.t.Helper()
+
+	schema := tf.schema(state.TypeName)
+	nullValue := tftypes.NewValue(schema.ValueType(), nil)
+
+	planned := tf.plan(state, nullValue)
+	if !planned.Value.IsNull() {
+		tf.t.Fatalf("expected %s to be planned for deletion", state.TypeName)
+	}
+
+	resp, err := tf.provider.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:        state.TypeName,
+		PriorState:      tf.dynamicValue(state.Value),
+		PlannedState:    tf.dynamicValue(nullValue),
+		Config:          tf.dynamicValue(nullValue),
+		PlannedPrivate:  planned.Private,
+		PlannedIdentity: tf.identityData(planned.Identity),
+	})
+	if err != nil {
+		tf.t.Fatalf("failed to destroy %s: %s", state.TypeName, err)
+	}
+
+	checkDiagnostics(tf.t, "destroy "+state.TypeName, resp.Diagnostics)
+}
+
+// plan returns the planned state of a resource for the given configuration.
+func (tf *Terraform) plan(prior *State, configValue tftypes.Value) *State {
+	tf.t.Helper()
+
+	typeName, priorValue := prior.TypeName, prior.Value
+	schema := tf.schema(typeName)
+
+	proposedValue := configValue
+	if !configValue.IsNull() {
+		proposedValue = proposedNewValue(schema.Block, priorValue, configValue)
+	}
+
+	resp, err := tf.provider.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       tf.dynamicValue(priorValue),
+		ProposedNewState: tf.dynamicValue(proposedValue),
+		Config:           tf.dynamicValue(configValue),
+		PriorPrivate:     prior.Private,
+		PriorIdentity:    tf.identityData(prior.Identity),
+	})
+	if err != nil {
+		tf.t.Fatalf("failed to plan %s: %s", typeName, err)
+	}
+
+	checkDiagnostics(tf.t, "plan "+typeName, resp.Diagnostics)
+
+	plannedValue := tf.unmarshal(schema, resp.PlannedState)
+	if !configValue.IsNull() && !resp.UnsafeToUseLegacyTypeSystem {
+		if errs := validPlannedBlock(
+			tftypes.NewAttributePath(), schema.Block, priorValue, configValue, plannedValue,
+		); len(errs) > 0 {
+			tf.t.Fatalf("provider produced invalid plan for %s:\n%s", typeName, strings.Join(errs, "\n"))
+		}
+	}
+
+	return &State{
+		TypeName: typeName,
+		Value:    plannedValue,
+		Private:  resp.PlannedPrivate,
+		Identity: tf.unmarshalIdentity(typeName, resp.PlannedIdentity),
+	}
+}
+
+func (tf *Terraform) schema(typeName string) *tfprotov6.Schema {
+	tf.t.Helper()
+
+	schema, ok := tf.schemas[typeName]
+	if !ok {
+		tf.t.Fatalf("unknown resource type %s", typeName)
+	}
+
+	return schema
+}
+
+func (tf *Terraform) dynamicValue(value tftypes.Value) *tfprotov6.DynamicValue {
+	tf.t.Helper()
+
+	result, err := tfprotov6.NewDynamicValue(value.Type(), value)
+	if err != nil {
+		tf.t.Fatalf("failed to encode value: %s", err)
+	}
+
+	return &result
+}
+
+func (tf *Terraform) unmarshal(schema *tfprotov6.Schema, value *tfprotov6.DynamicValue) tftypes.Value {
+	tf.t.Helper()
+
+	if value == nil {
+		return tftypes.NewValue(schema.ValueType(), nil)
+	}
+
+	result, err := value.Unmarshal(schema.ValueType())
+	if err != nil {
+		tf.t.Fatalf("failed to decode value: %s", err)
+	}
+
+	return result
+}
+
+// identityData encodes the given identity, which is nil for a Value without a type.
+func (tf *Terraform) identityData(identity tftypes.Value) *tfprotov6.ResourceIdentityData {
+	tf.t.Helper()
+
+	if identity.Type() == nil {
+		return nil
+	}
+
+	return &tfprotov6.ResourceIdentityData{IdentityData: tf.dynamicValue(identity)}
+}
+
+// unmarshalIdentity decodes the given identity of a resource of the given type. The identity is null
+// if it was not returned by the provider, and a Value without a type if the resource has no identity.
+func (tf *Terraform) unmarshalIdentity(typeName string, identity *tfprotov6.ResourceIdentityData) tftypes.Value {
+	tf.t.Helper()
+
+	identitySchema, ok := tf.identitySchemas[typeName]
+	if !ok {
+		return tftypes.Value{}
+	}
+
+	if identity == nil || identity.IdentityData == nil {
+		return tftypes.NewValue(identitySchema.ValueType(), nil)
+	}
+
+	result, err := identity.IdentityData.Unmarshal(identitySchema.ValueType())
+	if err != nil {
+		tf.t.Fatalf("failed to decode identity: %s", err)
+	}
+
+	return result
+}
+
+// configValue converts a configuration to a value of the given block, in the same way
+// as Terraform: unset attributes are null and unset nested blocks are empty.
+func (tf *Terraform) configValue(block *tfprotov6.SchemaBlock, config map[string]any) tftypes.Value {
+	tf.t.Helper()
+
+	value, err := blockValue(block, config)
+	if err != nil {
+		tf.t.Fatalf("invalid configuration: %s", err)
+	}
+
+	return value
+}
+
+func blockValue(block *tfprotov6.SchemaBlock, config map[string]any) (tftypes.Value, error) {
+	blockType := block.ValueType().(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(blockType.AttributeTypes))
+
+	known := make(map[string]struct{}, len(config))
+
+	for _, attr := range block.Attributes {
+		known[attr.Name] = struct{}{}
+
+		value, err := goValue(attr.ValueType(), config[attr.Name])
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", attr.Name, err)
+		}
+
+		values[attr.Name] = value
+	}
+
+	for _, nested := range block.BlockTypes {
+		known[nested.TypeName] = struct{}{}
+
+		value, err := nestedBlockValue(nested, config[nested.TypeName])
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", nested.TypeName, err)
+		}
+
+		values[nested.TypeName] = value
+	}
+
+	for name := range config {
+		if _, ok := known[name]; !ok {
+			return tftypes.Value{}, fmt.Errorf("unsupported argument %q", name)
+		}
+	}
+
+	return tftypes.NewValue(blockType, values), nil
+}
+
+func nestedBlockValue(nested *tfprotov6.SchemaNestedBlock, config any) (tftypes.Value, error) {
+	valueType := nested.ValueType()
+
+	switch nested.Nesting {
+	case tfprotov6.SchemaNestedBlockNestingModeSingle, tfprotov6.SchemaNestedBlockNestingModeGroup:
+		if config == nil {
+			return tftypes.NewValue(valueType, nil), nil
+		}
+
+		object, ok := config.(map[string]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a map, got %T", config)
+		}
+
+		return blockValue(nested.Block, object)
+	case tfprotov6.SchemaNestedBlockNestingModeList, tfprotov6.SchemaNestedBlockNestingModeSet:
+		var objects []map[string]any
+
+		switch v := config.(type) {
+		case nil:
+		case map[string]any:
+			objects = []map[string]any{v}
+		case []map[string]any:
+			objects = v
+		default:
+			return tftypes.Value{}, fmt.Errorf("expected a map or list of maps, got %T", config)
+		}
+
+		elements := make([]tftypes.Value, len(objects))
+
+		for i, object := range objects {
+			element, err := blockValue(nested.Block, object)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%d: %w", i, err)
+			}
+
+			elements[i] = element
+		}
+
+		return tftypes.NewValue(valueType, elements), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported nesting mode %s", nested.Nesting)
+	}
+}
+
+// goValue converts a Go value to a value of the given type.
+func goValue(valueType tftypes.Type, value any) (tftypes.Value, error) {
+	if value == nil {
+		return tftypes.NewValue(valueType, nil), nil
+	}
+
+	switch {
+	case valueType.Is(tftypes.String):
+		v, ok := value.(string)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a string, got %T", value)
+		}
+
+		return tftypes.NewValue(valueType, v), nil
+	case valueType.Is(tftypes.Bool):
+		v, ok := value.(bool)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a bool, got %T", value)
+		}
+
+		return tftypes.NewValue(valueType, v), nil
+	case valueType.Is(tftypes.Number):
+		switch v := value.(type) {
+		case int:
+			return tftypes.NewValue(valueType, big.NewFloat(float64(v))), nil
+		case float64:
+			return tftypes.NewValue(valueType, big.NewFloat(v)), nil
+		default:
+			return tftypes.Value{}, fmt.Errorf("expected a number, got %T", value)
+		}
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}):
+		var elementType tftypes.Type
+
+		if list, ok := valueType.(tftypes.List); ok {
+			elementType = list.ElementType
+		} else {
+			elementType = valueType.(tftypes.Set).ElementType
+		}
+
+		items, ok := value.([]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a list, got %T", value)
+		}
+
+		elements := make([]tftypes.Value, len(items))
+
+		for i, item := range items {
+			element, err := goValue(elementType, item)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%d: %w", i, err)
+			}
+
+			elements[i] = element
+		}
+
+		return tftypes.NewValue(valueType, elements), nil
+	case valueType.Is(tftypes.Map{}):
+		items, ok := value.(map[string]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a map, got %T", value)
+		}
+
+		elements := make(map[string]tftypes.Value, len(items))
+
+		for key, item := range items {
+			element, err := goValue(valueType.(tftypes.Map).ElementType, item)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", key, err)
+			}
+
+			elements[key] = element
+		}
+
+		return tftypes.NewValue(valueType, elements), nil
+	case valueType.Is(tftypes.Object{}):
+		items, ok := value.(map[string]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a map, got %T", value)
+		}
+
+		objectType := valueType.(tftypes.Object)
+		attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+		for name, attributeType := range objectType.AttributeTypes {
+			attribute, err := goValue(attributeType, items[name])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(valueType, attributes), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported type %s", valueType)
+	}
+}
+
+// proposedNewValue returns the proposed new value of a block in the same way as Terraform,
+// taking the prior values of computed attributes that are unset in the configuration.
+func proposedNewValue(block *tfprotov6.SchemaBlock, prior, config tftypes.Value) tftypes.Value {
+	if prior.IsNull() || !prior.IsKnown() {
+		return config
+	}
+
+	var priorAttributes, configAttributes map[string]tftypes.Value
+
+	if err := prior.As(&priorAttributes); err != nil {
+		return config
+	}
+
+	if err := config.As(&configAttributes); err != nil {
+		return config
+	}
+
+	result := make(map[string]tftypes.Value, len(configAttributes))
+
+	for name, value := range configAttributes {
+		result[name] = value
+	}
+
+	for _, attr := range block.Attributes {
+		switch {
+		case attr.Computed && configAttributes[attr.Name].IsNull():
+			result[attr.Name] = priorAttributes[attr.Name]
+		case attr.NestedType != nil:
+			result[attr.Name] = proposedNewNestedValue(attr.NestedType, priorAttributes[attr.Name], configAttributes[attr.Name])
+		}
+	}
+
+	for _, nested := range block.BlockTypes {
+		if nested.Nesting != tfprotov6.SchemaNestedBlockNestingModeList {
+			continue
+		}
+
+		var priorElements, configElements []tftypes.Value
+
+		if err := priorAttributes[nested.TypeName].As(&priorElements); err != nil {
+			continue
+		}
+
+		if err := configAttributes[nested.TypeName].As(&configElements); err != nil {
+			continue
+		}
+
+		elements := make([]tftypes.Value, len(configElements))
+
+		for i, element := range configElements {
+			elements[i] = element
+			if i < len(priorElements) {
+				elements[i] = proposedNewValue(nested.Block, priorElements[i], element)
+			}
+		}
+
+		result[nested.TypeName] = tftypes.NewValue(configAttributes[nested.TypeName].Type(), elements)
+	}
+
+	return tftypes.NewValue(config.Type(), result)
+}
+
+// proposedNewNestedValue returns the proposed new value of a nested attribute, taking the prior
+// values of the computed attributes of its objects, which are correlated by index in lists.
+func proposedNewNestedValue(nested *tfprotov6.SchemaObject, prior, config tftypes.Value) tftypes.Value {
+	if prior.IsNull() || !prior.IsKnown() || config.IsNull() || !config.IsKnown() {
+		return config
+	}
+
+	objectBlock := &tfprotov6.SchemaBlock{Attributes: nested.Attributes}
+
+	switch nested.Nesting {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		return proposedNewValue(objectBlock, prior, config)
+	case tfprotov6.SchemaObjectNestingModeList:
+		var priorElements, configElements []tftypes.Value
+
+		if prior.As(&priorElements) != nil || config.As(&configElements) != nil {
+			return config
+		}
+
+		elements := make([]tftypes.Value, len(configElements))
+
+		for i, element := range configElements {
+			elements[i] = element
+			if i < len(priorElements) {
+				elements[i] = proposedNewValue(objectBlock, priorElements[i], element)
+			}
+		}
+
+		return tftypes.NewValue(config.Type(), elements)
+	default:
+		return config
+	}
+}
+
+// validPlannedBlock checks a planned value of a block against its configuration in the same way
+// as Terraform, returning a description of each invalid value. Non-computed attributes must be
+// planned as configured, and computed attributes may only differ from a configured value by
+// keeping the prior value.
+func validPlannedBlock(
+	attrPath *tftypes.AttributePath, block *tfprotov6.SchemaBlock, prior, config, planned tftypes.Value,
+) []string {
+	if !planned.IsKnown() || planned.IsNull() {
+		return nil
+	}
+
+	priorAttributes := make(map[string]tftypes.Value)
+	if prior.IsKnown() && !prior.IsNull() {
+		_ = prior.As(&priorAttributes)
+	}
+
+	var configAttributes, plannedAttributes map[string]tftypes.Value
+	if config.As(&configAttributes) != nil || planned.As(&plannedAttributes) != nil {
+		return nil
+	}
+
+	var errs []string
+
+	for _, attr := range block.Attributes {
+		priorValue, ok := priorAttributes[attr.Name]
+		if !ok {
+			priorValue = tftypes.NewValue(attr.ValueType(), nil)
+		}
+
+		errs = append(errs, validPlannedValue(
+			attrPath.WithAttributeName(attr.Name), attr, priorValue, configAttributes[attr.Name], plannedAttributes[attr.Name],
+		)...)
+	}
+
+	for _, nested := range block.BlockTypes {
+		if nested.Nesting != tfprotov6.SchemaNestedBlockNestingModeList {
+			continue
+		}
+
+		nestedPath := attrPath.WithAttributeName(nested.TypeName)
+
+		var priorElements, configElements, plannedElements []tftypes.Value
+
+		if priorValue, ok := priorAttributes[nested.TypeName]; ok && priorValue.IsKnown() && !priorValue.IsNull() {
+			_ = priorValue.As(&priorElements)
+		}
+
+		if !plannedAttributes[nested.TypeName].IsKnown() {
+			errs = append(errs, fmt.Sprintf("%s: planned unknown value for a nested block", nestedPath))
+			continue
+		}
+
+		if configAttributes[nested.TypeName].As(&configElements) != nil ||
+			plannedAttributes[nested.TypeName].As(&plannedElements) != nil {
+			continue
+		}
+
+		if len(plannedElements) != len(configElements) {
+			errs = append(errs, fmt.Sprintf(
+				"%s: block count in plan (%d) disagrees with count in config (%d)",
+				nestedPath, len(plannedElements), len(configElements),
+			))
+
+			continue
+		}
+
+		for i, element := range plannedElements {
+			priorElement := tftypes.NewValue(element.Type(), nil)
+			if i < len(priorElements) {
+				priorElement = priorElements[i]
+			}
+
+			errs = append(errs, validPlannedBlock(
+				nestedPath.WithElementKeyInt(i), nested.Block, priorElement, configElements[i], element,
+			)...)
+		}
+	}
+
+	return errs
+}
+
+func validPlannedValue(
+	attrPath *tftypes.AttributePath, attr *tfprotov6.SchemaAttribute, prior, config, planned tftypes.Value,
+) []string {
+	switch {
+	case planned.Equal(config),
+		planned.Equal(prior) && !prior.IsNull() && !config.IsNull(),
+		attr.Computed && !attr.Optional,
+		attr.Computed && config.IsNull():
+		return nil
+	case config.IsNull():
+		return []string{fmt.Sprintf("%s: planned value %s for a non-computed attribute", attrPath, planned)}
+	}
+
+	if attr.NestedType == nil || !planned.IsKnown() || planned.IsNull() {
+		return []string{fmt.Sprintf("%s: planned value %s does not match config value %s", attrPath, planned, config)}
+	}
+
+	objectBlock := &tfprotov6.SchemaBlock{Attributes: attr.NestedType.Attributes}
+
+	switch attr.NestedType.Nesting {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		return validPlannedBlock(attrPath, objectBlock, prior, config, planned)
+	case tfprotov6.SchemaObjectNestingModeList:
+		var priorElements, configElements, plannedElements []tftypes.Value
+
+		if prior.IsKnown() && !prior.IsNull() {
+			_ = prior.As(&priorElements)
+		}
+
+		if config.As(&configElements) != nil || planned.As(&plannedElements) != nil {
+			return nil
+		}
+
+		if len(plannedElements) != len(configElements) {
+			return []string{fmt.Sprintf(
+				"%s: count in plan (%d) disagrees with count in config (%d)",
+				attrPath, len(plannedElements), len(configElements),
+			)}
+		}
+
+		var errs []string
+
+		for i, element := range plannedElements {
+			priorElement := tftypes.NewValue(element.Type(), nil)
+			if i < len(priorElements) {
+				priorElement = priorElements[i]
+			}
+
+			errs = append(errs, validPlannedBlock(
+				attrPath.WithElementKeyInt(i), objectBlock, priorElement, configElements[i], element,
+			)...)
+		}
+
+		return errs
+	default:
+		return nil
+	}
+}
+
+// compatibleValues checks that a new value of a resource conforms to its planned value
+// in the same way as Terraform, returning a description of each inconsistent value.
+// Unknown planned values may take any value, while known ones must be unchanged.
+func compatibleValues(attrPath *tftypes.AttributePath, planned, actual tftypes.Value) []string {
+	if !planned.IsKnown() {
+		if !actual.IsFullyKnown() {
+			return []string{fmt.Sprintf("%s: unknown value after apply", attrPath)}
+		}
+
+		return nil
+	}
+
+	if planned.IsNull() || actual.IsNull() || !actual.IsKnown() {
+		if !planned.Equal(actual) {
+			return []string{fmt.Sprintf("%s: was %s, but now %s", attrPath, planned, actual)}
+		}
+
+		return nil
+	}
+
+	switch {
+	case planned.Type().Is(tftypes.List{}), planned.Type().Is(tftypes.Tuple{}):
+		var plannedElements, actualElements []tftypes.Value
+
+		_ = planned.As(&plannedElements)
+		_ = actual.As(&actualElements)
+
+		if len(plannedElements) != len(actualElements) {
+			return []string{fmt.Sprintf(
+				"%s: length changed from %d to %d", attrPath, len(plannedElements), len(actualElements),
+			)}
+		}
+
+		var errs []string
+
+		for i := range plannedElements {
+			errs = append(errs, compatibleValues(attrPath.WithElementKeyInt(i), plannedElements[i], actualElements[i])...)
+		}
+
+		return errs
+	case planned.Type().Is(tftypes.Object{}), planned.Type().Is(tftypes.Map{}):
+		var plannedAttributes, actualAttributes map[string]tftypes.Value
+
+		_ = planned.As(&plannedAttributes)
+		_ = actual.As(&actualAttributes)
+
+		if len(plannedAttributes) != len(actualAttributes) {
+			return []string{fmt.Sprintf("%s: was %s, but now %s", attrPath, planned, actual)}
+		}
+
+		var errs []string
+
+		for name, value := range plannedAttributes {
+			errs = append(errs, compatibleValues(attrPath.WithAttributeName(name), value, actualAttributes[name])...)
+		}
+
+		return errs
+	case planned.Type().Is(tftypes.Set{}):
+		// Sets with unknown elements can't be correlated, so only their length is checked
+		if !planned.IsFullyKnown() {
+			var plannedElements, actualElements []tftypes.Value
+
+			_ = planned.As(&plannedElements)
+			_ = actual.As(&actualElements)
+
+			if len(plannedElements) == len(actualElements) {
+				return nil
+			}
+		} else if planned.Equal(actual) {
+			return nil
+		}
+
+		return []string{fmt.Sprintf("%s: was %s, but now %s", attrPath, planned, actual)}
+	}
+
+	if !planned.Equal(actual) {
+		return []string{fmt.Sprintf("%s: was %s, but now %s", attrPath, planned, actual)}
+	}
+
+	return nil
+}
+
+// Attr returns the value of the attribute at the given path of the state, e.g. "ipv4.0".
+// Strings, numbers and booleans are returned as string, float64 and bool respectively,
+// and null values as nil.
+func (s *State) Attr(path string) any {
+	value := s.Value
+
+	for _, step := range strings.Split(path, ".") {
+		if value.IsNull() || !value.IsKnown() {
+			return nil
+		}
+
+		switch {
+		case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+			var elements []tftypes.Value
+
+			index, err := strconv.Atoi(step)
+			if err != nil || value.As(&elements) != nil || index >= len(elements) {
+				return nil
+			}
+
+			value = elements[index]
+		default:
+			var attributes map[string]tftypes.Value
+
+			if err := value.As(&attributes); err != nil {
+				return nil
+			}
+
+			next, ok := attributes[step]
+			if !ok {
+				return nil
+			}
+
+			value = next
+		}
+	}
+
+	return goAttr(value)
+}
+
+// IdentityAttr returns the value of the given attribute of the identity of the resource,
+// in the same way as Attr.
+func (s *State) IdentityAttr(name string) any {
+	if s.Identity.Type() == nil || s.Identity.IsNull() {
+		return nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := s.Identity.As(&attributes); err != nil {
+		return nil
+	}
+
+	value, ok := attributes[name]
+	if !ok {
+		return nil
+	}
+
+	return goAttr(value)
+}
+
+func goAttr(value tftypes.Value) any {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var v string
+		_ = value.As(&v)
+
+		return v
+	case value.Type().Is(tftypes.Bool):
+		var v bool
+		_ = value.As(&v)
+
+		return v
+	case value.Type().Is(tftypes.Number):
+		var v big.Float
+		_ = value.As(&v)
+
+		result, _ := v.Float64()
+
+		return result
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+
+		result := make([]any, len(elements))
+		for i, element := range elements {
+			result[i] = goAttr(element)
+		}
+
+		return result
+	default:
+		var attributes map[string]tftypes.Value
+		_ = value.As(&attributes)
+
+		result := make(map[string]any, len(attributes))
+		for name, attribute := range attributes {
+			result[name] = goAttr(attribute)
+		}
+
+		return result
+	}
+}
+
+// diffValues describes the attributes that differ between two values.
+func diffValues(before, after tftypes.Value) string {
+	diffs, err := before.Diff(after)
+	if err != nil {
+		return err.Error()
+	}
+
+	var result strings.Builder
+
+	for _, diff := range diffs {
+		if diff.Value1 == nil || diff.Value2 == nil || diff.Value1.Type().Is(tftypes.Object{}) {
+			continue
+		}
+
+		fmt.Fprintf(&result, "  %s: %s => %s\n", diff.Path, diff.Value1, diff.Value2)
+	}
+
+	return result.String()
+}
+
+func checkDiagnostics(t testing.TB, operation string, diagnostics []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("failed to %s: %s: %s", operation, diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
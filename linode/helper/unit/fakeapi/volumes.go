@@ -0,0 +1,171 @@
+package fakeapi
+
+import (
+	"fmt"
+	"maps"
+)
+
+const (
+	defaultVolumeSize = 20
+	minVolumeSize     = 10
+)
+
+var volumeKind *resourceKind
+
+func createVolume(s *Server, req *request, path string) (object, *apiError) {
+	if err := req.require("label"); err != nil {
+		return nil, err
+	}
+
+	region := req.string("region", "")
+
+	var linodeLabel any
+
+	if linodeID, ok := req.int("linode_id"); ok {
+		linode, err := s.getEntity("linode", linodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		region = linode.data["region"].(string)
+		linodeLabel = linode.data["label"]
+	}
+
+	if region == "" {
+		return nil, errBadRequest("region", "region or linode_id is required")
+	}
+
+	if _, ok := findStatic(regions, region); !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	size := defaultVolumeSize
+	if value, ok := req.int("size"); ok {
+		size = value
+	}
+
+	if size < minVolumeSize {
+		return nil, errBadRequest("size", fmt.Sprintf("Size must be at least %d", minVolumeSize))
+	}
+
+	label := req.string("label", "")
+
+	return object{
+		"label":           label,
+		"region":          region,
+		"size":            size,
+		"status":          "creating",
+		"linode_id":       req.value("linode_id", nil),
+		"linode_label":    linodeLabel,
+		"filesystem_path": "/dev/disk/by-id/scsi-0Linode_Volume_" + label,
+		"hardware_type":   "nvme",
+		"encryption":      req.string("encryption", "disabled"),
+		"tags":            req.list("tags"),
+	}, nil
+}
+
+func init() {
+	volumeKind = &resourceKind{
+		pattern:    "volumes",
+		entityType: "volume",
+		create:     createVolume,
+		afterCreate: func(s *Server, req *request, path string, rec *record) {
+			s.transition(rec, "status", "active")
+		},
+		update: func(s *Server, req *request, rec *record) *apiError {
+			for _, field := range []string{"label", "tags"} {
+				if value, ok := req.body[field]; ok {
+					rec.data[field] = value
+				}
+			}
+
+			return nil
+		},
+		beforeDelete: func(s *Server, req *request, rec *record) *apiError {
+			if rec.data["linode_id"] != nil {
+				return errBadRequest("", "Volume must be detached before it can be deleted")
+			}
+
+			return nil
+		},
+		actions: map[string]actionFunc{
+			"attach": func(s *Server, req *request, rec *record) (any, *apiError) {
+				if err := req.require("linode_id"); err != nil {
+					return nil, err
+				}
+
+				linodeID, _ := req.int("linode_id")
+
+				linode, err := s.getEntity("linode", linodeID)
+				if err != nil {
+					return nil, err
+				}
+
+				if rec.data["linode_id"] != nil && toInt(rec.data["linode_id"]) != linodeID {
+					return nil, errBadRequest("linode_id", "Volume is already attached to a Linode")
+				}
+
+				if linode.data["region"] != rec.data["region"] {
+					return nil, errBadRequest("linode_id", "Volume and Linode must be in the same region")
+				}
+
+				s.transition(rec, "linode_id", linodeID)
+				s.transition(rec, "linode_label", linode.data["label"])
+				s.emitEvent("volume_attach", "volume", rec.data, true)
+
+				return rec.data, nil
+			},
+			"detach": func(s *Server, req *request, rec *record) (any, *apiError) {
+				s.transition(rec, "linode_id", nil)
+				s.transition(rec, "linode_label", nil)
+				s.emitEvent("volume_detach", "volume", rec.data, true)
+
+				return object{}, nil
+			},
+			"resize": func(s *Server, req *request, rec *record) (any, *apiError) {
+				size, ok := req.int("size")
+				if !ok {
+					return nil, errBadRequest("size", "size is required")
+				}
+
+				if size <= toInt(rec.data["size"]) {
+					return nil, errBadRequest("size", "Volumes can only be resized up")
+				}
+
+				rec.data["size"] = size
+				rec.data["status"] = "resizing"
+				s.transition(rec, "status", "active")
+				s.emitEvent("volume_resize", "volume", rec.data, true)
+
+				return object{}, nil
+			},
+			"clone": func(s *Server, req *request, rec *record) (any, *apiError) {
+				if err := req.require("label"); err != nil {
+					return nil, err
+				}
+
+				data := maps.Clone(rec.data)
+				delete(data, "id")
+
+				label := req.string("label", "")
+
+				now := s.now()
+				data["label"] = label
+				data["filesystem_path"] = "/dev/disk/by-id/scsi-0Linode_Volume_" + label
+				data["status"] = "creating"
+				data["linode_id"] = nil
+				data["linode_label"] = nil
+				data["created"] = now
+				data["updated"] = now
+
+				clone := s.insert("volumes", volumeKind, data)
+				s.transition(clone, "status", "active")
+				s.emitEvent("volume_clone", "volume", clone.data, true)
+
+				return clone.data, nil
+			},
+		},
+	}
+
+	resourceKinds = append(resourceKinds, volumeKind)
+}
@@ -0,0 +1,163 @@
+package iamroleassignment
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+type ResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Username   types.String `tfsdk:"username"`
+	Role       types.String `tfsdk:"role"`
+	EntityID   types.Int64  `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+// GetBinding returns the role binding described by this resource.
+func (m *ResourceModel) GetBinding(diags *diag.Diagnostics) roleBinding {
+	result := roleBinding{
+		Role: m.Role.ValueString(),
+	}
+
+	if !m.EntityID.IsNull() {
+		result.EntityID = helper.FrameworkSafeInt64ToInt(m.EntityID.ValueInt64(), diags)
+		result.EntityType = m.EntityType.ValueString()
+	}
+
+	return result
+}
+
+func (m *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	m.ID = helper.KeepOrUpdateValue(m.ID, other.ID, preserveKnown)
+	m.Username = helper.KeepOrUpdateValue(m.Username, other.Username, preserveKnown)
+	m.Role = helper.KeepOrUpdateValue(m.Role, other.Role, preserveKnown)
+	m.EntityID = helper.KeepOrUpdateValue(m.EntityID, other.EntityID, preserveKnown)
+	m.EntityType = helper.KeepOrUpdateValue(m.EntityType, other.EntityType, preserveKnown)
+}
+
+// roleBinding is a single role assigned to a user, either at the account
+// level or scoped to an entity.
+type roleBinding struct {
+	Role       string
+	EntityType string
+	EntityID   int
+}
+
+func (b roleBinding) IsAccountLevel() bool {
+	return b.EntityType == ""
+}
+
+func buildID(username string, binding roleBinding) string {
+	if binding.IsAccountLevel() {
+		return fmt.Sprintf("%s:%s", username, binding.Role)
+	}
+
+	return fmt.Sprintf("%s:%s:%s:%d", username, binding.Role, binding.EntityType, binding.EntityID)
+}
+
+// hasBinding returns whether the given permissions contain the role binding.
+func hasBinding(perms *linodego.UserRolePermissions, binding roleBinding) bool {
+	if binding.IsAccountLevel() {
+		return slices.Contains(perms.AccountAccess, binding.Role)
+	}
+
+	for _, entity := range perms.EntityAccess {
+		if entity.ID == binding.EntityID && entity.Type == binding.EntityType {
+			return slices.Contains(entity.Roles, binding.Role)
+		}
+	}
+
+	return false
+}
+
+// addBinding returns update options for the given permissions with the role binding added.
+// All other account and entity roles are preserved.
+func addBinding(
+	perms *linodego.UserRolePermissions,
+	binding roleBinding,
+) linodego.UserRolePermissionsUpdateOptions {
+	result := clonePermissions(perms)
+
+	if binding.IsAccountLevel() {
+		if !slices.Contains(result.AccountAccess, binding.Role) {
+			result.AccountAccess = append(result.AccountAccess, binding.Role)
+		}
+
+		return result
+	}
+
+	for i, entity := range result.EntityAccess {
+		if entity.ID == binding.EntityID && entity.Type == binding.EntityType {
+			if !slices.Contains(entity.Roles, binding.Role) {
+				result.EntityAccess[i].Roles = append(entity.Roles, binding.Role)
+			}
+
+			return result
+		}
+	}
+
+	result.EntityAccess = append(result.EntityAccess, linodego.UserAccess{
+		ID:    binding.EntityID,
+		Type:  binding.EntityType,
+		Roles: []string{binding.Role},
+	})
+
+	return result
+}
+
+// removeBinding returns update options for the given permissions with the role binding removed.
+// Entities left without any roles are dropped.
+func removeBinding(
+	perms *linodego.UserRolePermissions,
+	binding roleBinding,
+) linodego.UserRolePermissionsUpdateOptions {
+	result := clonePermissions(perms)
+
+	if binding.IsAccountLevel() {
+		result.AccountAccess = slices.DeleteFunc(result.AccountAccess, func(role string) bool {
+			return role == binding.Role
+		})
+
+		return result
+	}
+
+	for i, entity := range result.EntityAccess {
+		if entity.ID == binding.EntityID && entity.Type == binding.EntityType {
+			result.EntityAccess[i].Roles = slices.DeleteFunc(entity.Roles, func(role string) bool {
+				return role == binding.Role
+			})
+		}
+	}
+
+	result.EntityAccess = slices.DeleteFunc(result.EntityAccess, func(entity linodego.UserAccess) bool {
+		return len(entity.Roles) < 1
+	})
+
+	return result
+}
+
+func clonePermissions(perms *linodego.UserRolePermissions) linodego.UserRolePermissionsUpdateOptions {
+	result := linodego.UserRolePermissionsUpdateOptions{
+		AccountAccess: slices.Clone(perms.AccountAccess),
+		EntityAccess:  make([]linodego.UserAccess, len(perms.EntityAccess)),
+	}
+
+	if result.AccountAccess == nil {
+		result.AccountAccess = []string{}
+	}
+
+	for i, entity := range perms.EntityAccess {
+		result.EntityAccess[i] = linodego.UserAccess{
+			ID:    entity.ID,
+			Type:  entity.Type,
+			Roles: slices.Clone(entity.Roles),
+		}
+	}
+
+	return result
+}
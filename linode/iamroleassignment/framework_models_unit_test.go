@@ -0,0 +1,85 @@
+//go:build unit
+
+package iamroleassignment
+
+import (
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddBinding(t *testing.T) {
+	perms := &linodego.UserRolePermissions{
+		AccountAccess: []string{"account_event_viewer"},
+		EntityAccess: []linodego.UserAccess{
+			{ID: 1, Type: "volume", Roles: []string{"volume_viewer"}},
+		},
+	}
+
+	// Account-level roles are appended
+	opts := addBinding(perms, roleBinding{Role: "account_linode_creator"})
+	require.Equal(t, []string{"account_event_viewer", "account_linode_creator"}, opts.AccountAccess)
+	require.Equal(t, perms.EntityAccess, opts.EntityAccess)
+
+	// Roles are added to existing entities
+	opts = addBinding(perms, roleBinding{Role: "volume_admin", EntityType: "volume", EntityID: 1})
+	require.Equal(t, []string{"volume_viewer", "volume_admin"}, opts.EntityAccess[0].Roles)
+
+	// New entities are appended
+	opts = addBinding(perms, roleBinding{Role: "linode_viewer", EntityType: "linode", EntityID: 2})
+	require.Len(t, opts.EntityAccess, 2)
+	require.Equal(t, linodego.UserAccess{ID: 2, Type: "linode", Roles: []string{"linode_viewer"}}, opts.EntityAccess[1])
+
+	// The original permissions are not modified
+	require.Equal(t, []string{"volume_viewer"}, perms.EntityAccess[0].Roles)
+	require.Equal(t, []string{"account_event_viewer"}, perms.AccountAccess)
+}
+
+func TestRemoveBinding(t *testing.T) {
+	perms := &linodego.UserRolePermissions{
+		AccountAccess: []string{"account_event_viewer", "account_linode_creator"},
+		EntityAccess: []linodego.UserAccess{
+			{ID: 1, Type: "volume", Roles: []string{"volume_viewer", "volume_admin"}},
+			{ID: 2, Type: "linode", Roles: []string{"linode_viewer"}},
+		},
+	}
+
+	opts := removeBinding(perms, roleBinding{Role: "account_linode_creator"})
+	require.Equal(t, []string{"account_event_viewer"}, opts.AccountAccess)
+	require.Len(t, opts.EntityAccess, 2)
+
+	opts = removeBinding(perms, roleBinding{Role: "volume_admin", EntityType: "volume", EntityID: 1})
+	require.Equal(t, []string{"volume_viewer"}, opts.EntityAccess[0].Roles)
+
+	// Entities without any remaining roles are dropped
+	opts = removeBinding(perms, roleBinding{Role: "linode_viewer", EntityType: "linode", EntityID: 2})
+	require.Len(t, opts.EntityAccess, 1)
+	require.Equal(t, 1, opts.EntityAccess[0].ID)
+
+	// The original permissions are not modified
+	require.Equal(t, []string{"volume_viewer", "volume_admin"}, perms.EntityAccess[0].Roles)
+}
+
+func TestHasBinding(t *testing.T) {
+	perms := &linodego.UserRolePermissions{
+		AccountAccess: []string{"account_event_viewer"},
+		EntityAccess: []linodego.UserAccess{
+			{ID: 1, Type: "volume", Roles: []string{"volume_viewer"}},
+		},
+	}
+
+	require.True(t, hasBinding(perms, roleBinding{Role: "account_event_viewer"}))
+	require.False(t, hasBinding(perms, roleBinding{Role: "volume_viewer"}))
+	require.True(t, hasBinding(perms, roleBinding{Role: "volume_viewer", EntityType: "volume", EntityID: 1}))
+	require.False(t, hasBinding(perms, roleBinding{Role: "volume_viewer", EntityType: "linode", EntityID: 1}))
+}
+
+func TestBuildID(t *testing.T) {
+	require.Equal(t, "user:account_event_viewer", buildID("user", roleBinding{Role: "account_event_viewer"}))
+	require.Equal(
+		t,
+		"user:volume_viewer:volume:1",
+		buildID("user", roleBinding{Role: "volume_viewer", EntityType: "volume", EntityID: 1}),
+	)
+}
@@ -0,0 +1,320 @@
+package iamroleassignment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+// userLocks serializes role updates for the same user so bindings
+// managed by different resources don't overwrite each other.
+var userLocks helper.KeyedMutex
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_iam_role_assignment",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding := plan.GetBinding(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+	ctx = populateLogAttributes(ctx, username, binding)
+
+	err := updateRolePermissions(ctx, r.Meta.Client, username, func(
+		perms *linodego.UserRolePermissions,
+	) *linodego.UserRolePermissionsUpdateOptions {
+		if hasBinding(perms, binding) {
+			return nil
+		}
+
+		updateOpts := addBinding(perms, binding)
+		return &updateOpts
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to assign role %s to user %s", binding.Role, username),
+			err.Error(),
+		)
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(buildID(username, binding))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	binding := state.GetBinding(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+	ctx = populateLogAttributes(ctx, username, binding)
+
+	tflog.Trace(ctx, "client.GetUserRolePermissions(...)")
+
+	perms, err := r.Meta.Client.GetUserRolePermissions(ctx, username)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"User No Longer Exists",
+				fmt.Sprintf(
+					"Removing role assignment %s from state because the user no longer exists",
+					state.ID.ValueString(),
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get role permissions for user %s", username),
+			err.Error(),
+		)
+		return
+	}
+
+	if !hasBinding(perms, binding) {
+		resp.Diagnostics.AddWarning(
+			"Role Assignment No Longer Exists",
+			fmt.Sprintf(
+				"Removing role assignment %s from state because the user no longer has the role",
+				state.ID.ValueString(),
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(buildID(username, binding))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+	resp.Diagnostics.AddWarning(
+		"Unintended Calling to Update Function",
+		"The Update function of 'linode_iam_role_assignment' should never be "+
+			"invoked by design. This function has been redundantly implemented "+
+			"for improved reliability. Please consider reporting this as a bug "+
+			"to the provider developers.",
+	)
+
+	var state, plan ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.CopyFrom(state, true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding := state.GetBinding(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+	ctx = populateLogAttributes(ctx, username, binding)
+
+	err := updateRolePermissions(ctx, r.Meta.Client, username, func(
+		perms *linodego.UserRolePermissions,
+	) *linodego.UserRolePermissionsUpdateOptions {
+		if !hasBinding(perms, binding) {
+			return nil
+		}
+
+		updateOpts := removeBinding(perms, binding)
+		return &updateOpts
+	})
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"User No Longer Exists",
+				fmt.Sprintf("User %s was not found; assuming its roles were removed.", username),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to remove role %s from user %s", binding.Role, username),
+			err.Error(),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+
+	idFields := []helper.ImportableID{
+		{
+			Name:          "username",
+			TypeConverter: helper.IDTypeConverterString,
+		},
+		{
+			Name:          "role",
+			TypeConverter: helper.IDTypeConverterString,
+		},
+	}
+
+	// Entity-scoped assignments are identified by the entity as well
+	if strings.Count(req.ID, ",") > 1 {
+		idFields = append(
+			idFields,
+			helper.ImportableID{
+				Name:          "entity_type",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+			helper.ImportableID{
+				Name:          "entity_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+		)
+	}
+
+	helper.ImportStateWithMultipleIDs(ctx, req, resp, idFields)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We need to manually set the ID in state
+	// because it is not implicitly populated by one of the
+	// ID attributes above
+	var state ResourceModel
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binding := state.GetBinding(&resp.Diagnostics)
+	state.ID = types.StringValue(buildID(state.Username.ValueString(), binding))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// updateRolePermissions applies the update options returned by modify to the current
+// role permissions of the given user. No request is made if modify returns nil.
+func updateRolePermissions(
+	ctx context.Context,
+	client *linodego.Client,
+	username string,
+	modify func(perms *linodego.UserRolePermissions) *linodego.UserRolePermissionsUpdateOptions,
+) error {
+	unlock := userLocks.Lock(username)
+	defer unlock()
+
+	// Always read the current permissions while holding the lock
+	// so roles managed elsewhere are preserved.
+	tflog.Trace(ctx, "client.GetUserRolePermissions(...)")
+
+	perms, err := client.GetUserRolePermissions(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	updateOpts := modify(perms)
+	if updateOpts == nil {
+		tflog.Debug(ctx, "Role permissions are already up to date")
+		return nil
+	}
+
+	tflog.Debug(ctx, "client.UpdateUserRolePermissions(...)", map[string]any{
+		"options": updateOpts,
+	})
+
+	if _, err := client.UpdateUserRolePermissions(ctx, username, *updateOpts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func populateLogAttributes(ctx context.Context, username string, binding roleBinding) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"username":    username,
+		"role":        binding.Role,
+		"entity_type": binding.EntityType,
+		"entity_id":   binding.EntityID,
+	})
+}
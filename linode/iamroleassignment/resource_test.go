@@ -0,0 +1,89 @@
+//go:build integration || iamroleassignment
+
+package iamroleassignment_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/iamroleassignment/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{linodego.CapabilityBlockStorage}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceIAMRoleAssignment_basic(t *testing.T) {
+	t.Parallel()
+
+	// IAM Tests need to be opted into, iam accounts do not support all existing user endpoints as they will be replacing some of them
+	acceptance.OptInTest(t)
+
+	accountResName := "linode_iam_role_assignment.account"
+	volumeResName := "linode_iam_role_assignment.volume"
+	username := acctest.RandomWithPrefix("tf_test")
+
+	data := tmpl.TemplateData{
+		VolumeLabel: acctest.RandomWithPrefix("tf_test"),
+		Region:      testRegion,
+		Username:    username,
+		Email:       username + "@example.com",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(accountResName, "id", username+":account_linode_creator"),
+					resource.TestCheckResourceAttr(accountResName, "role", "account_linode_creator"),
+					resource.TestCheckNoResourceAttr(accountResName, "entity_id"),
+					resource.TestCheckResourceAttr(volumeResName, "role", "volume_viewer"),
+					resource.TestCheckResourceAttr(volumeResName, "entity_type", "volume"),
+					resource.TestCheckResourceAttrSet(volumeResName, "entity_id"),
+				),
+			},
+			{
+				ResourceName:      accountResName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     username + ",account_linode_creator",
+			},
+			{
+				ResourceName:      volumeResName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[volumeResName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", volumeResName)
+					}
+
+					return fmt.Sprintf(
+						"%s,%s,%s,%s",
+						rs.Primary.Attributes["username"],
+						rs.Primary.Attributes["role"],
+						rs.Primary.Attributes["entity_type"],
+						rs.Primary.Attributes["entity_id"],
+					), nil
+				},
+			},
+		},
+	})
+}
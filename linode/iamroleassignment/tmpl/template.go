@@ -0,0 +1,19 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	VolumeLabel string
+	Region      string
+	Username    string
+	Email       string
+}
+
+func Basic(t testing.TB, data TemplateData) string {
+	return acceptance.ExecuteTemplate(t,
+		"iam_role_assignment_basic", data)
+}
@@ -37,6 +37,7 @@ type ResourceModel struct {
 	Vendor              types.String      `tfsdk:"vendor"`
 	Timeouts            timeouts.Value    `tfsdk:"timeouts"`
 	Tags                types.List        `tfsdk:"tags"`
+	TagsAll             types.Set         `tfsdk:"tags_all"`
 	TotalSize           types.Int64       `tfsdk:"total_size"`
 	ReplicaRegions      types.List        `tfsdk:"replica_regions"`
 	Replications        types.List        `tfsdk:"replications"`
@@ -83,6 +84,28 @@ var imageSharingSharedByObjectType = types.ObjectType{
 	},
 }
 
+// FlattenTags sets the tags_all attribute to the tags of the image, and the tags attribute
+// to the same tags excluding the default tags of the provider which aren't in priorTags.
+func (data *ResourceModel) FlattenTags(
+	image *linodego.Image,
+	priorTags types.List,
+	meta *helper.FrameworkProviderMeta,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	tags, newDiags := types.ListValue(
+		types.StringType,
+		helper.StringSliceToFrameworkValueSlice(helper.FrameworkExcludeDefaultTags(image.Tags, priorTags, meta)),
+	)
+	diags.Append(newDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	data.Tags = helper.KeepOrUpdateValue(priorTags, tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateStringSet(data.TagsAll, image.Tags, preserveKnown, diags)
+}
+
 func (data *ResourceModel) FlattenImage(
 	ctx context.Context,
 	image *linodego.Image,
@@ -219,6 +242,7 @@ func (data *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
 	data.Vendor = helper.KeepOrUpdateValue(data.Vendor, other.Vendor, preserveKnown)
 	data.Timeouts = helper.KeepOrUpdateValue(data.Timeouts, other.Timeouts, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.TotalSize = helper.KeepOrUpdateValue(data.TotalSize, other.TotalSize, preserveKnown)
 	data.ReplicaRegions = helper.KeepOrUpdateValue(data.ReplicaRegions, other.ReplicaRegions, preserveKnown)
 	data.Replications = helper.KeepOrUpdateValue(data.Replications, other.Replications, preserveKnown)
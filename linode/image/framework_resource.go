@@ -43,9 +43,34 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper.FrameworkPlanTagsAll(ctx, req, resp, plan.Tags, r.Meta)
+}
+
 func createResourceFromUpload(
-	ctx context.Context, plan *ResourceModel, client *linodego.Client, resp *resource.CreateResponse, timeoutSeconds int,
+	ctx context.Context,
+	plan *ResourceModel,
+	meta *helper.FrameworkProviderMeta,
+	resp *resource.CreateResponse,
+	timeoutSeconds int,
 ) *linodego.Image {
+	client := meta.Client
+
 	tflog.Debug(ctx, "Create linode_image from file uploading")
 
 	imageReader := openImageFile(plan.FilePath.ValueString(), &resp.Diagnostics)
@@ -66,10 +91,8 @@ func createResourceFromUpload(
 		CloudInit:   plan.CloudInit.ValueBool(),
 	}
 
-	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &createOpts.Tags, true)...)
-	if resp.Diagnostics.HasError() {
-		return nil
-	}
+	tags := helper.FrameworkTagsAll(plan.Tags, meta)
+	createOpts.Tags = &tags
 
 	tflog.Trace(ctx, "client.CreateImageUpload(...)", map[string]any{
 		"options": createOpts,
@@ -106,8 +129,14 @@ func createResourceFromUpload(
 }
 
 func createResourceFromLinode(
-	ctx context.Context, plan *ResourceModel, client *linodego.Client, resp *resource.CreateResponse, timeoutSeconds int,
+	ctx context.Context,
+	plan *ResourceModel,
+	meta *helper.FrameworkProviderMeta,
+	resp *resource.CreateResponse,
+	timeoutSeconds int,
 ) *linodego.Image {
+	client := meta.Client
+
 	tflog.Debug(ctx, "Create linode_image from a Linode instance")
 
 	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
@@ -136,10 +165,8 @@ func createResourceFromLinode(
 		CloudInit:   plan.CloudInit.ValueBool(),
 	}
 
-	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &createOpts.Tags, true)...)
-	if resp.Diagnostics.HasError() {
-		return nil
-	}
+	tags := helper.FrameworkTagsAll(plan.Tags, meta)
+	createOpts.Tags = &tags
 
 	tflog.Trace(ctx, "client.CreateImage(...)", map[string]any{
 		"options": createOpts,
@@ -206,11 +233,13 @@ func (r *Resource) Create(
 		return
 	}
 
+	priorTags := plan.Tags
+
 	var image *linodego.Image
 	if !plan.LinodeID.IsNull() && plan.FilePath.IsNull() {
-		image = createResourceFromLinode(ctx, &plan, client, resp, timeoutSeconds)
+		image = createResourceFromLinode(ctx, &plan, r.Meta, resp, timeoutSeconds)
 	} else {
-		image = createResourceFromUpload(ctx, &plan, client, resp, timeoutSeconds)
+		image = createResourceFromUpload(ctx, &plan, r.Meta, resp, timeoutSeconds)
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -235,6 +264,7 @@ func (r *Resource) Create(
 	}
 
 	plan.FlattenImage(ctx, image, true, &resp.Diagnostics)
+	plan.FlattenTags(image, priorTags, r.Meta, true, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -285,7 +315,10 @@ func (r *Resource) Read(
 		return
 	}
 
+	priorTags := state.Tags
+
 	state.FlattenImage(ctx, image, true, &resp.Diagnostics)
+	state.FlattenTags(image, priorTags, r.Meta, true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -326,11 +359,9 @@ func (r *Resource) Update(
 		shouldUpdate = true
 	}
 
-	if !state.Tags.Equal(plan.Tags) {
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &updateOpts.Tags, true)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	if !state.Tags.Equal(plan.Tags) || !state.TagsAll.Equal(plan.TagsAll) {
+		tags := helper.FrameworkTagsAll(plan.Tags, r.Meta)
+		updateOpts.Tags = &tags
 		shouldUpdate = true
 	}
 
@@ -345,6 +376,7 @@ func (r *Resource) Update(
 			return
 		}
 		plan.FlattenImage(ctx, image, true, &resp.Diagnostics)
+		plan.FlattenTags(image, plan.Tags, r.Meta, true, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
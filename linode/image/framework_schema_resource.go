@@ -247,6 +247,11 @@ var frameworkResourceSchema = schema.Schema{
 			Optional:    true,
 			ElementType: types.StringType,
 		},
+		"tags_all": schema.SetAttribute{
+			Description: "All tags applied to the image, including the default tags of the provider.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
 		"total_size": schema.Int64Attribute{
 			Description: "The total size of the image in all available regions.",
 			Computed:    true,
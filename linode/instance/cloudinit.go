@@ -0,0 +1,213 @@
+package instance
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/linode/linodego"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// maxUserDataLength is the maximum length of the base64-encoded user data
+	// accepted by the Linode Metadata service.
+	maxUserDataLength = 65535
+
+	imageCapabilityCloudInit = "cloud-init"
+
+	cloudConfigHeader      = "#cloud-config"
+	cloudConfigContentType = "text/cloud-config"
+)
+
+// userDataValidator validates that a string is base64-encoded user data
+// and, if it is cloud-config YAML or a MIME multipart, that it is well-formed.
+type userDataValidator struct{}
+
+func (v userDataValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf(
+		"value must be base64-encoded, at most %d characters long and contain valid cloud-config YAML",
+		maxUserDataLength,
+	)
+}
+
+func (v userDataValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v userDataValidator) ValidateString(
+	ctx context.Context, req validator.StringRequest, resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateUserData(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid User Data", err.Error())
+	}
+}
+
+// validateUserData validates the given base64-encoded user data. User data which is
+// neither cloud-config YAML nor a MIME multipart (e.g. shell scripts) is only checked
+// for its encoding and length.
+func validateUserData(encoded string) error {
+	if len(encoded) > maxUserDataLength {
+		return fmt.Errorf(
+			"user_data must be at most %d characters long once base64-encoded, got %d",
+			maxUserDataLength, len(encoded),
+		)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("user_data must be base64-encoded: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(decoded, []byte(cloudConfigHeader)):
+		return validateCloudConfig(decoded)
+	case isMultipartUserData(decoded):
+		return validateMultipartUserData(decoded)
+	}
+
+	return nil
+}
+
+// validateCloudConfig validates that the given cloud-config is a YAML mapping.
+func validateCloudConfig(data []byte) error {
+	var config map[string]any
+
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("invalid cloud-config YAML: %w", err)
+	}
+
+	return nil
+}
+
+// isMultipartUserData returns whether the given user data is a MIME multipart message.
+func isMultipartUserData(data []byte) bool {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+
+	return err == nil && strings.HasPrefix(mediaType, "multipart/")
+}
+
+// validateMultipartUserData validates the cloud-config parts of the given MIME multipart message.
+func validateMultipartUserData(data []byte) error {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid MIME multipart user data: %w", err)
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("invalid MIME multipart user data: %w", err)
+	}
+
+	if params["boundary"] == "" {
+		return fmt.Errorf("invalid MIME multipart user data: missing boundary")
+	}
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	for i := 0; ; i++ {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid MIME multipart user data: %w", err)
+		}
+
+		mediaType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err != nil {
+			return fmt.Errorf("invalid Content-Type of MIME part %d: %w", i, err)
+		}
+
+		if mediaType != cloudConfigContentType {
+			continue
+		}
+
+		var body io.Reader = part
+		if strings.EqualFold(part.Header.Get("Content-Transfer-Encoding"), "base64") {
+			body = base64.NewDecoder(base64.StdEncoding, part)
+		}
+
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return fmt.Errorf("failed to read MIME part %d: %w", i, err)
+		}
+
+		if err := validateCloudConfig(content); err != nil {
+			return fmt.Errorf("MIME part %d: %w", i, err)
+		}
+	}
+}
+
+// validateUserDataImages validates that the images the planned Instance is deployed from
+// support cloud-init if user data is configured.
+func validateUserDataImages(
+	ctx context.Context,
+	client *linodego.Client,
+	plan *ResourceModel,
+	diags *diag.Diagnostics,
+) {
+	metadata := blockModels[MetadataModel](ctx, plan.Metadata, diags)
+	if len(metadata) == 0 || metadata[0].UserData.ValueString() == "" {
+		return
+	}
+
+	validateImageCloudInit(ctx, client, path.Root("image"), plan.Image.ValueString(), diags)
+
+	for i, disk := range blockModels[DiskModel](ctx, plan.Disk, diags) {
+		validateImageCloudInit(
+			ctx, client, path.Root("disk").AtListIndex(i).AtName("image"), disk.Image.ValueString(), diags,
+		)
+	}
+}
+
+// validateImageCloudInit validates that the given image supports cloud-init,
+// which is required for user data to be processed on boot.
+func validateImageCloudInit(
+	ctx context.Context,
+	client *linodego.Client,
+	imagePath path.Path,
+	image string,
+	diags *diag.Diagnostics,
+) {
+	if image == "" {
+		return
+	}
+
+	imageData, err := client.GetImage(ctx, image)
+	if err != nil {
+		// The image is validated by the API on creation
+		return
+	}
+
+	if !slices.Contains(imageData.Capabilities, imageCapabilityCloudInit) {
+		diags.AddAttributeError(
+			imagePath,
+			"Image Does Not Support cloud-init",
+			fmt.Sprintf(
+				"Image %s does not have the %s capability, so the configured user_data would not be processed.",
+				imageData.ID, imageCapabilityCloudInit,
+			),
+		)
+	}
+}
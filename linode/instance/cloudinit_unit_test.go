@@ -0,0 +1,85 @@
+//go:build unit
+
+package instance
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodeUserData(data string) string {
+	return base64.StdEncoding.EncodeToString([]byte(data))
+}
+
+func TestValidateUserData(t *testing.T) {
+	multipartUserData := func(cloudConfig string) string {
+		return "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\n" +
+			"MIME-Version: 1.0\r\n\r\n" +
+			"--MIMEBOUNDARY\r\n" +
+			"Content-Type: text/x-shellscript\r\n\r\n" +
+			"#!/bin/bash\necho {{ not yaml\r\n" +
+			"--MIMEBOUNDARY\r\n" +
+			"Content-Type: text/cloud-config\r\n\r\n" +
+			cloudConfig + "\r\n" +
+			"--MIMEBOUNDARY--\r\n"
+	}
+
+	testCases := []struct {
+		name      string
+		userData  string
+		wantError string
+	}{
+		{
+			name:     "cloud-config",
+			userData: encodeUserData("#cloud-config\npackages:\n  - nginx\n"),
+		},
+		{
+			name:      "malformed cloud-config",
+			userData:  encodeUserData("#cloud-config\npackages:\n  - nginx\n bad: [\n"),
+			wantError: "invalid cloud-config YAML",
+		},
+		{
+			name:      "cloud-config not a mapping",
+			userData:  encodeUserData("#cloud-config\n- nginx\n"),
+			wantError: "invalid cloud-config YAML",
+		},
+		{
+			name:     "shell script",
+			userData: encodeUserData("#!/bin/bash\necho {{ not yaml\n"),
+		},
+		{
+			name:     "multipart",
+			userData: encodeUserData(multipartUserData("#cloud-config\npackages:\n  - nginx")),
+		},
+		{
+			name:      "malformed multipart cloud-config",
+			userData:  encodeUserData(multipartUserData("#cloud-config\npackages: [nginx")),
+			wantError: "MIME part 1: invalid cloud-config YAML",
+		},
+		{
+			name:      "not base64",
+			userData:  "#cloud-config",
+			wantError: "must be base64-encoded",
+		},
+		{
+			name:      "too long",
+			userData:  encodeUserData("#cloud-config\n# " + strings.Repeat("a", maxUserDataLength)),
+			wantError: "must be at most 65535 characters long",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateUserData(testCase.userData)
+			if testCase.wantError == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, testCase.wantError)
+		})
+	}
+}
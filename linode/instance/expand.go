@@ -9,16 +9,14 @@ import (
 // expandInstanceConfigDeviceMap converts a terraform linode_instance config.*.devices map to a InstanceConfigDeviceMap
 // for the Linode API.
 func expandInstanceConfigDeviceMap(
-	m map[string]any, diskIDLabelMap map[string]int,
+	m map[string][]DeviceModel, diskIDLabelMap map[string]int,
 ) (deviceMap *linodego.InstanceConfigDeviceMap, err error) {
 	if len(m) == 0 {
 		return nil, nil
 	}
 	deviceMap = &linodego.InstanceConfigDeviceMap{}
-	for k, rdev := range m {
-		devSlots := rdev.([]any)
-		for _, rrdev := range devSlots {
-			dev := rrdev.(map[string]any)
+	for k, devSlots := range m {
+		for _, dev := range devSlots {
 			tDevice := new(linodego.InstanceConfigDevice)
 			if err := assignConfigDevice(tDevice, dev, diskIDLabelMap); err != nil {
 				return nil, err
@@ -35,17 +33,27 @@ func expandInstanceConfigDeviceMap(
 	return deviceMap, nil
 }
 
-func expandInstanceConfigDevice(m map[string]any) *linodego.InstanceConfigDevice {
+func expandInstanceConfigDevice(m DeviceModel) *linodego.InstanceConfigDevice {
 	var dev *linodego.InstanceConfigDevice
-	// be careful of `disk_label string` in m
-	if diskID, ok := m["disk_id"]; ok && diskID.(int) > 0 {
+	if diskID := m.DiskID.ValueInt64(); diskID > 0 {
 		dev = &linodego.InstanceConfigDevice{
-			DiskID: diskID.(int),
+			DiskID: int(diskID),
 		}
-	} else if volumeID, ok := m["volume_id"]; ok && volumeID.(int) > 0 {
+	} else if volumeID := m.VolumeID.ValueInt64(); volumeID > 0 {
 		dev = &linodego.InstanceConfigDevice{
-			VolumeID: m["volume_id"].(int),
+			VolumeID: int(volumeID),
 		}
 	}
 	return dev
 }
+
+// expandInstanceAlerts converts a terraform linode_instance alerts block to an InstanceAlert for the Linode API.
+func expandInstanceAlerts(m AlertsModel) *linodego.InstanceAlert {
+	return &linodego.InstanceAlert{
+		CPU:           int(m.CPU.ValueInt64()),
+		IO:            int(m.IO.ValueInt64()),
+		NetworkIn:     int(m.NetworkIn.ValueInt64()),
+		NetworkOut:    int(m.NetworkOut.ValueInt64()),
+		TransferQuota: int(m.TransferQuota.ValueInt64()),
+	}
+}
@@ -5,23 +5,14 @@ package instance
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 )
 
 func TestExpandInstanceConfigDeviceMap(t *testing.T) {
-	deviceMapInput := map[string]interface{}{
-		"sda": []interface{}{
-			map[string]interface{}{
-				"disk_id":   124458,
-				"volume_id": nil,
-			},
-		},
-		"sdb": []interface{}{
-			map[string]interface{}{
-				"disk_id":   124459,
-				"volume_id": nil,
-			},
-		},
+	deviceMapInput := map[string][]DeviceModel{
+		"sda": {{DiskID: types.Int64Value(124458)}},
+		"sdb": {{DiskID: types.Int64Value(124459)}},
 	}
 
 	diskIDLabelMap := map[string]int{
@@ -59,13 +50,13 @@ func TestExpandInstanceConfigDeviceMap(t *testing.T) {
 func TestExpandInstanceConfigDevice(t *testing.T) {
 	tests := []struct {
 		name string
-		m    map[string]interface{}
+		m    DeviceModel
 		want *linodego.InstanceConfigDevice
 	}{
 		{
 			name: "Valid DiskID",
-			m: map[string]interface{}{
-				"disk_id": 123,
+			m: DeviceModel{
+				DiskID: types.Int64Value(123),
 			},
 			want: &linodego.InstanceConfigDevice{
 				DiskID: 123,
@@ -73,8 +64,8 @@ func TestExpandInstanceConfigDevice(t *testing.T) {
 		},
 		{
 			name: "Valid VolumeID",
-			m: map[string]interface{}{
-				"volume_id": 456,
+			m: DeviceModel{
+				VolumeID: types.Int64Value(456),
 			},
 			want: &linodego.InstanceConfigDevice{
 				VolumeID: 456,
@@ -82,15 +73,15 @@ func TestExpandInstanceConfigDevice(t *testing.T) {
 		},
 		{
 			name: "Invalid IDs",
-			m: map[string]interface{}{
-				"disk_id":   0,
-				"volume_id": -1,
+			m: DeviceModel{
+				DiskID:   types.Int64Value(0),
+				VolumeID: types.Int64Value(-1),
 			},
 			want: nil,
 		},
 		{
 			name: "No IDs",
-			m:    map[string]interface{}{},
+			m:    DeviceModel{},
 			want: nil,
 		},
 	}
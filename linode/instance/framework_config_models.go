@@ -0,0 +1,552 @@
+package instance
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/customtypes"
+)
+
+// ConfigModel describes an object of the config block of the resource.
+type ConfigModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Label       types.String `tfsdk:"label"`
+	Kernel      types.String `tfsdk:"kernel"`
+	RunLevel    types.String `tfsdk:"run_level"`
+	VirtMode    types.String `tfsdk:"virt_mode"`
+	RootDevice  types.String `tfsdk:"root_device"`
+	Comments    types.String `tfsdk:"comments"`
+	MemoryLimit types.Int64  `tfsdk:"memory_limit"`
+	Helpers     types.List   `tfsdk:"helpers"`
+	Devices     types.List   `tfsdk:"devices"`
+	Interface   types.List   `tfsdk:"interface"`
+}
+
+// ConfigHelpersModel describes the helpers block of a config.
+type ConfigHelpersModel struct {
+	UpdateDBDisabled  types.Bool `tfsdk:"updatedb_disabled"`
+	Distro            types.Bool `tfsdk:"distro"`
+	ModulesDep        types.Bool `tfsdk:"modules_dep"`
+	Network           types.Bool `tfsdk:"network"`
+	DevTmpFsAutomount types.Bool `tfsdk:"devtmpfs_automount"`
+}
+
+// DeviceModel describes the disk or volume assigned to a device slot of a config.
+type DeviceModel struct {
+	DiskLabel types.String `tfsdk:"disk_label"`
+	DiskID    types.Int64  `tfsdk:"disk_id"`
+	VolumeID  types.Int64  `tfsdk:"volume_id"`
+}
+
+// InterfaceModel describes a configuration profile interface of the resource.
+type InterfaceModel struct {
+	Purpose     types.String `tfsdk:"purpose"`
+	IPAMAddress types.String `tfsdk:"ipam_address"`
+	Label       types.String `tfsdk:"label"`
+	ID          types.Int64  `tfsdk:"id"`
+	SubnetID    types.Int64  `tfsdk:"subnet_id"`
+	VPCID       types.Int64  `tfsdk:"vpc_id"`
+	Primary     types.Bool   `tfsdk:"primary"`
+	Active      types.Bool   `tfsdk:"active"`
+	IPRanges    types.List   `tfsdk:"ip_ranges"`
+	IPv4        types.List   `tfsdk:"ipv4"`
+	IPv6        types.List   `tfsdk:"ipv6"`
+}
+
+// InterfaceIPv4Model describes the IPv4 configuration of a VPC interface.
+type InterfaceIPv4Model struct {
+	VPC     types.String `tfsdk:"vpc"`
+	NAT1To1 types.String `tfsdk:"nat_1_1"`
+}
+
+// InterfaceIPv6Model describes the IPv6 configuration of a VPC interface.
+type InterfaceIPv6Model struct {
+	IsPublic types.Bool `tfsdk:"is_public"`
+	SLAAC    types.List `tfsdk:"slaac"`
+	Range    types.List `tfsdk:"range"`
+}
+
+// InterfaceSLAACModel describes a SLAAC prefix of a VPC interface.
+type InterfaceSLAACModel struct {
+	Range         customtypes.LinodeAutoAllocRangeValue `tfsdk:"range"`
+	AssignedRange types.String                          `tfsdk:"assigned_range"`
+	Address       types.String                          `tfsdk:"address"`
+}
+
+// InterfaceRangeModel describes an IPv6 range of a VPC interface.
+type InterfaceRangeModel struct {
+	Range         customtypes.LinodeAutoAllocRangeValue `tfsdk:"range"`
+	AssignedRange types.String                          `tfsdk:"assigned_range"`
+}
+
+// FlattenConfig sets the attributes of the model from the given config, resolving
+// the labels of its disks from diskLabels.
+func (data *ConfigModel) FlattenConfig(
+	ctx context.Context,
+	config linodego.InstanceConfig,
+	diskLabels map[int]string,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateInt64(data.ID, int64(config.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, config.Label, preserveKnown)
+	data.Kernel = helper.KeepOrUpdateString(data.Kernel, config.Kernel, preserveKnown)
+	data.RunLevel = helper.KeepOrUpdateString(data.RunLevel, config.RunLevel, preserveKnown)
+	data.VirtMode = helper.KeepOrUpdateString(data.VirtMode, config.VirtMode, preserveKnown)
+	data.RootDevice = helper.KeepOrUpdateString(data.RootDevice, config.RootDevice, preserveKnown)
+	data.Comments = keepOrUpdateOptional(data.Comments, types.StringValue(config.Comments), preserveKnown)
+	data.MemoryLimit = keepOrUpdateOptional(data.MemoryLimit, types.Int64Value(int64(config.MemoryLimit)), preserveKnown)
+
+	var helpers []ConfigHelpersModel
+
+	if config.Helpers != nil {
+		var helpersModel ConfigHelpersModel
+
+		priorHelpers := blockModels[ConfigHelpersModel](ctx, data.Helpers, diags)
+		if len(priorHelpers) > 0 {
+			helpersModel = priorHelpers[0]
+		}
+
+		helpersModel.FlattenHelpers(*config.Helpers, preserveKnown && len(priorHelpers) > 0)
+		helpers = append(helpers, helpersModel)
+	}
+
+	data.Helpers = attributeList(ctx, data.Helpers, resourceConfigHelpersNestedObject.Type(), helpers, diags)
+	data.Devices = data.flattenDevices(ctx, config.Devices, diskLabels, preserveKnown, diags)
+
+	data.Interface = attributeList(
+		ctx,
+		data.Interface,
+		resourceConfigInterfaceNestedObject.Type(),
+		flattenByIndex(
+			blockModels[InterfaceModel](ctx, data.Interface, diags),
+			config.Interfaces,
+			func(iface *InterfaceModel, value linodego.InstanceConfigInterface, preserveKnown bool) {
+				iface.FlattenInterface(ctx, value, preserveKnown, diags)
+			},
+			preserveKnown,
+		),
+		diags,
+	)
+}
+
+// flattenDevices returns the devices block of the config with an object in
+// every slot that has a disk or a volume assigned.
+func (data *ConfigModel) flattenDevices(
+	ctx context.Context,
+	devices *linodego.InstanceConfigDeviceMap,
+	diskLabels map[int]string,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) types.List {
+	var deviceMap linodego.InstanceConfigDeviceMap
+	if devices != nil {
+		deviceMap = *devices
+	}
+
+	priorSlots := make(map[string]attr.Value)
+	if priorDevices := blockModels[types.Object](ctx, data.Devices, diags); len(priorDevices) > 0 {
+		priorSlots = priorDevices[0].Attributes()
+	}
+
+	slots := make(map[string]attr.Value, len(resourceConfigDevicesNestedObject.Attributes))
+
+	for i, key := range helper.GetConfigDeviceKeys() {
+		priorSlot, ok := priorSlots[key].(types.List)
+		if !ok {
+			priorSlot = types.ListNull(resourceDeviceNestedObject.Type())
+		}
+
+		priorDevices := blockModels[DeviceModel](ctx, priorSlot, diags)
+
+		var slotDevices []DeviceModel
+
+		if device := configDeviceSlice(deviceMap)[i]; device != nil && !emptyInstanceConfigDevice(*device) {
+			var deviceModel DeviceModel
+			if len(priorDevices) > 0 {
+				deviceModel = priorDevices[0]
+			}
+
+			deviceModel.FlattenDevice(*device, diskLabels, preserveKnown && len(priorDevices) > 0)
+			slotDevices = append(slotDevices, deviceModel)
+		}
+
+		slots[key] = attributeList(ctx, priorSlot, resourceDeviceNestedObject.Type(), slotDevices, diags)
+	}
+
+	devicesObject, newDiags := types.ObjectValue(
+		resourceConfigDevicesNestedObject.Type().(types.ObjectType).AttrTypes, slots,
+	)
+	diags.Append(newDiags...)
+
+	return attributeList(ctx, data.Devices, resourceConfigDevicesNestedObject.Type(), []types.Object{devicesObject}, diags)
+}
+
+// GetCreateOptions returns the options to create the planned config, resolving the labels
+// of its disks from diskIDLabelMap.
+func (plan *ConfigModel) GetCreateOptions(
+	ctx context.Context, diskIDLabelMap map[string]int, diags *diag.Diagnostics,
+) (opts linodego.InstanceConfigCreateOptions) {
+	opts.Label = plan.Label.ValueString()
+	opts.Kernel = plan.Kernel.ValueString()
+	opts.Comments = plan.Comments.ValueString()
+	opts.VirtMode = plan.VirtMode.ValueString()
+	opts.RunLevel = plan.RunLevel.ValueString()
+	opts.MemoryLimit = helper.FrameworkSafeInt64ToInt(plan.MemoryLimit.ValueInt64(), diags)
+	opts.Helpers = plan.getHelpers(ctx, diags)
+	opts.Interfaces = plan.getInterfaces(ctx, diags)
+
+	if rootDevice := plan.RootDevice.ValueString(); rootDevice != "" {
+		opts.RootDevice = &rootDevice
+	}
+
+	if devices := plan.getDevices(ctx, diskIDLabelMap, diags); devices != nil {
+		opts.Devices = *devices
+	}
+
+	return opts
+}
+
+// GetUpdateOptions returns the options to update the given existing config to match the plan,
+// leaving the interfaces and devices unchanged if they already match.
+func (plan *ConfigModel) GetUpdateOptions(
+	ctx context.Context,
+	existing linodego.InstanceConfig,
+	diskIDLabelMap map[string]int,
+	diags *diag.Diagnostics,
+) (opts linodego.InstanceConfigUpdateOptions) {
+	opts = existing.GetUpdateOptions()
+	opts.Kernel = plan.Kernel.ValueString()
+	opts.RunLevel = plan.RunLevel.ValueString()
+	opts.VirtMode = plan.VirtMode.ValueString()
+	opts.RootDevice = plan.RootDevice.ValueString()
+	opts.Comments = plan.Comments.ValueString()
+	opts.MemoryLimit = helper.FrameworkSafeInt64ToInt(plan.MemoryLimit.ValueInt64(), diags)
+
+	if helpers := plan.getHelpers(ctx, diags); helpers != nil {
+		opts.Helpers = helpers
+	}
+
+	opts.Interfaces = plan.getInterfaces(ctx, diags)
+	if reflect.DeepEqual(opts.Interfaces, existing.GetUpdateOptions().Interfaces) {
+		opts.Interfaces = nil
+	}
+
+	opts.Devices = plan.getDevices(ctx, diskIDLabelMap, diags)
+	if opts.Devices != nil && emptyConfigDeviceMap(*opts.Devices) {
+		opts.Devices = nil
+	}
+
+	return opts
+}
+
+func (plan *ConfigModel) getHelpers(ctx context.Context, diags *diag.Diagnostics) *linodego.InstanceConfigHelpers {
+	helpers := blockModels[ConfigHelpersModel](ctx, plan.Helpers, diags)
+	if len(helpers) == 0 {
+		return nil
+	}
+
+	return &linodego.InstanceConfigHelpers{
+		UpdateDBDisabled:  helpers[0].UpdateDBDisabled.ValueBool(),
+		Distro:            helpers[0].Distro.ValueBool(),
+		ModulesDep:        helpers[0].ModulesDep.ValueBool(),
+		Network:           helpers[0].Network.ValueBool(),
+		DevTmpFsAutomount: helpers[0].DevTmpFsAutomount.ValueBool(),
+	}
+}
+
+func (plan *ConfigModel) getInterfaces(
+	ctx context.Context, diags *diag.Diagnostics,
+) []linodego.InstanceConfigInterfaceCreateOptions {
+	return expandInterfaceModels(ctx, plan.Interface, diags)
+}
+
+// expandInterfaceModels returns the options to create each interface of the given block.
+func expandInterfaceModels(
+	ctx context.Context, list types.List, diags *diag.Diagnostics,
+) []linodego.InstanceConfigInterfaceCreateOptions {
+	interfaces := blockModels[InterfaceModel](ctx, list, diags)
+
+	result := make([]linodego.InstanceConfigInterfaceCreateOptions, len(interfaces))
+	for i, iface := range interfaces {
+		result[i] = iface.GetCreateOptions(ctx, diags)
+	}
+
+	return result
+}
+
+func (plan *ConfigModel) getDevices(
+	ctx context.Context, diskIDLabelMap map[string]int, diags *diag.Diagnostics,
+) *linodego.InstanceConfigDeviceMap {
+	devices := blockModels[types.Object](ctx, plan.Devices, diags)
+	if len(devices) == 0 {
+		return nil
+	}
+
+	slots := make(map[string][]DeviceModel, len(devices[0].Attributes()))
+	for key, value := range devices[0].Attributes() {
+		if slot, ok := value.(types.List); ok {
+			slots[key] = blockModels[DeviceModel](ctx, slot, diags)
+		}
+	}
+
+	result, err := expandInstanceConfigDeviceMap(slots, diskIDLabelMap)
+	if err != nil {
+		diags.AddError("Failed to Expand Config Devices", err.Error())
+		return nil
+	}
+
+	return result
+}
+
+// FlattenHelpers sets the attributes of the model from the given config helpers.
+func (data *ConfigHelpersModel) FlattenHelpers(helpers linodego.InstanceConfigHelpers, preserveKnown bool) {
+	data.UpdateDBDisabled = helper.KeepOrUpdateBool(data.UpdateDBDisabled, helpers.UpdateDBDisabled, preserveKnown)
+	data.Distro = helper.KeepOrUpdateBool(data.Distro, helpers.Distro, preserveKnown)
+	data.ModulesDep = helper.KeepOrUpdateBool(data.ModulesDep, helpers.ModulesDep, preserveKnown)
+	data.Network = helper.KeepOrUpdateBool(data.Network, helpers.Network, preserveKnown)
+	data.DevTmpFsAutomount = helper.KeepOrUpdateBool(data.DevTmpFsAutomount, helpers.DevTmpFsAutomount, preserveKnown)
+}
+
+// FlattenDevice sets the attributes of the model from the disk or volume assigned to a device slot.
+func (data *DeviceModel) FlattenDevice(
+	device linodego.InstanceConfigDevice, diskLabels map[int]string, preserveKnown bool,
+) {
+	if device.DiskID > 0 {
+		data.DiskID = keepOrUpdateOptional(data.DiskID, types.Int64Value(int64(device.DiskID)), preserveKnown)
+		data.VolumeID = types.Int64Null()
+
+		if label, ok := diskLabels[device.DiskID]; ok {
+			data.DiskLabel = keepOrUpdateOptional(data.DiskLabel, types.StringValue(label), preserveKnown)
+		}
+	} else {
+		data.VolumeID = keepOrUpdateOptional(data.VolumeID, types.Int64Value(int64(device.VolumeID)), preserveKnown)
+		data.DiskID = types.Int64Null()
+		data.DiskLabel = types.StringNull()
+	}
+
+	if data.DiskLabel.IsUnknown() {
+		data.DiskLabel = types.StringNull()
+	}
+}
+
+// FlattenInterface sets the attributes of the model from the given config interface.
+func (data *InterfaceModel) FlattenInterface(
+	ctx context.Context,
+	iface linodego.InstanceConfigInterface,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.Purpose = helper.KeepOrUpdateString(data.Purpose, string(iface.Purpose), preserveKnown)
+	data.IPAMAddress = keepOrUpdateOptional(data.IPAMAddress, types.StringValue(iface.IPAMAddress), preserveKnown)
+	data.Label = keepOrUpdateOptional(data.Label, types.StringValue(iface.Label), preserveKnown)
+	data.ID = helper.KeepOrUpdateInt64(data.ID, int64(iface.ID), preserveKnown)
+	data.VPCID = helper.KeepOrUpdateIntPointer(data.VPCID, iface.VPCID, preserveKnown)
+	data.Primary = helper.KeepOrUpdateBool(data.Primary, iface.Primary, preserveKnown)
+	data.Active = helper.KeepOrUpdateBool(data.Active, iface.Active, preserveKnown)
+
+	subnetID := types.Int64Null()
+	if iface.SubnetID != nil {
+		subnetID = types.Int64Value(int64(*iface.SubnetID))
+	}
+
+	data.SubnetID = keepOrUpdateOptional(data.SubnetID, subnetID, preserveKnown)
+
+	ipRanges := iface.IPRanges
+	if ipRanges == nil {
+		ipRanges = make([]string, 0)
+	}
+
+	ipRangesList, newDiags := types.ListValueFrom(ctx, types.StringType, ipRanges)
+	diags.Append(newDiags...)
+	data.IPRanges = keepOrUpdateOptional(data.IPRanges, ipRangesList, preserveKnown)
+
+	var ipv4 []linodego.VPCIPv4
+	if iface.IPv4 != nil {
+		ipv4 = append(ipv4, *iface.IPv4)
+	}
+
+	data.IPv4 = attributeList(
+		ctx,
+		data.IPv4,
+		resourceInterfaceIPv4NestedObject.Type(),
+		flattenByIndex(
+			blockModels[InterfaceIPv4Model](ctx, data.IPv4, diags),
+			ipv4,
+			(*InterfaceIPv4Model).FlattenIPv4,
+			preserveKnown,
+		),
+		diags,
+	)
+
+	var ipv6 []linodego.InstanceConfigInterfaceIPv6
+	if iface.IPv6 != nil {
+		ipv6 = append(ipv6, *iface.IPv6)
+	}
+
+	data.IPv6 = attributeList(
+		ctx,
+		data.IPv6,
+		resourceInterfaceIPv6NestedObject.Type(),
+		flattenByIndex(
+			blockModels[InterfaceIPv6Model](ctx, data.IPv6, diags),
+			ipv6,
+			func(model *InterfaceIPv6Model, value linodego.InstanceConfigInterfaceIPv6, preserveKnown bool) {
+				model.FlattenIPv6(ctx, value, preserveKnown, diags)
+			},
+			preserveKnown,
+		),
+		diags,
+	)
+}
+
+// GetCreateOptions returns the options to create the planned interface.
+func (plan *InterfaceModel) GetCreateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) (opts linodego.InstanceConfigInterfaceCreateOptions) {
+	opts.Purpose = linodego.ConfigInterfacePurpose(plan.Purpose.ValueString())
+	opts.Primary = plan.Primary.ValueBool()
+
+	switch opts.Purpose {
+	case linodego.InterfacePurposeVLAN:
+		opts.IPAMAddress = plan.IPAMAddress.ValueString()
+		opts.Label = plan.Label.ValueString()
+	case linodego.InterfacePurposeVPC:
+		if subnetID := helper.FrameworkSafeInt64ToInt(plan.SubnetID.ValueInt64(), diags); subnetID != 0 {
+			opts.SubnetID = &subnetID
+		}
+
+		if ipv4 := blockModels[InterfaceIPv4Model](ctx, plan.IPv4, diags); len(ipv4) > 0 {
+			opts.IPv4 = ipv4[0].GetCreateOptions()
+		}
+
+		if ipv6 := blockModels[InterfaceIPv6Model](ctx, plan.IPv6, diags); len(ipv6) > 0 {
+			opts.IPv6 = ipv6[0].GetCreateOptions(ctx, diags)
+		}
+
+		// IPRanges is kept nil rather than empty when there are no ranges
+		if len(plan.IPRanges.Elements()) > 0 {
+			diags.Append(plan.IPRanges.ElementsAs(ctx, &opts.IPRanges, false)...)
+		}
+	}
+
+	return opts
+}
+
+// FlattenIPv4 sets the attributes of the model from the given IPv4 configuration.
+func (data *InterfaceIPv4Model) FlattenIPv4(ipv4 linodego.VPCIPv4, preserveKnown bool) {
+	data.VPC = helper.KeepOrUpdateString(data.VPC, ipv4.VPC, preserveKnown)
+
+	// The API returns the address assigned for a configured 1:1 NAT of "any"
+	if !data.NAT1To1.Equal(types.StringValue("any")) {
+		data.NAT1To1 = helper.KeepOrUpdateStringPointer(data.NAT1To1, ipv4.NAT1To1, preserveKnown)
+	}
+}
+
+// GetCreateOptions returns the planned IPv4 configuration, or nil if it is empty.
+func (plan *InterfaceIPv4Model) GetCreateOptions() *linodego.VPCIPv4 {
+	vpcAddress, nat1To1 := plan.VPC.ValueString(), plan.NAT1To1.ValueString()
+	if vpcAddress == "" && nat1To1 == "" {
+		return nil
+	}
+
+	result := &linodego.VPCIPv4{
+		VPC: vpcAddress,
+	}
+
+	if nat1To1 != "" {
+		result.NAT1To1 = &nat1To1
+	}
+
+	return result
+}
+
+// FlattenIPv6 sets the attributes of the model from the given IPv6 configuration.
+func (data *InterfaceIPv6Model) FlattenIPv6(
+	ctx context.Context,
+	ipv6 linodego.InstanceConfigInterfaceIPv6,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.IsPublic = helper.KeepOrUpdateBoolPointer(data.IsPublic, ipv6.IsPublic, preserveKnown)
+
+	data.SLAAC = attributeList(
+		ctx,
+		data.SLAAC,
+		resourceInterfaceSLAACNestedObject.Type(),
+		flattenByIndex(
+			blockModels[InterfaceSLAACModel](ctx, data.SLAAC, diags),
+			ipv6.SLAAC,
+			(*InterfaceSLAACModel).FlattenSLAAC,
+			preserveKnown,
+		),
+		diags,
+	)
+
+	data.Range = attributeList(
+		ctx,
+		data.Range,
+		resourceInterfaceRangeNestedObject.Type(),
+		flattenByIndex(
+			blockModels[InterfaceRangeModel](ctx, data.Range, diags),
+			ipv6.Ranges,
+			(*InterfaceRangeModel).FlattenRange,
+			preserveKnown,
+		),
+		diags,
+	)
+}
+
+// GetCreateOptions returns the planned IPv6 configuration.
+func (plan *InterfaceIPv6Model) GetCreateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) *linodego.InstanceConfigInterfaceCreateOptionsIPv6 {
+	result := &linodego.InstanceConfigInterfaceCreateOptionsIPv6{}
+
+	for _, slaac := range blockModels[InterfaceSLAACModel](ctx, plan.SLAAC, diags) {
+		result.SLAAC = append(result.SLAAC, linodego.InstanceConfigInterfaceCreateOptionsIPv6SLAAC{
+			Range: slaac.Range.ValueString(),
+		})
+	}
+
+	for _, ipv6Range := range blockModels[InterfaceRangeModel](ctx, plan.Range, diags) {
+		rangeOpts := linodego.InstanceConfigInterfaceCreateOptionsIPv6Range{}
+		if !ipv6Range.Range.IsNull() && !ipv6Range.Range.IsUnknown() {
+			rangeOpts.Range = linodego.Pointer(ipv6Range.Range.ValueString())
+		}
+
+		result.Ranges = append(result.Ranges, rangeOpts)
+	}
+
+	if !plan.IsPublic.IsUnknown() {
+		result.IsPublic = plan.IsPublic.ValueBoolPointer()
+	}
+
+	return result
+}
+
+// FlattenSLAAC sets the attributes of the model from the given SLAAC prefix.
+func (data *InterfaceSLAACModel) FlattenSLAAC(slaac linodego.InstanceConfigInterfaceIPv6SLAAC, preserveKnown bool) {
+	data.Range = helper.KeepOrUpdateValue(
+		data.Range,
+		customtypes.LinodeAutoAllocRangeValue{StringValue: types.StringValue(slaac.Range)},
+		preserveKnown,
+	)
+	data.AssignedRange = helper.KeepOrUpdateString(data.AssignedRange, slaac.Range, preserveKnown)
+	data.Address = helper.KeepOrUpdateString(data.Address, slaac.Address, preserveKnown)
+}
+
+// FlattenRange sets the attributes of the model from the given IPv6 range.
+func (data *InterfaceRangeModel) FlattenRange(ipv6Range linodego.InstanceConfigInterfaceIPv6Range, preserveKnown bool) {
+	data.Range = helper.KeepOrUpdateValue(
+		data.Range,
+		customtypes.LinodeAutoAllocRangeValue{StringValue: types.StringValue(ipv6Range.Range)},
+		preserveKnown,
+	)
+	data.AssignedRange = helper.KeepOrUpdateString(data.AssignedRange, ipv6Range.Range, preserveKnown)
+}
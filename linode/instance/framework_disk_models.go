@@ -0,0 +1,89 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+// DiskModel describes an object of the disk block of the resource.
+type DiskModel struct {
+	Label           types.String `tfsdk:"label"`
+	Size            types.Int64  `tfsdk:"size"`
+	ID              types.Int64  `tfsdk:"id"`
+	Filesystem      types.String `tfsdk:"filesystem"`
+	ReadOnly        types.Bool   `tfsdk:"read_only"`
+	Image           types.String `tfsdk:"image"`
+	AuthorizedKeys  types.List   `tfsdk:"authorized_keys"`
+	AuthorizedUsers types.List   `tfsdk:"authorized_users"`
+	StackScriptID   types.Int64  `tfsdk:"stackscript_id"`
+	StackScriptData types.Map    `tfsdk:"stackscript_data"`
+	RootPass        types.String `tfsdk:"root_pass"`
+}
+
+// FlattenDisk sets the attributes of the model from the given disk. The attributes
+// the API doesn't return for existing disks keep their prior values.
+func (data *DiskModel) FlattenDisk(disk linodego.InstanceDisk, preserveKnown bool) {
+	data.Label = helper.KeepOrUpdateString(data.Label, disk.Label, preserveKnown)
+	data.Size = helper.KeepOrUpdateInt64(data.Size, int64(disk.Size), preserveKnown)
+	data.ID = helper.KeepOrUpdateInt64(data.ID, int64(disk.ID), preserveKnown)
+	data.Filesystem = helper.KeepOrUpdateString(data.Filesystem, string(disk.Filesystem), preserveKnown)
+
+	if data.ReadOnly.IsUnknown() {
+		data.ReadOnly = types.BoolNull()
+	}
+
+	if data.Image.IsUnknown() {
+		data.Image = types.StringNull()
+	}
+
+	if data.StackScriptID.IsUnknown() {
+		data.StackScriptID = types.Int64Null()
+	}
+
+	if data.RootPass.IsUnknown() {
+		data.RootPass = types.StringNull()
+	}
+
+	if data.AuthorizedKeys.IsNull() || data.AuthorizedKeys.IsUnknown() {
+		data.AuthorizedKeys = types.ListNull(types.StringType)
+	}
+
+	if data.AuthorizedUsers.IsNull() || data.AuthorizedUsers.IsUnknown() {
+		data.AuthorizedUsers = types.ListNull(types.StringType)
+	}
+
+	if data.StackScriptData.IsNull() || data.StackScriptData.IsUnknown() {
+		data.StackScriptData = types.MapNull(types.StringType)
+	}
+}
+
+// GetCreateOptions returns the options to create the planned disk.
+func (plan *DiskModel) GetCreateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) (opts linodego.InstanceDiskCreateOptions) {
+	opts.Label = plan.Label.ValueString()
+	opts.Filesystem = plan.Filesystem.ValueString()
+	opts.Size = helper.FrameworkSafeInt64ToInt(plan.Size.ValueInt64(), diags)
+
+	// The remaining fields are only accepted when deploying an image
+	opts.Image = plan.Image.ValueString()
+	if opts.Image == "" {
+		return opts
+	}
+
+	opts.RootPass = plan.RootPass.ValueString()
+	opts.StackscriptID = helper.FrameworkSafeInt64ToInt(plan.StackScriptID.ValueInt64(), diags)
+
+	diags.Append(plan.AuthorizedKeys.ElementsAs(ctx, &opts.AuthorizedKeys, false)...)
+	diags.Append(plan.AuthorizedUsers.ElementsAs(ctx, &opts.AuthorizedUsers, false)...)
+
+	if !plan.StackScriptData.IsUnknown() {
+		diags.Append(plan.StackScriptData.ElementsAs(ctx, &opts.StackscriptData, false)...)
+	}
+
+	return opts
+}
@@ -0,0 +1,894 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+// ResourceModel describes the Terraform resource data model to match the
+// resource schema.
+type ResourceModel struct {
+	ID                              types.String   `tfsdk:"id"`
+	Image                           types.String   `tfsdk:"image"`
+	BackupID                        types.Int64    `tfsdk:"backup_id"`
+	StackScriptID                   types.Int64    `tfsdk:"stackscript_id"`
+	StackScriptData                 types.Map      `tfsdk:"stackscript_data"`
+	StackScriptDataWO               types.Map      `tfsdk:"stackscript_data_wo"`
+	StackScriptDataWOVersion        types.Int64    `tfsdk:"stackscript_data_wo_version"`
+	Label                           types.String   `tfsdk:"label"`
+	Group                           types.String   `tfsdk:"group"`
+	Tags                            types.Set      `tfsdk:"tags"`
+	TagsAll                         types.Set      `tfsdk:"tags_all"`
+	Capabilities                    types.Set      `tfsdk:"capabilities"`
+	Locks                           types.Set      `tfsdk:"locks"`
+	BootConfigLabel                 types.String   `tfsdk:"boot_config_label"`
+	Region                          types.String   `tfsdk:"region"`
+	MaintenancePolicy               types.String   `tfsdk:"maintenance_policy"`
+	Type                            types.String   `tfsdk:"type"`
+	ResizeDisk                      types.Bool     `tfsdk:"resize_disk"`
+	MigrationType                   types.String   `tfsdk:"migration_type"`
+	Status                          types.String   `tfsdk:"status"`
+	IPAddress                       types.String   `tfsdk:"ip_address"`
+	IPv6                            types.String   `tfsdk:"ipv6"`
+	IPv4                            types.Set      `tfsdk:"ipv4"`
+	PrivateIP                       types.Bool     `tfsdk:"private_ip"`
+	PrivateIPAddress                types.String   `tfsdk:"private_ip_address"`
+	AuthorizedKeys                  types.List     `tfsdk:"authorized_keys"`
+	AuthorizedUsers                 types.List     `tfsdk:"authorized_users"`
+	RootPass                        types.String   `tfsdk:"root_pass"`
+	RootPassWO                      types.String   `tfsdk:"root_pass_wo"`
+	RootPassWOVersion               types.Int64    `tfsdk:"root_pass_wo_version"`
+	SwapSize                        types.Int64    `tfsdk:"swap_size"`
+	BackupsEnabled                  types.Bool     `tfsdk:"backups_enabled"`
+	WatchdogEnabled                 types.Bool     `tfsdk:"watchdog_enabled"`
+	HostUUID                        types.String   `tfsdk:"host_uuid"`
+	Booted                          types.Bool     `tfsdk:"booted"`
+	FirewallID                      types.Int64    `tfsdk:"firewall_id"`
+	SharedIPv4                      types.Set      `tfsdk:"shared_ipv4"`
+	NetworkHelper                   types.Bool     `tfsdk:"network_helper"`
+	PlacementGroupExternallyManaged types.Bool     `tfsdk:"placement_group_externally_managed"`
+	InterfaceGeneration             types.String   `tfsdk:"interface_generation"`
+	HasUserData                     types.Bool     `tfsdk:"has_user_data"`
+	DiskEncryption                  types.String   `tfsdk:"disk_encryption"`
+	LKEClusterID                    types.Int64    `tfsdk:"lke_cluster_id"`
+	HourlyCost                      types.Float64  `tfsdk:"hourly_cost"`
+	MonthlyCost                     types.Float64  `tfsdk:"monthly_cost"`
+	Specs                           types.List     `tfsdk:"specs"`
+	Backups                         types.List     `tfsdk:"backups"`
+	Metadata                        types.List     `tfsdk:"metadata"`
+	PlacementGroup                  types.List     `tfsdk:"placement_group"`
+	Alerts                          types.List     `tfsdk:"alerts"`
+	Interface                       types.List     `tfsdk:"interface"`
+	Config                          types.List     `tfsdk:"config"`
+	Disk                            types.List     `tfsdk:"disk"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// SpecsModel describes an object of the specs attribute of the resource.
+type SpecsModel struct {
+	Disk               types.Int64 `tfsdk:"disk"`
+	Memory             types.Int64 `tfsdk:"memory"`
+	VCPUs              types.Int64 `tfsdk:"vcpus"`
+	Transfer           types.Int64 `tfsdk:"transfer"`
+	AcceleratedDevices types.Int64 `tfsdk:"accelerated_devices"`
+	GPUs               types.Int64 `tfsdk:"gpus"`
+}
+
+// BackupsModel describes an object of the backups attribute of the resource.
+type BackupsModel struct {
+	Available types.Bool `tfsdk:"available"`
+	Enabled   types.Bool `tfsdk:"enabled"`
+	Schedule  types.List `tfsdk:"schedule"`
+}
+
+// BackupScheduleModel describes the schedule of the backups of the resource.
+type BackupScheduleModel struct {
+	Day    types.String `tfsdk:"day"`
+	Window types.String `tfsdk:"window"`
+}
+
+// AlertsModel describes the alerts block of the resource.
+type AlertsModel struct {
+	CPU           types.Int64 `tfsdk:"cpu"`
+	NetworkIn     types.Int64 `tfsdk:"network_in"`
+	NetworkOut    types.Int64 `tfsdk:"network_out"`
+	TransferQuota types.Int64 `tfsdk:"transfer_quota"`
+	IO            types.Int64 `tfsdk:"io"`
+}
+
+// PlacementGroupModel describes the placement_group block of the resource.
+type PlacementGroupModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	CompliantOnly        types.Bool   `tfsdk:"compliant_only"`
+	Label                types.String `tfsdk:"label"`
+	PlacementGroupType   types.String `tfsdk:"placement_group_type"`
+	PlacementGroupPolicy types.String `tfsdk:"placement_group_policy"`
+}
+
+// MetadataModel describes the metadata block of the resource.
+type MetadataModel struct {
+	UserData types.String `tfsdk:"user_data"`
+}
+
+// FlattenInstance sets the attributes of the model from the given instance and its networking,
+// disks and configs as returned by the API.
+func (data *ResourceModel) FlattenInstance(
+	ctx context.Context,
+	instance *linodego.Instance,
+	network *linodego.InstanceIPAddressResponse,
+	disks []linodego.InstanceDisk,
+	configs []linodego.InstanceConfig,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(instance.ID), preserveKnown)
+
+	ips := make([]string, len(instance.IPv4))
+	for i, ip := range instance.IPv4 {
+		ips[i] = ip.String()
+	}
+
+	ipv4, newDiags := types.SetValueFrom(ctx, types.StringType, ips)
+	diags.Append(newDiags...)
+	data.IPv4 = helper.KeepOrUpdateValue(data.IPv4, ipv4, preserveKnown)
+
+	data.IPv6 = helper.KeepOrUpdateString(data.IPv6, instance.IPv6, preserveKnown)
+
+	sharedIPv4, newDiags := types.SetValueFrom(ctx, types.StringType, instanceIPSliceToString(network.IPv4.Shared))
+	diags.Append(newDiags...)
+	data.SharedIPv4 = helper.KeepOrUpdateValue(data.SharedIPv4, sharedIPv4, preserveKnown)
+
+	data.IPAddress = flattenAddress(data.IPAddress, network.IPv4.Public)
+	data.PrivateIPAddress = flattenAddress(data.PrivateIPAddress, network.IPv4.Private)
+	data.PrivateIP = keepOrUpdateOptional(data.PrivateIP, types.BoolValue(len(network.IPv4.Private) > 0), preserveKnown)
+
+	data.Label = helper.KeepOrUpdateString(data.Label, instance.Label, preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(instance.Status), preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, instance.Type, preserveKnown)
+	data.Region = helper.KeepOrUpdateString(data.Region, instance.Region, preserveKnown)
+	data.MaintenancePolicy = helper.KeepOrUpdateString(data.MaintenancePolicy, instance.MaintenancePolicy, preserveKnown)
+	data.WatchdogEnabled = helper.KeepOrUpdateBool(data.WatchdogEnabled, instance.WatchdogEnabled, preserveKnown)
+	data.Group = keepOrUpdateOptional(data.Group, types.StringValue(instance.Group), preserveKnown)
+	data.Booted = helper.KeepOrUpdateBool(data.Booted, isInstanceBooted(instance), preserveKnown)
+	data.HostUUID = helper.KeepOrUpdateString(data.HostUUID, instance.HostUUID, preserveKnown)
+	data.HasUserData = helper.KeepOrUpdateBool(data.HasUserData, instance.HasUserData, preserveKnown)
+	data.LKEClusterID = helper.KeepOrUpdateInt64(data.LKEClusterID, int64(instance.LKEClusterID), preserveKnown)
+	data.DiskEncryption = helper.KeepOrUpdateString(
+		data.DiskEncryption, string(instance.DiskEncryption), preserveKnown,
+	)
+	data.InterfaceGeneration = helper.KeepOrUpdateString(
+		data.InterfaceGeneration, string(instance.InterfaceGeneration), preserveKnown,
+	)
+	data.BackupsEnabled = helper.KeepOrUpdateBool(data.BackupsEnabled, instance.Backups.Enabled, preserveKnown)
+
+	capabilities, newDiags := types.SetValueFrom(ctx, types.StringType, instance.Capabilities)
+	diags.Append(newDiags...)
+	data.Capabilities = helper.KeepOrUpdateValue(data.Capabilities, capabilities, preserveKnown)
+
+	locks, newDiags := types.SetValueFrom(ctx, types.StringType, instance.Locks)
+	diags.Append(newDiags...)
+	data.Locks = helper.KeepOrUpdateValue(data.Locks, locks, preserveKnown)
+
+	data.flattenSpecs(ctx, instance, preserveKnown, diags)
+	data.flattenBackups(ctx, instance, preserveKnown, diags)
+	data.flattenAlerts(ctx, instance, preserveKnown, diags)
+
+	// The assignment of an externally managed placement group is not tracked by this resource
+	if !data.PlacementGroupExternallyManaged.ValueBool() {
+		data.flattenPlacementGroup(ctx, instance, preserveKnown, diags)
+	}
+
+	data.flattenDisks(ctx, disks, preserveKnown, diags)
+
+	diskLabels := make(map[int]string, len(disks))
+	for _, disk := range disks {
+		diskLabels[disk.ID] = disk.Label
+	}
+
+	data.Config = attributeList(
+		ctx,
+		data.Config,
+		resourceConfigNestedObject.Type(),
+		flattenByLabel(
+			blockModels[ConfigModel](ctx, data.Config, diags),
+			configs,
+			func(config ConfigModel) types.String { return config.Label },
+			func(config linodego.InstanceConfig) string { return config.Label },
+			func(model *ConfigModel, config linodego.InstanceConfig, preserveKnown bool) {
+				model.FlattenConfig(ctx, config, diskLabels, preserveKnown, diags)
+			},
+			preserveKnown && !data.Config.IsUnknown(),
+		),
+		diags,
+	)
+
+	if len(configs) == 1 {
+		// Interfaces defined in the config block are not tracked at the top level
+		if data.Interface.IsUnknown() || len(data.Interface.Elements()) > 0 {
+			data.Interface = blockList(
+				ctx,
+				resourceInterfaceNestedObject.Type(),
+				flattenByIndex(
+					blockModels[InterfaceModel](ctx, data.Interface, diags),
+					configs[0].Interfaces,
+					func(iface *InterfaceModel, value linodego.InstanceConfigInterface, preserveKnown bool) {
+						iface.FlattenInterface(ctx, value, preserveKnown, diags)
+					},
+					preserveKnown,
+				),
+				diags,
+			)
+		}
+
+		data.BootConfigLabel = helper.KeepOrUpdateString(data.BootConfigLabel, configs[0].Label, preserveKnown)
+	}
+
+	if data.Interface.IsUnknown() {
+		data.Interface = types.ListValueMust(resourceInterfaceNestedObject.Type(), nil)
+	}
+
+	if data.BootConfigLabel.IsUnknown() {
+		data.BootConfigLabel = types.StringNull()
+	}
+
+	if data.Metadata.IsUnknown() {
+		data.Metadata = types.ListNull(resourceMetadataNestedObject.Type())
+	}
+}
+
+func (data *ResourceModel) flattenSpecs(
+	ctx context.Context, instance *linodego.Instance, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	var specs []SpecsModel
+
+	if instance.Specs != nil {
+		specs = append(specs, SpecsModel{
+			Disk:               types.Int64Value(int64(instance.Specs.Disk)),
+			Memory:             types.Int64Value(int64(instance.Specs.Memory)),
+			VCPUs:              types.Int64Value(int64(instance.Specs.VCPUs)),
+			Transfer:           types.Int64Value(int64(instance.Specs.Transfer)),
+			AcceleratedDevices: types.Int64Value(int64(instance.Specs.AcceleratedDevices)),
+			GPUs:               types.Int64Value(int64(instance.Specs.GPUs)),
+		})
+	}
+
+	specsList, newDiags := types.ListValueFrom(ctx, specsObjectType, specs)
+	diags.Append(newDiags...)
+
+	data.Specs = helper.KeepOrUpdateValue(data.Specs, specsList, preserveKnown)
+}
+
+func (data *ResourceModel) flattenBackups(
+	ctx context.Context, instance *linodego.Instance, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	var backups []BackupsModel
+
+	if instance.Backups != nil {
+		schedule, newDiags := types.ListValueFrom(
+			ctx,
+			backupsObjectType.AttrTypes["schedule"].(types.ListType).ElemType,
+			[]BackupScheduleModel{
+				{
+					Day:    types.StringValue(instance.Backups.Schedule.Day),
+					Window: types.StringValue(instance.Backups.Schedule.Window),
+				},
+			},
+		)
+		diags.Append(newDiags...)
+
+		backups = append(backups, BackupsModel{
+			Available: types.BoolValue(instance.Backups.Available),
+			Enabled:   types.BoolValue(instance.Backups.Enabled),
+			Schedule:  schedule,
+		})
+	}
+
+	backupsList, newDiags := types.ListValueFrom(ctx, backupsObjectType, backups)
+	diags.Append(newDiags...)
+
+	data.Backups = helper.KeepOrUpdateValue(data.Backups, backupsList, preserveKnown)
+}
+
+func (data *ResourceModel) flattenAlerts(
+	ctx context.Context, instance *linodego.Instance, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	var alerts AlertsModel
+
+	priorAlerts := blockModels[AlertsModel](ctx, data.Alerts, diags)
+	if len(priorAlerts) > 0 {
+		alerts = priorAlerts[0]
+	}
+
+	if instance.Alerts != nil {
+		alerts.FlattenAlerts(*instance.Alerts, preserveKnown && len(priorAlerts) > 0)
+	}
+
+	data.Alerts = attributeList(ctx, data.Alerts, resourceAlertsNestedObject.Type(), []AlertsModel{alerts}, diags)
+}
+
+func (data *ResourceModel) flattenPlacementGroup(
+	ctx context.Context, instance *linodego.Instance, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	var groups []linodego.InstancePlacementGroup
+	if instance.PlacementGroup != nil {
+		groups = append(groups, *instance.PlacementGroup)
+	}
+
+	data.PlacementGroup = blockList(
+		ctx,
+		resourcePlacementGroupNestedObject.Type(),
+		flattenByIndex(
+			blockModels[PlacementGroupModel](ctx, data.PlacementGroup, diags),
+			groups,
+			(*PlacementGroupModel).FlattenPlacementGroup,
+			preserveKnown,
+		),
+		diags,
+	)
+}
+
+func (data *ResourceModel) flattenDisks(
+	ctx context.Context, disks []linodego.InstanceDisk, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	var swapSize int
+
+	for _, disk := range disks {
+		if disk.Filesystem == linodego.FilesystemSwap {
+			swapSize += disk.Size
+		}
+	}
+
+	data.SwapSize = helper.KeepOrUpdateInt64(data.SwapSize, int64(swapSize), preserveKnown)
+
+	data.Disk = attributeList(
+		ctx,
+		data.Disk,
+		resourceDiskNestedObject.Type(),
+		flattenByLabel(
+			blockModels[DiskModel](ctx, data.Disk, diags),
+			disks,
+			func(disk DiskModel) types.String { return disk.Label },
+			func(disk linodego.InstanceDisk) string { return disk.Label },
+			(*DiskModel).FlattenDisk,
+			preserveKnown && !data.Disk.IsUnknown(),
+		),
+		diags,
+	)
+}
+
+// FlattenAlerts sets the attributes of the model from the given alert thresholds.
+func (data *AlertsModel) FlattenAlerts(alerts linodego.InstanceAlert, preserveKnown bool) {
+	data.CPU = helper.KeepOrUpdateInt64(data.CPU, int64(alerts.CPU), preserveKnown)
+	data.NetworkIn = helper.KeepOrUpdateInt64(data.NetworkIn, int64(alerts.NetworkIn), preserveKnown)
+	data.NetworkOut = helper.KeepOrUpdateInt64(data.NetworkOut, int64(alerts.NetworkOut), preserveKnown)
+	data.TransferQuota = helper.KeepOrUpdateInt64(data.TransferQuota, int64(alerts.TransferQuota), preserveKnown)
+	data.IO = helper.KeepOrUpdateInt64(data.IO, int64(alerts.IO), preserveKnown)
+}
+
+// FlattenPlacementGroup sets the attributes of the model from the given placement group assignment.
+func (data *PlacementGroupModel) FlattenPlacementGroup(group linodego.InstancePlacementGroup, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateInt64(data.ID, int64(group.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, group.Label, preserveKnown)
+	data.PlacementGroupType = helper.KeepOrUpdateString(
+		data.PlacementGroupType, string(group.PlacementGroupType), preserveKnown,
+	)
+	data.PlacementGroupPolicy = helper.KeepOrUpdateString(
+		data.PlacementGroupPolicy, string(group.PlacementGroupPolicy), preserveKnown,
+	)
+
+	// The API does not return whether the assignment was compliant only
+	if data.CompliantOnly.IsUnknown() {
+		data.CompliantOnly = types.BoolNull()
+	}
+}
+
+// SetDefaults sets the default values of the attributes only used when planning changes,
+// e.g. after the resource has been imported.
+func (data *ResourceModel) SetDefaults() {
+	if data.ResizeDisk.IsNull() || data.ResizeDisk.IsUnknown() {
+		data.ResizeDisk = types.BoolValue(false)
+	}
+
+	if data.MigrationType.IsNull() || data.MigrationType.IsUnknown() {
+		data.MigrationType = types.StringValue(string(linodego.ColdMigration))
+	}
+
+	if data.PlacementGroupExternallyManaged.IsNull() || data.PlacementGroupExternallyManaged.IsUnknown() {
+		data.PlacementGroupExternallyManaged = types.BoolValue(false)
+	}
+}
+
+// Cost returns a function computing the cost of the Instance from its type, region
+// and whether it is enrolled in the Backup service.
+func (data *ResourceModel) Cost(ctx context.Context, client *linodego.Client) helper.CostFunc {
+	return func() (*helper.ResourceCost, error) {
+		if !helper.ValuesKnown(data.Type, data.Region) {
+			return nil, nil
+		}
+
+		typeID, region := data.Type.ValueString(), data.Region.ValueString()
+		if typeID == "" || region == "" {
+			return nil, nil
+		}
+
+		return helper.GetLinodeTypeCost(ctx, client, typeID, region, data.BackupsEnabled.ValueBool())
+	}
+}
+
+// RegionRequirements returns the region capabilities and plan type required by the Instance.
+func (data *ResourceModel) RegionRequirements(ctx context.Context, diags *diag.Diagnostics) helper.RegionRequirements {
+	requirements := helper.RegionRequirements{
+		Capabilities: []string{linodego.CapabilityLinodes},
+	}
+
+	if helper.ValuesKnown(data.Type) {
+		requirements.RequirePlanType(data.Type.ValueString())
+	}
+
+	if len(data.PlacementGroup.Elements()) > 0 {
+		requirements.Require(linodego.CapabilityPlacementGroup)
+	}
+
+	if len(data.Metadata.Elements()) > 0 {
+		requirements.Require(linodego.CapabilityMetadata)
+	}
+
+	if data.DiskEncryption.ValueString() == "enabled" {
+		requirements.Require(linodego.CapabilityDiskEncryption)
+	}
+
+	interfaces := blockModels[InterfaceModel](ctx, data.Interface, diags)
+
+	for _, config := range blockModels[ConfigModel](ctx, data.Config, diags) {
+		interfaces = append(interfaces, blockModels[InterfaceModel](ctx, config.Interface, diags)...)
+	}
+
+	for _, iface := range interfaces {
+		if iface.Purpose.ValueString() == string(linodego.InterfacePurposeVPC) {
+			requirements.Require(linodego.CapabilityVPCs)
+		}
+	}
+
+	return requirements
+}
+
+// PlacementGroupCreateOptions returns the options to assign the Instance to
+// the configured Placement Group on creation.
+func (data *ResourceModel) PlacementGroupCreateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) *linodego.InstanceCreatePlacementGroupOptions {
+	groupID := data.placementGroupID(ctx, diags)
+	if groupID == 0 {
+		return nil
+	}
+
+	pgOptions := linodego.InstanceCreatePlacementGroupOptions{
+		ID:            groupID,
+		CompliantOnly: data.placementGroupCompliantOnly(),
+	}
+
+	return &pgOptions
+}
+
+// placementGroupID returns the ID of the configured Placement Group, or 0 if none is configured.
+func (data *ResourceModel) placementGroupID(ctx context.Context, diags *diag.Diagnostics) int {
+	groups := blockModels[PlacementGroupModel](ctx, data.PlacementGroup, diags)
+	if len(groups) == 0 {
+		return 0
+	}
+
+	return helper.FrameworkSafeInt64ToInt(groups[0].ID.ValueInt64(), diags)
+}
+
+func (data *ResourceModel) placementGroupCompliantOnly() *bool {
+	if len(data.PlacementGroup.Elements()) == 0 {
+		return nil
+	}
+
+	group, ok := data.PlacementGroup.Elements()[0].(types.Object)
+	if !ok {
+		return nil
+	}
+
+	compliantOnly, ok := group.Attributes()["compliant_only"].(types.Bool)
+	if !ok {
+		return nil
+	}
+
+	return compliantOnly.ValueBoolPointer()
+}
+
+// upgradeResourceStateV0toV1 upgrades the state of an Instance managed by the SDKv2 implementation
+// of this resource, which stored the zero values of unset attributes. The embedded disks, configs
+// and alerts computed from the API are carried over as they are still tracked.
+func upgradeResourceStateV0toV1(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	raw, err := convertValueType(req.State.Raw, resp.State.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Upgrade Instance State", err.Error())
+		return
+	}
+
+	resp.State.Raw = raw
+
+	var state types.Object
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := nullifyZeroValues(
+		ctx, state, frameworkResourceSchema.Attributes, frameworkResourceSchema.Blocks, &resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+}
+
+// convertValueType converts the given value to the given type with the same structure, where the
+// attributes of objects which are missing from the value are null and the others are dropped.
+func convertValueType(value tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch t := typ.(type) {
+	case tftypes.Object:
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		result := make(map[string]tftypes.Value, len(t.AttributeTypes))
+
+		for name, attributeType := range t.AttributeTypes {
+			attribute, ok := attributes[name]
+			if !ok {
+				result[name] = tftypes.NewValue(attributeType, nil)
+				continue
+			}
+
+			converted, err := convertValueType(attribute, attributeType)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+
+			result[name] = converted
+		}
+
+		return tftypes.NewValue(t, result), nil
+	case tftypes.List, tftypes.Set:
+		var elementType tftypes.Type
+
+		if list, ok := t.(tftypes.List); ok {
+			elementType = list.ElementType
+		} else {
+			elementType = t.(tftypes.Set).ElementType
+		}
+
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		result := make([]tftypes.Value, len(elements))
+
+		for i, element := range elements {
+			converted, err := convertValueType(element, elementType)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%d: %w", i, err)
+			}
+
+			result[i] = converted
+		}
+
+		return tftypes.NewValue(t, result), nil
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		result := make(map[string]tftypes.Value, len(elements))
+
+		for key, element := range elements {
+			converted, err := convertValueType(element, t.ElementType)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", key, err)
+			}
+
+			result[key] = converted
+		}
+
+		return tftypes.NewValue(t, result), nil
+	}
+
+	if !value.Type().Equal(typ) {
+		return tftypes.Value{}, fmt.Errorf("expected a value of type %s, got %s", typ, value.Type())
+	}
+
+	return value, nil
+}
+
+// nullifyZeroValues returns the given object with the zero values of its optional, non-computed
+// attributes replaced with null, as the SDKv2 did not distinguish between them.
+func nullifyZeroValues(
+	ctx context.Context,
+	value types.Object,
+	attributes map[string]schema.Attribute,
+	blocks map[string]schema.Block,
+	diags *diag.Diagnostics,
+) types.Object {
+	if value.IsNull() || value.IsUnknown() {
+		return value
+	}
+
+	result := value.Attributes()
+
+	for name, attribute := range attributes {
+		if attribute.IsOptional() && !attribute.IsComputed() && isZeroValue(result[name]) {
+			result[name] = nullValue(ctx, attribute.GetType())
+			continue
+		}
+
+		if nested, ok := attribute.(schema.ListNestedAttribute); ok {
+			result[name] = nullifyListZeroValues(ctx, result[name], nested.NestedObject.Attributes, nil, diags)
+		}
+	}
+
+	for name, block := range blocks {
+		listBlock, ok := block.(schema.ListNestedBlock)
+		if !ok {
+			continue
+		}
+
+		if list, ok := result[name].(types.List); !ok || list.IsNull() || list.IsUnknown() {
+			result[name] = types.ListValueMust(listBlock.NestedObject.Type(), nil)
+			continue
+		}
+
+		result[name] = nullifyListZeroValues(
+			ctx, result[name], listBlock.NestedObject.Attributes, listBlock.NestedObject.Blocks, diags,
+		)
+	}
+
+	newValue, newDiags := types.ObjectValue(value.AttributeTypes(ctx), result)
+	diags.Append(newDiags...)
+
+	return newValue
+}
+
+// nullifyListZeroValues applies nullifyZeroValues to every object of the given nested list.
+func nullifyListZeroValues(
+	ctx context.Context,
+	value attr.Value,
+	attributes map[string]schema.Attribute,
+	blocks map[string]schema.Block,
+	diags *diag.Diagnostics,
+) attr.Value {
+	list, ok := value.(types.List)
+	if !ok || list.IsNull() || list.IsUnknown() {
+		return value
+	}
+
+	elements := make([]attr.Value, len(list.Elements()))
+	for i, element := range list.Elements() {
+		elements[i] = nullifyZeroValues(ctx, element.(types.Object), attributes, blocks, diags)
+	}
+
+	newList, newDiags := types.ListValue(list.ElementType(ctx), elements)
+	diags.Append(newDiags...)
+
+	return newList
+}
+
+// flattenAddress returns the prior address if it is still assigned to the instance,
+// or the first of the given addresses otherwise.
+func flattenAddress(prior types.String, addresses []*linodego.InstanceIP) types.String {
+	if len(addresses) == 0 {
+		if prior.IsUnknown() {
+			return types.StringNull()
+		}
+
+		return prior
+	}
+
+	if !prior.IsUnknown() && slices.ContainsFunc(
+		addresses, func(ip *linodego.InstanceIP) bool {
+			return ip.Address == prior.ValueString()
+		},
+	) {
+		return prior
+	}
+
+	return types.StringValue(addresses[0].Address)
+}
+
+// keepOrUpdateOptional updates an optional attribute, keeping it null
+// if it is not set and the new value is the zero value of its type.
+func keepOrUpdateOptional[T attr.Value](original, updated T, preserveKnown bool) T {
+	if original.IsNull() && isZeroValue(updated) {
+		return original
+	}
+
+	return helper.KeepOrUpdateValue(original, updated, preserveKnown)
+}
+
+// blockModels returns the objects of a nested block as models, or nil if the block is null or unknown.
+func blockModels[T any](ctx context.Context, list types.List, diags *diag.Diagnostics) []T {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var result []T
+
+	diags.Append(list.ElementsAs(ctx, &result, false)...)
+
+	return result
+}
+
+// blockList returns the value of a nested block with the given objects.
+func blockList[T any](
+	ctx context.Context, elemType attr.Type, models []T, diags *diag.Diagnostics,
+) types.List {
+	if models == nil {
+		models = make([]T, 0)
+	}
+
+	result, newDiags := types.ListValueFrom(ctx, elemType, models)
+	diags.Append(newDiags...)
+
+	return result
+}
+
+// attributeList returns the value of a nested list attribute with the given objects,
+// which is null rather than empty if its prior value is not set.
+func attributeList[T any](
+	ctx context.Context, prior types.List, elemType attr.Type, models []T, diags *diag.Diagnostics,
+) types.List {
+	if len(models) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ListNull(elemType)
+	}
+
+	return blockList(ctx, elemType, models, diags)
+}
+
+// flattenByLabel flattens the given values into the prior objects of a nested list with the same label,
+// keeping the prior order. The prior objects without a value are dropped, while the values without
+// a prior object are appended unless the known prior objects are preserved.
+func flattenByLabel[M, V any](
+	prior []M,
+	values []V,
+	priorLabel func(M) types.String,
+	label func(V) string,
+	flatten func(*M, V, bool),
+	preserveKnown bool,
+) []M {
+	result := make([]M, 0, len(values))
+	matched := make([]bool, len(values))
+
+	for i, model := range prior {
+		key := priorLabel(model)
+
+		index := slices.IndexFunc(values, func(value V) bool {
+			return label(value) == key.ValueString()
+		})
+
+		// A planned label may be unknown, e.g. when derived from another resource
+		if key.IsUnknown() && i < len(values) {
+			index = i
+		}
+
+		if index < 0 || matched[index] {
+			continue
+		}
+
+		matched[index] = true
+
+		flatten(&model, values[index], preserveKnown)
+		result = append(result, model)
+	}
+
+	for i, value := range values {
+		if matched[i] || preserveKnown {
+			continue
+		}
+
+		var model M
+
+		flatten(&model, value, false)
+		result = append(result, model)
+	}
+
+	return result
+}
+
+// flattenByIndex flattens the given values into the prior objects of a nested block at the same index.
+func flattenByIndex[M, V any](prior []M, values []V, flatten func(*M, V, bool), preserveKnown bool) []M {
+	result := make([]M, len(values))
+
+	for i, value := range values {
+		if i < len(prior) {
+			result[i] = prior[i]
+		}
+
+		flatten(&result[i], value, preserveKnown && i < len(prior))
+	}
+
+	return result
+}
+
+// nullValue returns the null value of the given type.
+func nullValue(ctx context.Context, typ attr.Type) attr.Value {
+	result, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+	if err != nil {
+		panic(fmt.Sprintf("failed to create null value of %s: %s", typ, err))
+	}
+
+	return result
+}
+
+// fillUnknownsFromState replaces the unknown values nested in a planned value with
+// the corresponding values from the prior state, aligning list elements by index.
+func fillUnknownsFromState(ctx context.Context, plan, state attr.Value) attr.Value {
+	if state == nil || state.IsNull() || state.IsUnknown() {
+		return plan
+	}
+
+	if plan.IsUnknown() {
+		return state
+	}
+
+	switch p := plan.(type) {
+	case types.Object:
+		s, ok := state.(types.Object)
+		if !ok || p.IsNull() {
+			return plan
+		}
+
+		attributes := p.Attributes()
+		for name, value := range attributes {
+			attributes[name] = fillUnknownsFromState(ctx, value, s.Attributes()[name])
+		}
+
+		return types.ObjectValueMust(p.AttributeTypes(ctx), attributes)
+	case types.List:
+		s, ok := state.(types.List)
+		if !ok || p.IsNull() {
+			return plan
+		}
+
+		elements := p.Elements()
+		for i, element := range elements {
+			if i < len(s.Elements()) {
+				elements[i] = fillUnknownsFromState(ctx, element, s.Elements()[i])
+			}
+		}
+
+		return types.ListValueMust(p.ElementType(ctx), elements)
+	}
+
+	return plan
+}
@@ -0,0 +1,163 @@
+//go:build unit
+
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenByLabel(t *testing.T) {
+	prior := []DiskModel{
+		{Label: types.StringValue("swap"), Size: types.Int64Value(512)},
+		{Label: types.StringValue("boot"), Size: types.Int64Value(25000)},
+		{Label: types.StringValue("deleted"), Size: types.Int64Value(1024)},
+	}
+
+	disks := []linodego.InstanceDisk{
+		{ID: 1, Label: "boot", Size: 30000, Filesystem: linodego.FilesystemExt4},
+		{ID: 2, Label: "swap", Size: 512, Filesystem: linodego.FilesystemSwap},
+		{ID: 3, Label: "unmanaged", Size: 1024, Filesystem: linodego.FilesystemRaw},
+	}
+
+	result := flattenByLabel(
+		prior,
+		disks,
+		func(disk DiskModel) types.String { return disk.Label },
+		func(disk linodego.InstanceDisk) string { return disk.Label },
+		(*DiskModel).FlattenDisk,
+		false,
+	)
+	require.Len(t, result, 3)
+
+	require.Equal(t, types.StringValue("swap"), result[0].Label)
+	require.Equal(t, types.Int64Value(2), result[0].ID)
+
+	require.Equal(t, types.StringValue("boot"), result[1].Label)
+	require.Equal(t, types.Int64Value(30000), result[1].Size)
+
+	require.Equal(t, types.StringValue("unmanaged"), result[2].Label)
+	require.Equal(t, types.Int64Value(3), result[2].ID)
+
+	// The planned objects are kept as they are
+	result = flattenByLabel(
+		prior,
+		disks,
+		func(disk DiskModel) types.String { return disk.Label },
+		func(disk linodego.InstanceDisk) string { return disk.Label },
+		(*DiskModel).FlattenDisk,
+		true,
+	)
+	require.Len(t, result, 2)
+
+	require.Equal(t, types.Int64Value(25000), result[1].Size)
+}
+
+func TestFillUnknownsFromState(t *testing.T) {
+	ctx := context.Background()
+	objectType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"label": types.StringType,
+		"id":    types.Int64Type,
+	}}
+
+	plan := types.ListValueMust(objectType, []attr.Value{
+		types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"label": types.StringValue("boot"),
+			"id":    types.Int64Unknown(),
+		}),
+		types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"label": types.StringValue("new"),
+			"id":    types.Int64Unknown(),
+		}),
+	})
+
+	state := types.ListValueMust(objectType, []attr.Value{
+		types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"label": types.StringValue("boot"),
+			"id":    types.Int64Value(123),
+		}),
+	})
+
+	result := fillUnknownsFromState(ctx, plan, state).(types.List)
+	require.Len(t, result.Elements(), 2)
+	require.Equal(t, types.Int64Value(123), result.Elements()[0].(types.Object).Attributes()["id"])
+	require.True(t, result.Elements()[1].(types.Object).Attributes()["id"].IsUnknown())
+}
+
+func TestBlockValidator(t *testing.T) {
+	ctx := context.Background()
+	configType := frameworkResourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	newConfig := func(values map[string]tftypes.Value) tfsdk.Config {
+		attributes := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+		for name, attributeType := range configType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+
+		for name, value := range values {
+			attributes[name] = value
+		}
+
+		return tfsdk.Config{
+			Schema: frameworkResourceSchema,
+			Raw:    tftypes.NewValue(configType, attributes),
+		}
+	}
+
+	diskListType := configType.AttributeTypes["disk"].(tftypes.List)
+	diskType := diskListType.ElementType.(tftypes.Object)
+	diskAttributes := make(map[string]tftypes.Value, len(diskType.AttributeTypes))
+	for name, attributeType := range diskType.AttributeTypes {
+		diskAttributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	disks := tftypes.NewValue(diskListType, []tftypes.Value{tftypes.NewValue(diskType, diskAttributes)})
+	emptyDisks := tftypes.NewValue(diskListType, []tftypes.Value{})
+	image := tftypes.NewValue(tftypes.String, "linode/debian12")
+
+	testCases := map[string]struct {
+		validator   resource.ConfigValidator
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		"conflict": {
+			validator:   blockConflictsWith("disk", "image"),
+			values:      map[string]tftypes.Value{"disk": disks, "image": image},
+			expectError: true,
+		},
+		"empty block": {
+			validator: blockConflictsWith("disk", "image"),
+			values:    map[string]tftypes.Value{"disk": emptyDisks, "image": image},
+		},
+		"missing requirement": {
+			validator:   blockAlsoRequires("disk", "image"),
+			values:      map[string]tftypes.Value{"disk": disks},
+			expectError: true,
+		},
+		"unknown requirement": {
+			validator: blockAlsoRequires("disk", "image"),
+			values: map[string]tftypes.Value{
+				"disk":  disks,
+				"image": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := resource.ValidateConfigResponse{}
+			testCase.validator.ValidateResource(
+				ctx, resource.ValidateConfigRequest{Config: newConfig(testCase.values)}, &resp,
+			)
+			require.Equal(t, testCase.expectError, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+		})
+	}
+}
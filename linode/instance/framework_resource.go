@@ -0,0 +1,1312 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+// Instance creation with reserved IPv4 is for internal use only : please refer to KB page for more information.
+
+const (
+	LinodeInstanceCreateTimeout = 15 * time.Minute
+	LinodeInstanceUpdateTimeout = time.Hour
+	LinodeInstanceDeleteTimeout = 10 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+var (
+	_ resource.ResourceWithModifyPlan       = &Resource{}
+	_ resource.ResourceWithConfigValidators = &Resource{}
+	_ resource.ResourceWithUpgradeState     = &Resource{}
+	_ resource.ResourceWithIdentity         = &Resource{}
+	_ resource.ResourceWithImportState      = &Resource{}
+)
+
+func (r *Resource) IdentitySchema(
+	ctx context.Context,
+	req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the Linode Instance.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	explicitDiskConflicts := []string{
+		"image", "root_pass", "root_pass_wo", "authorized_keys", "authorized_users", "swap_size",
+		"backup_id", "stackscript_id", "stackscript_data", "stackscript_data_wo", "interface",
+	}
+
+	return []resource.ConfigValidator{
+		blockConflictsWith("disk", explicitDiskConflicts...),
+		blockConflictsWith("config", explicitDiskConflicts...),
+		blockAlsoRequires("interface", "image"),
+	}
+}
+
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := frameworkResourceSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeResourceStateV0toV1,
+		},
+	}
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if r.Meta == nil {
+		return
+	}
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The plan modifiers of attributes such as the root_pass hashed by the SDKv2 implementation
+	// may keep the state value of every configured change, in which case nothing changes and
+	// the computed attributes the framework marked as unknown keep their state values as well.
+	if plannedChangesReverted(req.Plan.Raw, req.State.Raw, req.Config.Raw) {
+		resp.Plan.Raw = req.State.Raw
+		return
+	}
+
+	helper.FrameworkPlanTagsAll(ctx, req, resp, plan.Tags, r.Meta)
+	helper.PlanCost(ctx, req, resp, plan.Cost(ctx, r.Meta.Client))
+
+	if !req.State.Raw.IsNull() {
+		var state ResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Changing the user data replaces the Instance
+		if !plan.Metadata.Equal(state.Metadata) {
+			validateUserDataImages(ctx, r.Meta.Client, &plan, &resp.Diagnostics)
+		}
+
+		if isInterfaceGenerationUpgrade(state.InterfaceGeneration, plan.InterfaceGeneration) {
+			validateInterfaceUpgrade(ctx, &plan, &resp.Diagnostics)
+		}
+
+		return
+	}
+
+	validateUserDataImages(ctx, r.Meta.Client, &plan, &resp.Diagnostics)
+
+	requirements := plan.RegionRequirements(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper.ValidateRegionRequirements(
+		ctx, r.Meta.Client, path.Root("region"), plan.Region, requirements, &resp.Diagnostics,
+	)
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan, config ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, LinodeInstanceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := *r.Meta.Client
+
+	disksOk := len(config.Disk.Elements()) > 0
+	configsOk := len(config.Config.Elements()) > 0
+
+	if err := validateBooted(config.Booted, plan.Image.ValueString() != "", disksOk, configsOk); err != nil {
+		resp.Diagnostics.AddError("Failed to Validate", err.Error())
+		return
+	}
+
+	bootConfig := 0
+	createOpts := linodego.InstanceCreateOptions{
+		Region:         plan.Region.ValueString(),
+		Type:           plan.Type.ValueString(),
+		Label:          plan.Label.ValueString(),
+		Group:          plan.Group.ValueString(),
+		BackupsEnabled: plan.BackupsEnabled.ValueBool(),
+		PrivateIP:      plan.PrivateIP.ValueBool(),
+		DiskEncryption: linodego.InstanceDiskEncryption(plan.DiskEncryption.ValueString()),
+		Tags:           helper.FrameworkTagsAll(plan.Tags, r.Meta),
+		FirewallID:     helper.FrameworkSafeInt64ToInt(plan.FirewallID.ValueInt64(), &resp.Diagnostics),
+	}
+
+	if !plan.IPv4.IsUnknown() {
+		resp.Diagnostics.Append(plan.IPv4.ElementsAs(ctx, &createOpts.IPv4, false)...)
+	}
+
+	if maintenancePolicy := plan.MaintenancePolicy.ValueString(); maintenancePolicy != "" {
+		createOpts.MaintenancePolicy = &maintenancePolicy
+	}
+
+	for _, iface := range blockModels[InterfaceModel](ctx, plan.Interface, &resp.Diagnostics) {
+		createOpts.Interfaces = append(createOpts.Interfaces, iface.GetCreateOptions(ctx, &resp.Diagnostics))
+	}
+
+	if interfaceGeneration := plan.InterfaceGeneration.ValueString(); interfaceGeneration != "" {
+		createOpts.InterfaceGeneration = linodego.InterfaceGeneration(interfaceGeneration)
+	}
+
+	if !config.NetworkHelper.IsNull() {
+		createOpts.NetworkHelper = plan.NetworkHelper.ValueBoolPointer()
+	}
+
+	if metadata := blockModels[MetadataModel](ctx, plan.Metadata, &resp.Diagnostics); len(metadata) > 0 {
+		createOpts.Metadata = &linodego.InstanceMetadataOptions{
+			UserData: metadata[0].UserData.ValueString(),
+		}
+	}
+
+	createOpts.PlacementGroup = plan.PlacementGroupCreateOptions(ctx, &resp.Diagnostics)
+
+	// If we don't have disks and we don't have configs, use the single API call approach
+	if !disksOk && !configsOk {
+		resp.Diagnostics.Append(plan.AuthorizedKeys.ElementsAs(ctx, &createOpts.AuthorizedKeys, false)...)
+		resp.Diagnostics.Append(plan.AuthorizedUsers.ElementsAs(ctx, &createOpts.AuthorizedUsers, false)...)
+		resp.Diagnostics.Append(plan.StackScriptData.ElementsAs(ctx, &createOpts.StackScriptData, false)...)
+		if !config.StackScriptDataWO.IsNull() {
+			resp.Diagnostics.Append(config.StackScriptDataWO.ElementsAs(ctx, &createOpts.StackScriptData, false)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		createOpts.RootPass = plan.RootPass.ValueString()
+		if !config.RootPassWO.IsNull() {
+			createOpts.RootPass = config.RootPassWO.ValueString()
+		}
+
+		if createOpts.RootPass == "" {
+			var err error
+			createOpts.RootPass, err = helper.CreateRandomRootPassword()
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Generate Root Password", err.Error())
+				return
+			}
+		}
+
+		createOpts.Image = plan.Image.ValueString()
+
+		if !config.Booted.IsNull() {
+			createOpts.Booted = config.Booted.ValueBoolPointer()
+		}
+
+		createOpts.BackupID = helper.FrameworkSafeInt64ToInt(plan.BackupID.ValueInt64(), &resp.Diagnostics)
+		createOpts.StackScriptID = helper.FrameworkSafeInt64ToInt(plan.StackScriptID.ValueInt64(), &resp.Diagnostics)
+
+		if swapSize := helper.FrameworkSafeInt64ToInt(plan.SwapSize.ValueInt64(), &resp.Diagnostics); swapSize > 0 {
+			createOpts.SwapSize = &swapSize
+		}
+	} else {
+		createOpts.Booted = linodego.Pointer(false) // necessary to prepare disks and configs
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createPoller, err := client.NewEventPollerWithoutEntity(linodego.EntityLinode, linodego.ActionLinodeCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateInstance(...) ", map[string]any{
+		"label":  createOpts.Label,
+		"region": createOpts.Region,
+		"type":   createOpts.Type,
+		"image":  createOpts.Image,
+	})
+
+	instance, err := client.CreateInstance(ctx, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Create Linode Instance", err.Error())
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", instance.ID)
+
+	// The instance should always be tracked so it isn't orphaned if a later step fails
+	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(instance.ID)))
+	resp.Identity.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(instance.ID)))
+
+	createPoller.EntityID = instance.ID
+
+	updateOpts := linodego.InstanceUpdateOptions{}
+	doUpdate := false
+
+	if !plan.WatchdogEnabled.ValueBool() {
+		doUpdate = true
+		updateOpts.WatchdogEnabled = linodego.Pointer(false)
+	}
+
+	if alerts := blockModels[AlertsModel](ctx, plan.Alerts, &resp.Diagnostics); len(alerts) > 0 {
+		doUpdate = true
+		updateOpts.Alerts = expandInstanceAlerts(alerts[0])
+	}
+
+	if doUpdate {
+		tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+			"options": updateOpts,
+		})
+
+		instance, err = client.UpdateInstance(ctx, instance.ID, updateOpts)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Update Linode Instance", err.Error())
+			return
+		}
+	}
+
+	// Look up tables for any disks and configs we create
+	// - so configs and initrd can reference disks by label
+	// - so configs can be referenced as a boot_config_label param
+	var diskIDLabelMap map[string]int
+
+	if disksOk {
+		tflog.Debug(ctx, "Waiting for instance creation to complete before provisioning disks")
+
+		if _, err := createPoller.WaitForFinished(ctx, getDeadlineSeconds(ctx)); err != nil {
+			resp.Diagnostics.AddError("Failed to Wait for Instance to Finish Creating", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Instance is ready, provisioning disks")
+
+		diskSpecs := blockModels[DiskModel](ctx, plan.Disk, &resp.Diagnostics)
+		diskIDLabelMap = make(map[string]int, len(diskSpecs))
+
+		for _, diskSpec := range diskSpecs {
+			diskOpts := diskSpec.GetCreateOptions(ctx, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			instanceDisk, err := createInstanceDisk(ctx, client, *instance, diskOpts)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Create Instance Disk", err.Error())
+				return
+			}
+
+			diskIDLabelMap[instanceDisk.Label] = instanceDisk.ID
+		}
+	}
+
+	if configsOk {
+		configSpecs := blockModels[ConfigModel](ctx, plan.Config, &resp.Diagnostics)
+
+		configIDMap, err := createInstanceConfigsFromSet(
+			ctx, client, instance.ID, configSpecs, diskIDLabelMap, makeVolumeDetacher(client),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Create Instance Configs", err.Error())
+			return
+		}
+
+		configIDLabelMap := make(map[string]int, len(configIDMap))
+		for k, v := range configIDMap {
+			if len(configIDLabelMap) == 1 {
+				bootConfig = k
+			}
+
+			configIDLabelMap[v.Label] = k
+		}
+
+		if bootConfigLabel := plan.BootConfigLabel.ValueString(); bootConfigLabel != "" {
+			foundConfig, found := configIDLabelMap[bootConfigLabel]
+			if !found {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("boot_config_label"),
+					"Failed to Set Boot Config Label",
+					fmt.Sprintf("Config label '%s' not found", bootConfigLabel),
+				)
+				return
+			}
+
+			bootConfig = foundConfig
+		}
+	}
+
+	var sharedIPv4 []string
+	if !plan.SharedIPv4.IsUnknown() {
+		resp.Diagnostics.Append(plan.SharedIPv4.ElementsAs(ctx, &sharedIPv4, false)...)
+	}
+
+	if len(sharedIPv4) > 0 {
+		shareOpts := linodego.IPAddressesShareOptions{
+			IPs:      sharedIPv4,
+			LinodeID: instance.ID,
+		}
+
+		tflog.Debug(ctx, "client.ShareIPAddresses(...)", map[string]any{
+			"options": shareOpts,
+		})
+
+		if err := client.ShareIPAddresses(ctx, shareOpts); err != nil {
+			resp.Diagnostics.AddError("Failed to Share IPv4 Addresses with Instance", err.Error())
+			return
+		}
+	}
+
+	targetStatus := linodego.InstanceRunning
+
+	if disksOk && configsOk && (config.Booted.IsNull() || config.Booted.ValueBool()) {
+		p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "client.BootInstance(...)", map[string]any{
+			"config_id": bootConfig,
+		})
+
+		if err := client.BootInstance(ctx, instance.ID, bootConfig); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Boot Linode Instance %d", instance.ID), err.Error())
+			return
+		}
+
+		event, err := p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Boot Linode Instance %d", instance.ID),
+				describeInstanceWaitFailure(ctx, &client, r.Meta.Config.LishPrivateKey, instance.ID, err),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "Instance finished booting", map[string]any{
+			"event_id": event.ID,
+		})
+	} else if createOpts.Image == "" || (!config.Booted.IsNull() && !config.Booted.ValueBool()) {
+		targetStatus = linodego.InstanceOffline
+	}
+
+	if !r.Meta.Config.SkipInstanceReadyPoll.ValueBool() {
+		tflog.Debug(ctx, "Waiting for instance to reach target status", map[string]any{
+			"target_status": targetStatus,
+		})
+
+		if _, err := client.WaitForInstanceStatus(
+			ctx, instance.ID, targetStatus, getDeadlineSeconds(ctx),
+		); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Timed Out Waiting for Linode Instance %d to Reach Status %s", instance.ID, targetStatus),
+				describeInstanceWaitFailure(ctx, &client, r.Meta.Config.LishPrivateKey, instance.ID, err),
+			)
+			return
+		}
+	}
+
+	if !r.refresh(ctx, &plan, instance.ID, true, &resp.Diagnostics) {
+		resp.Diagnostics.AddError(
+			"Linode Instance Not Found",
+			fmt.Sprintf("Linode Instance %d was not found after creation", instance.ID),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(instance.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", id)
+
+	if !r.refresh(ctx, &state, id, false, &resp.Diagnostics) {
+		resp.Diagnostics.AddWarning(
+			"Linode Instance Not Found",
+			fmt.Sprintf("removing Linode Instance ID %d from state because it no longer exists", id),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.SetDefaults()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), state.ID)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state, config ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, LinodeInstanceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client := *r.Meta.Client
+	skipImplicitReboots := r.Meta.Config.SkipImplicitReboots.ValueBool()
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", id)
+
+	if err := validateBooted(
+		config.Booted, plan.Image.ValueString() != "", len(plan.Disk.Elements()) > 0, len(plan.Config.Elements()) > 0,
+	); err != nil {
+		resp.Diagnostics.AddError("Failed to Validate", err.Error())
+		return
+	}
+
+	instance, err := client.GetInstance(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Linode Instance", err.Error())
+		return
+	}
+
+	// Track whether root_pass needs updating. We defer the actual reset so it can
+	// share a single shutdown/boot cycle with other offline-required operations
+	// (e.g., VPC interface updates) instead of causing an extra power cycle.
+	//
+	// The SDKv2 implementation of this resource stored a hash of the root password,
+	// which doesn't require a reset if it matches the configured password.
+	rootPass := plan.RootPass.ValueString()
+	pendingRootPassReset := !plan.RootPass.Equal(state.RootPass) && rootPass != "" &&
+		hashString(rootPass) != state.RootPass.ValueString()
+
+	// The write-only root password is only available in the configuration,
+	// and is reset whenever its version changes.
+	if !plan.RootPassWOVersion.IsNull() && !plan.RootPassWOVersion.Equal(state.RootPassWOVersion) {
+		rootPass = config.RootPassWO.ValueString()
+		pendingRootPassReset = true
+	}
+
+	updateOpts := linodego.InstanceUpdateOptions{}
+	simpleUpdate := false
+
+	if !plan.Label.Equal(state.Label) {
+		updateOpts.Label = plan.Label.ValueString()
+		simpleUpdate = true
+	}
+
+	if !plan.Group.Equal(state.Group) {
+		updateOpts.Group = linodego.Pointer(plan.Group.ValueString())
+		simpleUpdate = true
+	}
+
+	if !plan.MaintenancePolicy.Equal(state.MaintenancePolicy) && plan.MaintenancePolicy.ValueString() != "" {
+		updateOpts.MaintenancePolicy = linodego.Pointer(plan.MaintenancePolicy.ValueString())
+		simpleUpdate = true
+	}
+
+	if !plan.Tags.Equal(state.Tags) || !plan.TagsAll.Equal(state.TagsAll) {
+		tags := helper.FrameworkTagsAll(plan.Tags, r.Meta)
+		updateOpts.Tags = &tags
+		simpleUpdate = true
+	}
+
+	if !plan.WatchdogEnabled.Equal(state.WatchdogEnabled) {
+		updateOpts.WatchdogEnabled = plan.WatchdogEnabled.ValueBoolPointer()
+		simpleUpdate = true
+	}
+
+	planAlerts := fillUnknownsFromState(ctx, plan.Alerts, state.Alerts).(types.List)
+	if !planAlerts.IsUnknown() && !planAlerts.Equal(state.Alerts) {
+		if alerts := blockModels[AlertsModel](ctx, planAlerts, &resp.Diagnostics); len(alerts) > 0 {
+			updateOpts.Alerts = expandInstanceAlerts(alerts[0])
+			simpleUpdate = true
+		}
+	}
+
+	if !plan.PlacementGroupExternallyManaged.ValueBool() {
+		oldPGID, newPGID := state.placementGroupID(ctx, &resp.Diagnostics), plan.placementGroupID(ctx, &resp.Diagnostics)
+
+		if oldPGID != newPGID {
+			tflog.Debug(ctx, "Attempting Placement Group reassignment", map[string]any{
+				"old_pg_id": oldPGID,
+				"new_pg_id": newPGID,
+			})
+
+			if err := reassignPlacementGroup(
+				ctx, client, instance.ID, oldPGID, newPGID, plan.placementGroupCompliantOnly(),
+			); err != nil {
+				resp.Diagnostics.AddError("Failed to Update Linode Placement Group", err.Error())
+				return
+			}
+		}
+	}
+
+	// apply staged simple updates early
+	if simpleUpdate {
+		tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+			"options": updateOpts,
+		})
+
+		if instance, err = client.UpdateInstance(ctx, id, updateOpts); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Update Instance %d", id), err.Error())
+			return
+		}
+	}
+
+	if !plan.BackupsEnabled.IsUnknown() && !plan.BackupsEnabled.Equal(state.BackupsEnabled) {
+		backupsEnabled := plan.BackupsEnabled.ValueBool()
+
+		tflog.Info(ctx, "Updating backups enrollment", map[string]any{
+			"backups_enabled": backupsEnabled,
+		})
+
+		if backupsEnabled {
+			tflog.Debug(ctx, "client.EnableInstanceBackups(...)")
+			err = client.EnableInstanceBackups(ctx, instance.ID)
+		} else {
+			tflog.Debug(ctx, "client.CancelInstanceBackups(...)")
+			err = client.CancelInstanceBackups(ctx, instance.ID)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Update Backups Enrollment", err.Error())
+			return
+		}
+	}
+
+	rebootInstance := false
+
+	if plan.PrivateIP.ValueBool() != state.PrivateIP.ValueBool() {
+		if !plan.PrivateIP.ValueBool() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Remove Private IP Address for Instance %d", instance.ID),
+				"Removing a Private IP address must be handled through a support ticket",
+			)
+			return
+		}
+
+		tflog.Info(ctx, "client.AddInstanceIPAddress(...)", map[string]any{
+			"public": false,
+		})
+
+		if _, err := client.AddInstanceIPAddress(ctx, instance.ID, false); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Activate Private Networking on Instance %d", instance.ID), err.Error(),
+			)
+			return
+		}
+
+		rebootInstance = true
+	}
+
+	migrationType := linodego.InstanceMigrationType(plan.MigrationType.ValueString())
+
+	// If the region has changed,
+	// we should migrate the Linode.
+	if !plan.Region.Equal(state.Region) {
+		instance, err = applyInstanceMigration(ctx, &client, instance, plan.Region.ValueString(), migrationType)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Migrate Instance", err.Error())
+			return
+		}
+	}
+
+	// The legacy configuration interfaces are converted by the upgrade,
+	// so they are not updated separately.
+	upgradeInterfaces := isInterfaceGenerationUpgrade(state.InterfaceGeneration, plan.InterfaceGeneration)
+
+	if upgradeInterfaces {
+		configID, err := helper.GetCurrentBootedConfig(ctx, &client, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Get Current Booted Config", err.Error())
+			return
+		}
+
+		if label := state.BootConfigLabel.ValueString(); configID == 0 && label != "" {
+			configIDs, err := getInstanceConfigLabelIDMap(ctx, &client, id)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Get Instance Configs", err.Error())
+				return
+			}
+
+			configID = configIDs[label]
+		}
+
+		upgrade, err := upgradeInstanceInterfaces(ctx, &client, id, configID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Upgrade Instance Interfaces", err.Error())
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Instance Interfaces Upgraded",
+			fmt.Sprintf(
+				"The interfaces of config %d were upgraded to Linode interfaces, which can be imported "+
+					"as linode_interface resources using the following IDs:%s",
+				upgrade.ConfigID, formatInterfaceImportIDs(id, upgrade.Interfaces),
+			),
+		)
+
+		rebootInstance = true
+	}
+
+	oldSpec, newSpec, err := getInstanceTypeChange(ctx, &client, state.Type.ValueString(), plan.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Resize Info for Instance", err.Error())
+		return
+	}
+	upsized := newSpec.Disk > oldSpec.Disk
+
+	// Disks are computed from the API when they aren't configured,
+	// in which case the current disks are carried through unchanged.
+	oldDisks := blockModels[DiskModel](ctx, state.Disk, &resp.Diagnostics)
+	newDisks := oldDisks
+	if !plan.Disk.IsUnknown() {
+		newDisks = blockModels[DiskModel](ctx, plan.Disk, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	explicitDisks := config.Image.IsNull() && len(config.Disk.Elements()) > 0
+
+	if upsized {
+		// The linode was upsized; apply before disk changes to allocate more disk
+		if instance, err = applyInstanceTypeChange(
+			ctx, &client, instance, newSpec, plan.ResizeDisk.ValueBool(), migrationType, newDisks, explicitDisks,
+		); err != nil {
+			resp.Diagnostics.AddError("Failed to Change Instance Type", err.Error())
+			return
+		}
+		rebootInstance = true
+	}
+
+	// We only need to do this if explicit disks are defined
+	if explicitDisks {
+		if didChange, err := applyInstanceDiskSpec(ctx, &client, instance, newSpec, oldDisks, newDisks); err == nil && didChange {
+			rebootInstance = true
+		} else if err != nil && newSpec.Disk < oldSpec.Disk && plan.Disk.Equal(state.Disk) {
+			// Linode was downsized but the pre-existing disk config does not fit new instance spec
+			// This might mean the user tried to downsize an instance with an implicit, default
+			resp.Diagnostics.AddError(
+				"Failed to Apply Instance Disk Spec", fmt.Sprintf("%s. %s", err, downsizeFailedMessage),
+			)
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddError("Failed to Apply Instance Disk Spec", err.Error())
+			return
+		}
+	}
+
+	if oldSpec.ID != newSpec.ID && !upsized {
+		// linode was downsized or changed to a type with the same disk allocation
+		if instance, err = applyInstanceTypeChange(
+			ctx, &client, instance, newSpec, plan.ResizeDisk.ValueBool(), migrationType, newDisks, explicitDisks,
+		); err != nil {
+			resp.Diagnostics.AddError("Failed to Change Instance Type", err.Error())
+			return
+		}
+	}
+
+	if !plan.SwapSize.IsUnknown() && !plan.SwapSize.Equal(state.SwapSize) {
+		if err := adjustSwapSize(
+			ctx, &client, instance, int(state.SwapSize.ValueInt64()), int(plan.SwapSize.ValueInt64()),
+		); err != nil {
+			resp.Diagnostics.AddError("Failed to Adjust Swap Size", err.Error())
+			return
+		}
+
+		rebootInstance = true
+	}
+
+	diskIDLabelMap, err := getInstanceDiskLabelIDMap(ctx, client, newDisks, instance.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Disk Label to ID Mappings", err.Error())
+		return
+	}
+
+	bootConfig := 0
+	bootConfigLabel := plan.BootConfigLabel.ValueString()
+
+	var updatedConfigMap map[string]int
+	var updatedConfigs []*linodego.InstanceConfig
+
+	tfConfigsNew := blockModels[ConfigModel](
+		ctx, fillUnknownsFromState(ctx, plan.Config, state.Config).(types.List), &resp.Diagnostics,
+	)
+	tfConfigsOld := blockModels[ConfigModel](ctx, state.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(tfConfigsNew) > 0 {
+		var didChangeConfig bool
+
+		didChangeConfig, updatedConfigMap, updatedConfigs, err = updateInstanceConfigs(
+			ctx, client, *instance, tfConfigsOld, tfConfigsNew, diskIDLabelMap, bootConfigLabel)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Update Instance Configs", err.Error())
+			return
+		}
+		rebootInstance = rebootInstance || didChangeConfig
+	} else {
+		updatedConfigMap, err = getInstanceConfigLabelIDMap(ctx, &client, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Get Instance Configs", err.Error())
+			return
+		}
+	}
+
+	if bootConfigLabel != "" {
+		foundConfig, found := updatedConfigMap[bootConfigLabel]
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("boot_config_label"),
+				"Failed to Set Boot Config Label",
+				fmt.Sprintf("Config label '%s' not found", bootConfigLabel),
+			)
+			return
+		}
+
+		bootConfig = foundConfig
+	} else if len(updatedConfigs) > 0 {
+		bootConfig = updatedConfigs[0].ID
+	}
+
+	booted := config.Booted
+
+	// Detect whether the interface update requires a power-off (VPC interface change).
+	// The actual shutdown and interface config application are deferred to the
+	// unified offline-required block below so that all offline-required operations
+	// (root_pass reset, VPC interface updates, etc.) share a single power cycle.
+	var pendingInterfaceUpdate bool
+	var expandedInterfaces []linodego.InstanceConfigInterfaceCreateOptions
+	var interfacePowerOffRequired bool
+
+	expandedInterfaces = expandInterfaceModels(
+		ctx, fillUnknownsFromState(ctx, plan.Interface, state.Interface).(types.List), &resp.Diagnostics,
+	)
+	existingInterfaces := expandInterfaceModels(ctx, state.Interface, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !upgradeInterfaces && !reflect.DeepEqual(expandedInterfaces, existingInterfaces) {
+		pendingInterfaceUpdate = true
+
+		bootInstanceConfig, err := client.GetInstanceConfig(ctx, id, bootConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Get Config %d", bootConfig), err.Error())
+			return
+		}
+
+		interfacePowerOffRequired = VPCInterfaceIncluded(bootInstanceConfig.Interfaces, expandedInterfaces)
+
+		if !interfacePowerOffRequired {
+			// Interface change that does NOT require a power cycle can be applied immediately.
+			tflog.Debug(ctx, "Updating instance config for interface changes (no power-off needed)", map[string]any{
+				"config_id": bootConfig,
+			})
+
+			configUpdateOpts := linodego.InstanceConfigUpdateOptions{
+				Interfaces: expandedInterfaces,
+			}
+
+			if _, err := client.UpdateInstanceConfig(ctx, id, bootConfig, configUpdateOpts); err != nil {
+				resp.Diagnostics.AddError("Failed to Set Boot Config Interfaces", err.Error())
+				return
+			}
+
+			rebootInstance = true
+			pendingInterfaceUpdate = false
+		}
+	}
+
+	// All operations that require the instance to be powered off are handled here
+	// in a single shutdown/boot cycle: root_pass reset, VPC interface updates, etc.
+	needsOffline := pendingRootPassReset || (pendingInterfaceUpdate && interfacePowerOffRequired)
+
+	if needsOffline {
+		// Wait for the instance to reach a non-transient state (e.g., not booting,
+		// rebooting, or shutting_down) before checking its status. This ensures we
+		// don't skip shutdown for an instance that is effectively still online.
+		settledStatus, err := helper.WaitForInstanceNonTransientStatus(ctx, &client, id, getDeadlineSeconds(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Linode Instance %d to Reach a Non-Transient State", id),
+				err.Error(),
+			)
+			return
+		}
+
+		wasRunning := settledStatus == linodego.InstanceRunning
+
+		reason := "offline-required update"
+		if pendingRootPassReset && pendingInterfaceUpdate {
+			reason = "root password reset and VPC interface update"
+		} else if pendingRootPassReset {
+			reason = "root password reset"
+		} else if pendingInterfaceUpdate {
+			reason = "VPC interface update"
+		}
+
+		// Power off if not already offline
+		if settledStatus != linodego.InstanceOffline {
+			if err := ShutdownInstanceForOfflineOperation(
+				ctx, &client, skipImplicitReboots, id, getDeadlineSeconds(ctx), reason,
+			); err != nil {
+				resp.Diagnostics.AddError("Failed to Shut Down Instance", err.Error())
+				return
+			}
+		}
+
+		// Apply root_pass reset while offline
+		if pendingRootPassReset {
+			tflog.Debug(ctx, "Resetting root password while instance is offline")
+			if err := client.ResetInstancePassword(
+				ctx, id, linodego.InstancePasswordResetOptions{RootPass: rootPass},
+			); err != nil {
+				resp.Diagnostics.AddError("Failed to Reset Root Password", err.Error())
+				return
+			}
+		}
+
+		// Apply VPC interface update while offline
+		if pendingInterfaceUpdate && interfacePowerOffRequired {
+			tflog.Debug(ctx, "Updating instance config for interface changes (offline)", map[string]any{
+				"config_id": bootConfig,
+			})
+
+			configUpdateOpts := linodego.InstanceConfigUpdateOptions{
+				Interfaces: expandedInterfaces,
+			}
+
+			if _, err := client.UpdateInstanceConfig(ctx, id, bootConfig, configUpdateOpts); err != nil {
+				resp.Diagnostics.AddError("Failed to Set Boot Config Interfaces", err.Error())
+				return
+			}
+		}
+
+		// Boot back up if it was running and booted isn't explicitly false.
+		// Otherwise, it will stay off or be handled by `handleBootedUpdate`.
+		if wasRunning && (booted.IsNull() || booted.ValueBool()) {
+			tflog.Debug(ctx, fmt.Sprintf("Booting instance after %s", reason))
+
+			if err := helper.BootInstanceSync(ctx, &client, id, bootConfig, getDeadlineSeconds(ctx)); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Failed to Boot Instance After %s", reason), err.Error())
+				return
+			}
+		}
+
+		// Refresh instance after the offline operations
+		instance, err = client.GetInstance(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Get Linode Instance", err.Error())
+			return
+		}
+
+		// reboot won't be needed since we already handled the power cycle
+		rebootInstance = false
+	}
+
+	if !plan.SharedIPv4.IsUnknown() && !plan.SharedIPv4.Equal(state.SharedIPv4) {
+		sharedIPv4 := make([]string, 0, len(plan.SharedIPv4.Elements()))
+		resp.Diagnostics.Append(plan.SharedIPv4.ElementsAs(ctx, &sharedIPv4, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		shareOpts := linodego.IPAddressesShareOptions{
+			IPs:      sharedIPv4,
+			LinodeID: instance.ID,
+		}
+
+		tflog.Debug(ctx, "client.ShareIPAddresses(...)", map[string]any{
+			"options": shareOpts,
+		})
+
+		if err := client.ShareIPAddresses(ctx, shareOpts); err != nil {
+			resp.Diagnostics.AddError("Failed to Share IPv4 Addresses with Instance", err.Error())
+			return
+		}
+	}
+
+	// Don't reboot if the Linode should be powered off
+	if !booted.IsNull() && !booted.ValueBool() {
+		rebootInstance = false
+	}
+
+	// Only reboot the instance if implicit reboots are not skipped
+	if skipImplicitReboots {
+		rebootInstance = false
+	}
+
+	if rebootInstance && len(diskIDLabelMap) > 0 && len(updatedConfigMap) > 0 && bootConfig > 0 {
+		ctx := tflog.SetField(ctx, "config_id", bootConfig)
+
+		tflog.Info(ctx, "Implicitly rebooting instance")
+
+		p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeReboot)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "client.RebootInstance(...)")
+
+		if err := client.RebootInstance(ctx, instance.ID, bootConfig); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Reboot Instance %d", instance.ID), err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Waiting for instance reboot to complete")
+
+		if _, err := p.WaitForFinished(ctx, getDeadlineSeconds(ctx)); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Instance %d to Finish Rebooting", instance.ID),
+				describeInstanceWaitFailure(ctx, &client, r.Meta.Config.LishPrivateKey, instance.ID, err),
+			)
+			return
+		}
+
+		tflog.Debug(ctx, "Instance has finished rebooting")
+
+		if _, err := client.WaitForInstanceStatus(
+			ctx, instance.ID, linodego.InstanceRunning, getDeadlineSeconds(ctx),
+		); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Timed Out Waiting for Linode Instance %d to Boot", instance.ID),
+				describeInstanceWaitFailure(ctx, &client, r.Meta.Config.LishPrivateKey, instance.ID, err),
+			)
+			return
+		}
+	}
+
+	if err := handleBootedUpdate(ctx, client, booted, instance.ID, bootConfig); err != nil {
+		resp.Diagnostics.AddError("Failed to Handle Booted Update", err.Error())
+		return
+	}
+
+	if !r.refresh(ctx, &plan, id, true, &resp.Diagnostics) {
+		resp.Diagnostics.AddError(
+			"Linode Instance Not Found",
+			fmt.Sprintf("Linode Instance %d was not found after update", id),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, LinodeInstanceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", id)
+
+	p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeDelete)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteInstance(...)")
+
+	if err := client.DeleteInstance(ctx, id); err != nil {
+		if !linodego.IsNotFound(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to Delete Linode Instance %d", id), err.Error())
+		}
+		return
+	}
+
+	if !r.Meta.Config.SkipInstanceDeletePoll.ValueBool() {
+		// Wait for full deletion to assure volumes are detached
+		if _, err := p.WaitForFinished(ctx, getDeadlineSeconds(ctx)); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Wait for Instance %d to be Deleted", id), err.Error(),
+			)
+		}
+	}
+}
+
+// refresh updates the given model with the current state of the instance,
+// returning false if the instance no longer exists.
+func (r *Resource) refresh(
+	ctx context.Context,
+	data *ResourceModel,
+	id int,
+	preserveKnown bool,
+	diags *diag.Diagnostics,
+) bool {
+	client := r.Meta.Client
+
+	instance, err := client.GetInstance(ctx, id)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			return false
+		}
+
+		diags.AddError("Failed to Get Linode Instance", err.Error())
+		return true
+	}
+
+	network, err := client.GetInstanceIPAddresses(ctx, id)
+	if err != nil {
+		diags.AddError("Failed to Get Instance Networking", err.Error())
+		return true
+	}
+
+	disks, err := client.ListInstanceDisks(ctx, id, nil)
+	if err != nil {
+		diags.AddError("Failed to Get Instance Disks", err.Error())
+		return true
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, id, nil)
+	if err != nil {
+		diags.AddError("Failed to Get Instance Configs", err.Error())
+		return true
+	}
+
+	priorTags := data.Tags
+
+	data.FlattenInstance(ctx, instance, network, disks, configs, preserveKnown, diags)
+	helper.FrameworkFlattenTags(&data.Tags, &data.TagsAll, priorTags, instance.Tags, r.Meta, preserveKnown, diags)
+	helper.SetCost(ctx, &data.HourlyCost, &data.MonthlyCost, data.Cost(ctx, client))
+
+	return true
+}
+
+// adjustSwapSize resizes the underlying main/swap disks on the instance to match
+// the declared swap size allocation.
+func adjustSwapSize(
+	ctx context.Context, client *linodego.Client, instance *linodego.Instance, oldSwap, newSwap int,
+) error {
+	// If the swap_size attribute is set, there are two default disks attached to the instance (the main disk of type ext4
+	// and a swap disk), as custom disk configuration via "disk" nested attributes conflicts with the swap_size.
+	bootDisk, swapDisk, err := getInstanceDefaultDisks(ctx, instance.ID, client)
+	if err != nil {
+		return err
+	}
+
+	diff := newSwap - oldSwap
+	newBootDiskSize := bootDisk.Size - diff
+
+	toResize := []struct {
+		size int
+		disk *linodego.InstanceDisk
+	}{
+		{
+			size: newBootDiskSize,
+			disk: bootDisk,
+		},
+		{
+			size: newSwap,
+			disk: swapDisk,
+		},
+	}
+
+	if bootDisk.Size < newBootDiskSize {
+		// swap disk needs to be downsized first to upsize main disk
+		toResize[0], toResize[1] = toResize[1], toResize[0]
+	}
+
+	for _, resizeOp := range toResize {
+		if err := changeInstanceDiskSize(ctx, client, *instance, *resizeOp.disk, resizeOp.size); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findDiskByFS(disks []linodego.InstanceDisk, fs linodego.DiskFilesystem) *linodego.InstanceDisk {
+	for _, disk := range disks {
+		if disk.Filesystem == fs {
+			return &disk
+		}
+	}
+	return nil
+}
+
+// plannedChangesReverted returns whether the given plan only differs from the prior state
+// in unknown values of attributes which are null in the configuration.
+func plannedChangesReverted(plan, state, config tftypes.Value) bool {
+	if plan.IsNull() || state.IsNull() || plan.Equal(state) {
+		return false
+	}
+
+	reverted, err := tftypes.Transform(plan, func(p *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsKnown() {
+			return value, nil
+		}
+
+		if configValue, _, err := tftypes.WalkAttributePath(config, p); err == nil {
+			if configValue, ok := configValue.(tftypes.Value); !ok || !configValue.IsNull() {
+				return value, errPlannedChange
+			}
+		}
+
+		stateValue, _, err := tftypes.WalkAttributePath(state, p)
+		if err != nil {
+			return value, errPlannedChange
+		}
+
+		return stateValue.(tftypes.Value), nil
+	})
+
+	return err == nil && reverted.Equal(state)
+}
+
+var errPlannedChange = errors.New("planned change")
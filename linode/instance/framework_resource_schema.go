@@ -0,0 +1,2336 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/boolplanmodifiers"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/customtypes"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/int64planmodifiers"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/listplanmodifiers"
+	linodesetplanmodifiers "github.com/linode/terraform-provider-linode/v3/linode/helper/setplanmodifiers"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/stringplanmodifiers"
+)
+
+var specsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"disk":                types.Int64Type,
+		"memory":              types.Int64Type,
+		"vcpus":               types.Int64Type,
+		"transfer":            types.Int64Type,
+		"accelerated_devices": types.Int64Type,
+		"gpus":                types.Int64Type,
+	},
+}
+
+var backupsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"available": types.BoolType,
+		"enabled":   types.BoolType,
+		"schedule": types.ListType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"day":    types.StringType,
+					"window": types.StringType,
+				},
+			},
+		},
+	},
+}
+
+var resourceAlertsNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"cpu": schema.Int64Attribute{
+			Description: "The percentage of CPU usage required to trigger an alert. If the average CPU usage " +
+				"over two hours exceeds this value, we'll send you an alert. If this is set to 0, the alert is disabled.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"network_in": schema.Int64Attribute{
+			Description: "The amount of incoming traffic, in Mbit/s, required to trigger an alert. If the average " +
+				"incoming traffic over two hours exceeds this value, we'll send you an alert. " +
+				"If this is set to 0 (zero), the alert is disabled.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"network_out": schema.Int64Attribute{
+			Description: "The amount of outbound traffic, in Mbit/s, required to trigger an alert. " +
+				"If the average outbound traffic over two hours exceeds this value, we'll send you an alert. " +
+				"If this is set to 0 (zero), the alert is disabled.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"transfer_quota": schema.Int64Attribute{
+			Description: "The percentage of network transfer that may be used before an alert is triggered. " +
+				"When this value is exceeded, we'll alert you. " +
+				"If this is set to 0 (zero), the alert is disabled.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+		"io": schema.Int64Attribute{
+			Description: "The amount of disk IO operation per second required to trigger an alert. " +
+				"If the average disk IO over two hours exceeds this value, we'll send you an alert. " +
+				"If set to 0, this alert is disabled.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+		},
+	},
+}
+
+var resourcePlacementGroupNestedObject = schema.NestedBlockObject{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The ID of the Placement Group to assign this Linode to.",
+			Required:    true,
+		},
+		"compliant_only": schema.BoolAttribute{
+			Optional: true,
+		},
+		"label": schema.StringAttribute{
+			Description: "The label of this Placement Group.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("id"),
+			},
+		},
+		"placement_group_type": schema.StringAttribute{
+			Description: "The placement group type for this Placement Group.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("id"),
+			},
+		},
+		"placement_group_policy": schema.StringAttribute{
+			Description: "Whether compliance is strictly enforced by this Placement Group.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOfCaseInsensitive("strict", "flexible"),
+			},
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("id"),
+			},
+		},
+	},
+}
+
+var resourceMetadataNestedObject = schema.NestedBlockObject{
+	Attributes: map[string]schema.Attribute{
+		"user_data": schema.StringAttribute{
+			Description: "The base64-encoded user-defined data exposed to this instance " +
+				"through the Linode Metadata service. Refer to the base64encode(...) function " +
+				"for information on encoding content for this field.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				requiresReplaceStringUnlessZero(),
+			},
+			Validators: []validator.String{
+				userDataValidator{},
+			},
+		},
+	},
+}
+
+var resourceInterfaceSLAACNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"range": schema.StringAttribute{
+			Description: "A SLAAC prefix to add to this interface, " +
+				"or `auto` for a new IPv6 prefix to be automatically allocated.",
+			Optional:   true,
+			CustomType: customtypes.LinodeAutoAllocRangeType{},
+		},
+		"assigned_range": schema.StringAttribute{
+			Description: "The value of `range` computed by the API. " +
+				"This is necessary when needing to access the range " +
+				"implicitly allocated using `auto`.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("range"),
+			},
+		},
+		"address": schema.StringAttribute{
+			Description: "The SLAAC address chosen for this interface.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("range"),
+			},
+		},
+	},
+}
+
+var resourceInterfaceRangeNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"range": schema.StringAttribute{
+			Description: "A prefix to add to this interface, " +
+				"or `auto` for a new IPv6 prefix to be automatically allocated.",
+			Optional:   true,
+			CustomType: customtypes.LinodeAutoAllocRangeType{},
+		},
+		"assigned_range": schema.StringAttribute{
+			Description: "The value of `range` computed by the API. " +
+				"This is necessary when needing to access the range " +
+				"implicitly allocated using `auto`.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("range"),
+			},
+		},
+	},
+}
+
+var resourceInterfaceIPv4NestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"vpc": schema.StringAttribute{
+			Description: "The IP from the VPC subnet to use for this interface.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"nat_1_1": schema.StringAttribute{
+			Description: "The public IP that will be used for the " +
+				"one-to-one NAT purpose.",
+			Optional: true,
+			Computed: true,
+		},
+	},
+}
+
+var resourceInterfaceIPv6NestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"is_public": schema.BoolAttribute{
+			Description: "If true, connections from the interface to IPv6 addresses outside the VPC, " +
+				"and connections from IPv6 addresses outside the VPC to the interface will be permitted.",
+			Optional: true,
+			Computed: true,
+		},
+		"slaac": schema.ListNestedAttribute{
+			Description:  "An array of SLAAC prefixes to use for this interface.",
+			Optional:     true,
+			Computed:     true,
+			NestedObject: resourceInterfaceSLAACNestedObject,
+		},
+		"range": schema.ListNestedAttribute{
+			Description:  "An array of SLAAC prefixes to use for this interface.",
+			Optional:     true,
+			Computed:     true,
+			NestedObject: resourceInterfaceRangeNestedObject,
+		},
+	},
+}
+
+var resourceConfigHelpersNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"updatedb_disabled": schema.BoolAttribute{
+			Description: "Disables updatedb cron job to avoid disk thrashing.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				defaultBoolIfParentConfigured(true),
+			},
+		},
+		"distro": schema.BoolAttribute{
+			Description: "Controls the behavior of the Linode Config's Distribution Helper setting.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				defaultBoolIfParentConfigured(true),
+			},
+		},
+		"modules_dep": schema.BoolAttribute{
+			Description: "Creates a modules dependency file for the Kernel you run.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				defaultBoolIfParentConfigured(true),
+			},
+		},
+		"network": schema.BoolAttribute{
+			Description: "Controls the behavior of the Linode Config's Network Helper setting, " +
+				"used to automatically configure additional IP addresses assigned to this instance.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				defaultBoolIfParentConfigured(true),
+			},
+		},
+		"devtmpfs_automount": schema.BoolAttribute{
+			Description: "Populates the /dev directory early during boot without udev. Defaults to false.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				defaultBoolIfParentConfigured(false),
+			},
+		},
+	},
+}
+
+var resourceConfigDevicesNestedObject = schema.NestedAttributeObject{
+	Attributes: resourceDeviceAttributes(),
+}
+
+// resourceInterfaceAttributes are the attributes of the interfaces of the resource,
+// which are configured either as blocks or as a nested attribute of a config.
+var resourceInterfaceAttributes = map[string]schema.Attribute{
+	"purpose": schema.StringAttribute{
+		Description: "The type of interface.",
+		Required:    true,
+	},
+	"ipam_address": schema.StringAttribute{
+		Description: "This Network Interface's private IP address in " +
+			"Classless Inter-Domain Routing (CIDR) notation." +
+			onlyAllowedForVLANMsg,
+		Optional: true,
+	},
+	"label": schema.StringAttribute{
+		Description: "The name of the VALN. " + requiredForVLANMsg +
+			" " + onlyAllowedForVLANMsg,
+		Optional: true,
+	},
+	"id": schema.Int64Attribute{
+		Description: "The ID of the interface.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			useStateForUnknownInt64IfUnchanged("purpose", "subnet_id"),
+		},
+	},
+	"subnet_id": schema.Int64Attribute{
+		Description: "The ID of the subnet which the VPC interface is connected to." +
+			requiredForVPCMsg + onlyAllowedForVPCMsg,
+		Optional: true,
+	},
+	"vpc_id": schema.Int64Attribute{
+		Description: "The ID of VPC of the subnet which the VPC " +
+			"interface is connected to.",
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			useStateForUnknownInt64IfUnchanged("purpose", "subnet_id"),
+		},
+	},
+	"primary": schema.BoolAttribute{
+		Description: "Whether the interface is the primary interface that should " +
+			"have the default route for this Linode.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			defaultBoolIfParentConfigured(false),
+		},
+	},
+	"active": schema.BoolAttribute{
+		Description: "Whether this interface is currently booted and active.",
+		Computed:    true,
+	},
+	"ip_ranges": schema.ListAttribute{
+		Description: "List of VPC IPs or IP ranges inside the VPC subnet.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(ipRangeValidator{}),
+		},
+	},
+	"ipv4": schema.ListNestedAttribute{
+		Description: "The IPv4 configuration of the VPC interface." +
+			onlyAllowedForVPCMsg,
+		Optional: true,
+		Computed: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: resourceInterfaceIPv4NestedObject,
+		PlanModifiers: []planmodifier.List{
+			useStateForUnknownListIfUnchanged("purpose", "subnet_id"),
+		},
+	},
+	"ipv6": schema.ListNestedAttribute{
+		Description: "The IPv6 configuration of the VPC interface. " +
+			onlyAllowedForVPCMsg,
+		Optional: true,
+		Computed: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: resourceInterfaceIPv6NestedObject,
+		PlanModifiers: []planmodifier.List{
+			useStateForUnknownListIfUnchanged("purpose", "subnet_id"),
+		},
+	},
+}
+
+var resourceInterfaceNestedObject = schema.NestedBlockObject{
+	Attributes: resourceInterfaceAttributes,
+}
+
+var resourceConfigInterfaceNestedObject = schema.NestedAttributeObject{
+	Attributes: resourceInterfaceAttributes,
+}
+
+var resourceDeviceNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"disk_label": schema.StringAttribute{
+			Description: "The `label` of the `disk` to map to this `device` slot.",
+			Optional:    true,
+		},
+		"disk_id": schema.Int64Attribute{
+			Description: "The Disk ID to map to this disk slot",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				useStateForUnknownInt64IfUnchanged("disk_label"),
+			},
+		},
+		"volume_id": schema.Int64Attribute{
+			Description: "The Block Storage volume ID to map to this disk slot",
+			Optional:    true,
+		},
+	},
+}
+
+var resourceConfigNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The unique ID of this Config.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				useStateForUnknownInt64IfUnchanged("label"),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The Config's label for display purposes.  Also used by `boot_config_label`.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 48),
+			},
+		},
+		"kernel": schema.StringAttribute{
+			Description: "A Kernel ID to boot a Linode with. Default is based on image choice. " +
+				"(examples: linode/latest-64bit, linode/grub2, linode/direct-disk)",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("label"),
+			},
+		},
+		"run_level": schema.StringAttribute{
+			Description: "Defines the state of your Linode after booting. Defaults to default.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				defaultStringIfParentConfigured("default"),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf("default", "single", "binbash"),
+			},
+		},
+		"virt_mode": schema.StringAttribute{
+			Description: "Controls the virtualization mode. Defaults to paravirt.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				defaultStringIfParentConfigured("paravirt"),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf("paravirt", "fullvirt"),
+			},
+		},
+		"root_device": schema.StringAttribute{
+			Description: "The root device to boot. The corresponding disk must be attached.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("label"),
+			},
+		},
+		"comments": schema.StringAttribute{
+			Description: "Optional field for arbitrary User comments on this Config.",
+			Optional:    true,
+		},
+		"memory_limit": schema.Int64Attribute{
+			Description: "Defaults to the total RAM of the Linode",
+			Optional:    true,
+		},
+		"helpers": schema.ListNestedAttribute{
+			Description: "Helpers enabled when booting to this Linode Config.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: resourceConfigHelpersNestedObject,
+			PlanModifiers: []planmodifier.List{
+				useStateForUnknownListIfUnchanged("label"),
+			},
+		},
+		"devices": schema.ListNestedAttribute{
+			Description: "Device sda-sdbl can be either a Disk or Volume identified by disk_label or volume_id. " +
+				"Only one type per slot allowed.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: resourceConfigDevicesNestedObject,
+			PlanModifiers: []planmodifier.List{
+				useStateForUnknownListIfUnchanged("label"),
+			},
+		},
+		"interface": schema.ListNestedAttribute{
+			Description:  "An array of Network Interfaces for this Linode’s Configuration Profile.",
+			Optional:     true,
+			NestedObject: resourceConfigInterfaceNestedObject,
+		},
+	},
+}
+
+var resourceDiskNestedObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"label": schema.StringAttribute{
+			Description: "The disks label, which acts as an identifier in Terraform.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 48),
+			},
+		},
+		"size": schema.Int64Attribute{
+			Description: "The size of the Disk in MB.",
+			Required:    true,
+		},
+		"id": schema.Int64Attribute{
+			Description: "The ID of the Disk (for use in Linode Image resources and Linode Instance Config Devices)",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				useStateForUnknownInt64IfUnchanged("label"),
+			},
+		},
+		"filesystem": schema.StringAttribute{
+			Description: "The Disk filesystem can be one of: raw, swap, ext3, ext4, initrd (max 32mb)",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("raw", "swap", "ext3", "ext4", "initrd"),
+			},
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("label"),
+				requiresReplaceStringIfUnchanged("label"),
+			},
+		},
+		"read_only": schema.BoolAttribute{
+			Description: "If true, this Disk is read-only.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				useStateForUnknownBoolIfUnchanged("label"),
+				requiresReplaceBoolIfUnchanged("label"),
+			},
+		},
+		// The API does not return the following fields for existing disks,
+		// so they are only used when a disk is created.
+		"image": schema.StringAttribute{
+			Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, " +
+				"while your Images start with private/.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownStringIfUnchanged("label"),
+			},
+		},
+		"authorized_keys": schema.ListAttribute{
+			Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. " +
+				"Only accepted if 'image' is provided.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"authorized_users": schema.ListAttribute{
+			Description: "A list of Linode usernames. If the usernames have associated SSH keys, " +
+				"the keys will be appended to the `root` user's `~/.ssh/authorized_keys` file automatically. " +
+				"Only accepted if 'image' is provided.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"stackscript_id": schema.Int64Attribute{
+			Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' " +
+				"must also be provided, and must be an Image that is compatible with this StackScript.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				useStateForUnknownInt64IfUnchanged("label"),
+			},
+		},
+		"stackscript_data": schema.MapAttribute{
+			Description: "An object containing responses to any User Defined Fields present in the " +
+				"StackScript being deployed to this Linode. Only accepted if 'stackscript_id' is given. " +
+				"The required values depend on the StackScript being deployed.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"root_pass": schema.StringAttribute{
+			Description: "The password that will be initialially assigned to the 'root' user account.",
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(helper.RootPassMinimumCharacters, helper.RootPassMaximumCharacters),
+			},
+			PlanModifiers: []planmodifier.String{
+				useStateForHashedRootPass(),
+			},
+		},
+	},
+}
+
+var frameworkResourceSchema = schema.Schema{
+	Version: 1,
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode instance.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"image": schema.StringAttribute{
+			Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, " +
+				"while your Images start with private/. See /images for more information on the Images available " +
+				"for you to use.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("backup_id")),
+			},
+			PlanModifiers: []planmodifier.String{
+				requiresReplaceStringUnlessZero(),
+			},
+		},
+		"backup_id": schema.Int64Attribute{
+			Description: "A Backup ID from another Linode's available backups. Your User must have read_write " +
+				"access to that Linode, the Backup must have a status of successful, and the Linode must be deployed " +
+				"to the same region as the Backup. See /linode/instances/{linodeId}/backups for a Linode's available " +
+				"backups. This field and the image field are mutually exclusive.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(path.MatchRoot("image")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				requiresReplaceInt64UnlessZero(),
+			},
+		},
+		"stackscript_id": schema.Int64Attribute{
+			Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' must also be " +
+				"provided, and must be an Image that is compatible with this StackScript.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("image")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				requiresReplaceInt64UnlessZero(),
+			},
+		},
+		"stackscript_data": schema.MapAttribute{
+			Description: "An object containing responses to any User Defined Fields present in the StackScript " +
+				"being deployed to this Linode. Only accepted if 'stackscript_id' is given. The required values depend " +
+				"on the StackScript being deployed.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.Map{
+				mapvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+			PlanModifiers: []planmodifier.Map{
+				requiresReplaceMapUnlessZero(),
+			},
+		},
+		"stackscript_data_wo": schema.MapAttribute{
+			Description: "A write-only object containing responses to any User Defined Fields present in the " +
+				"StackScript being deployed to this Linode. This value is never stored in the state.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.Map{
+				mapvalidator.ConflictsWith(path.MatchRoot("stackscript_data")),
+				mapvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"stackscript_data_wo_version": schema.Int64Attribute{
+			Description: "The version of stackscript_data_wo. Changing this value forces the creation " +
+				"of a new Linode Instance.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("stackscript_data_wo")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifiers.RequiresReplaceIfVersionChanged(),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The Linode's label is for display purposes only. If no label is provided for a Linode, " +
+				"a default will be assigned",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(3, 50),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group": schema.StringAttribute{
+			Description:        "The display group of the Linode instance.",
+			DeprecationMessage: "Group label is deprecated. We recommend using tags instead.",
+			Optional:           true,
+		},
+		"tags": schema.SetAttribute{
+			Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				linodesetplanmodifiers.CaseInsensitiveSet(),
+			},
+			Default: helper.EmptySetDefault(types.StringType),
+		},
+		"tags_all": schema.SetAttribute{
+			Description: "All tags applied to this object, including the default tags of the provider.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"capabilities": schema.SetAttribute{
+			Description: "A list of capabilities of this Linode instance.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"locks": schema.SetAttribute{
+			Description: "A list of locks applied to this Linode.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"boot_config_label": schema.StringAttribute{
+			Description: "The Label of the Instance Config that should be used to boot the Linode instance.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"region": schema.StringAttribute{
+			Description: "This is the location where the Linode was deployed. Changing this migrates the Linode " +
+				"to the new region in place, preserving its ID and disks.",
+			Required: true,
+		},
+		"maintenance_policy": schema.StringAttribute{
+			Description: "This is the maintenance type of the Linode instance. If not provided, " +
+				"the default policy of the account will be applied.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of instance to be deployed, determining the price and size.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("g6-standard-1"),
+		},
+		"resize_disk": schema.BoolAttribute{
+			Description: "If true, changes in Linode type will attempt to upsize or downsize implicitly created disks. " +
+				"This must be false if explicit disks are defined. This is an irreversible action as Linode disks cannot " +
+				"be automatically downsized.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			Validators: []validator.Bool{
+				boolvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"migration_type": schema.StringAttribute{
+			Description: "The type of migration to use for resize and migration operations.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("cold"),
+			Validators: []validator.String{
+				stringvalidator.OneOfCaseInsensitive("cold", "warm"),
+			},
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the instance, indicating the current readiness state.",
+			Computed:    true,
+		},
+		"ip_address": schema.StringAttribute{
+			Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this " +
+				"Instance, an arbitrary address will be used for this field.",
+			Computed: true,
+			DeprecationMessage: "The `ip_address` attribute in linode_instance resource is deprecated. " +
+				"Please consider using the `ipv4` set attribute in the same resource or a " +
+				"`linode_instance_networking` data source instead.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifiers.UseStateForUnknownUnlessTheseChanged(path.MatchRoot("region")),
+			},
+		},
+		"ipv6": schema.StringAttribute{
+			Description: "This Linode's IPv6 SLAAC addresses. This address is specific to a Linode, and may not be shared.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifiers.UseStateForUnknownUnlessTheseChanged(path.MatchRoot("region")),
+			},
+		},
+		"ipv4": schema.SetAttribute{
+			Description: "This Linode's IPv4 Addresses. Each Linode is assigned a single public IPv4 address upon " +
+				"creation, and may get a single private IPv4 address if needed. You could pass a reserved IPv4 address " +
+				"here to create a linode with a particular reserved IP address. You may need to open a support ticket " +
+				"to get additional IPv4 addresses.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				linodesetplanmodifiers.UseStateForUnknownUnlessTheseChanged(
+					path.MatchRoot("region"), path.MatchRoot("private_ip"),
+				),
+				requiresReplaceSetIfConfigured(),
+			},
+		},
+		"private_ip": schema.BoolAttribute{
+			Description: "If true, the created Linode will have private networking enabled, allowing use of the " +
+				"192.168.128.0/17 network within the Linode's region.",
+			Optional: true,
+		},
+		"private_ip_address": schema.StringAttribute{
+			Description: "This Linode's Private IPv4 Address.  The regional private IP address range is " +
+				"192.168.128/17 address shared by all Linode Instances in a region.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifiers.UseStateForUnknownUnlessTheseChanged(
+					path.MatchRoot("region"), path.MatchRoot("private_ip"),
+				),
+			},
+		},
+		"authorized_keys": schema.ListAttribute{
+			Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. " +
+				"Only accepted if 'image' is provided.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("image")),
+				listvalidator.NoNullValues(),
+			},
+			PlanModifiers: []planmodifier.List{
+				requiresReplaceListUnlessZero(),
+			},
+		},
+		"authorized_users": schema.ListAttribute{
+			Description: "A list of Linode usernames. If the usernames have associated SSH keys, the keys will " +
+				"be appended to the `root` user's `~/.ssh/authorized_keys` file automatically. Only accepted if " +
+				"'image' is provided.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.AlsoRequires(path.MatchRoot("image")),
+				listvalidator.NoNullValues(),
+			},
+			PlanModifiers: []planmodifier.List{
+				requiresReplaceListUnlessZero(),
+			},
+		},
+		"root_pass": schema.StringAttribute{
+			Description: "The password that will be initially assigned to the 'root' user account.",
+			Optional:    true,
+			Sensitive:   true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(helper.RootPassMinimumCharacters, helper.RootPassMaximumCharacters),
+			},
+			PlanModifiers: []planmodifier.String{
+				useStateForHashedRootPass(),
+			},
+		},
+		"root_pass_wo": schema.StringAttribute{
+			Description: "A write-only password that will be initially assigned to the 'root' user account. " +
+				"This value is never stored in the state. Update root_pass_wo_version to reset the password.",
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(helper.RootPassMinimumCharacters, helper.RootPassMaximumCharacters),
+				stringvalidator.ConflictsWith(path.MatchRoot("root_pass")),
+				stringvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"root_pass_wo_version": schema.Int64Attribute{
+			Description: "The version of root_pass_wo. Changing this value resets the root password " +
+				"to the current value of root_pass_wo.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("root_pass_wo")),
+			},
+		},
+		"swap_size": schema.Int64Attribute{
+			Description: "When deploying from an Image, this field is optional with a Linode API default of " +
+				"512mb, otherwise it is ignored. This is used to set the swap disk size for the newly-created Linode.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("image")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"backups_enabled": schema.BoolAttribute{
+			Description: "If this field is set to true, the created Linode will automatically be enrolled in the " +
+				"Linode Backup service. This will incur an additional charge. The cost for the Backup service is " +
+				"dependent on the Type of Linode deployed.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"watchdog_enabled": schema.BoolAttribute{
+			Description: "The watchdog, named Lassie, is a Shutdown Watchdog that monitors your Linode and will " +
+				"reboot it if it powers off unexpectedly. It works by issuing a boot job when your Linode powers off " +
+				"without a shutdown job being responsible. To prevent a loop, Lassie will give up if there have been " +
+				"more than 5 boot jobs issued within 15 minutes.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+		"host_uuid": schema.StringAttribute{
+			Description: "The Linode’s host machine, as a UUID.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifiers.UseStateForUnknownUnlessTheseChanged(
+					path.MatchRoot("region"), path.MatchRoot("type"),
+				),
+			},
+		},
+		"booted": schema.BoolAttribute{
+			Description: "If true, then the instance is kept or converted into in a running state. " +
+				"If false, the instance will be shutdown.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"firewall_id": schema.Int64Attribute{
+			Description: "The ID of the firewall applied to the Linode " +
+				"instance during creation.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				requiresReplaceInt64UnlessZero(),
+			},
+		},
+		"shared_ipv4": schema.SetAttribute{
+			Description: "A set of IPv4 addresses to share with this Linode.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(ipv4AddressValidator{}),
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"network_helper": schema.BoolAttribute{
+			Description: "Whether Network Helper should be enabled for this instance.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Bool{
+				requiresReplaceBoolUnlessZero(),
+			},
+		},
+		"placement_group_externally_managed": schema.BoolAttribute{
+			Description: "If true, this placement group's assignment is externally managed and will " +
+				"NOT be updated by this resource.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"interface_generation": schema.StringAttribute{
+			Description: "Specifies the interface type for the Linode. " +
+				"The default value is determined by the interfaces_for_new_linodes " +
+				"setting in the account settings. " +
+				"If the interface_generation option is set to linode, " +
+				"legacy configuration interfaces can no longer be used on the Linode. " +
+				"Changing this from legacy_config to linode upgrades the interfaces of the Linode in place. " +
+				"NOTE: Linode Interfaces may not currently be available to all users.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				requiresReplaceInterfaceGenerationUnlessUpgrade(),
+			},
+		},
+		"has_user_data": schema.BoolAttribute{
+			Description: "Whether or not this Instance was created with user-data.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"disk_encryption": schema.StringAttribute{
+			Description: "The disk encryption policy for this Instance.",
+			// This is computed to prevent instances created pre-disk-encryption from being recreated.
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("enabled", "disabled"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				requiresReplaceStringIfConfigured(),
+			},
+		},
+		"lke_cluster_id": schema.Int64Attribute{
+			Description: "If applicable, the ID of the LKE cluster this Instance is a node of.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"hourly_cost": schema.Float64Attribute{
+			Description: "The estimated hourly cost of the Instance in US dollars, based on its type and region, " +
+				"including the Backup service if enabled.",
+			Computed: true,
+		},
+		"monthly_cost": schema.Float64Attribute{
+			Description: "The estimated monthly cost of the Instance in US dollars, based on its type and region, " +
+				"including the Backup service if enabled.",
+			Computed: true,
+		},
+		"specs": schema.ListAttribute{
+			Description: "Information about the resources available to this Linode.",
+			ElementType: specsObjectType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifiers.UseStateForUnknownIf(
+					func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifiers.UseStateForUnknownIfFuncResponse) {
+						resp.UseState, resp.Diagnostics = rootAttributesUnchanged(ctx, req.Plan, req.State, "type")
+					},
+				),
+			},
+		},
+		"backups": schema.ListAttribute{
+			Description: "Information about this Linode's backups status.",
+			ElementType: backupsObjectType,
+			Computed:    true,
+		},
+		// The alerts, configs and disks are computed from the API when they aren't configured.
+		"alerts": schema.ListNestedAttribute{
+			Description: "Configuration options for alert triggers on this Linode.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: resourceAlertsNestedObject,
+			PlanModifiers: []planmodifier.List{
+				useStateForUnknownListIfRootUnchanged("type"),
+			},
+		},
+		"config": schema.ListNestedAttribute{
+			Description: "Configuration profiles define the VM settings and boot behavior of the Linode Instance.",
+			DeprecationMessage: "The embedded config is deprecated and scheduled to be removed in the next major version." +
+				"Please consider migrating it  to linode_instance_config resource.",
+			Optional:     true,
+			Computed:     true,
+			NestedObject: resourceConfigNestedObject,
+			PlanModifiers: []planmodifier.List{
+				useStateForUnknownConfigIfUnchanged(),
+			},
+		},
+		"disk": schema.ListNestedAttribute{
+			Description: "Disks associated with this Linode.",
+			DeprecationMessage: "The embedded disk block in linode_instance resource is deprecated and " +
+				"scheduled to be removed in the next major version. Please consider migrating " +
+				"it to be the linode_instance_disk resource.",
+			Optional:     true,
+			Computed:     true,
+			NestedObject: resourceDiskNestedObject,
+			PlanModifiers: []planmodifier.List{
+				useStateForUnknownListIfRootUnchanged("type", "swap_size"),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"metadata": schema.ListNestedBlock{
+			Description:  "Various fields related to the Linode Metadata service.",
+			NestedObject: resourceMetadataNestedObject,
+		},
+		"placement_group": schema.ListNestedBlock{
+			Description: "Fields related to the Placement Group this instance is assigned to.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: resourcePlacementGroupNestedObject,
+		},
+		"interface": schema.ListNestedBlock{
+			Description: "An array of Network Interfaces for this Linode to be created with. " +
+				"If an explicit config or disk is defined, interfaces must be declared in the config block.",
+			NestedObject: resourceInterfaceNestedObject,
+		},
+	},
+}
+
+// frameworkResourceSchemaV0 returns the schema of linode_instance as implemented with the SDKv2,
+// which stored the disks, configs and alerts computed from the API in nested blocks.
+func frameworkResourceSchemaV0(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique ID of this Linode.",
+				Computed:    true,
+			},
+			"image": schema.StringAttribute{
+				Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, while your " +
+					"Images start with private/. See /images for more information on the Images available for " +
+					"you to use.",
+				Optional: true,
+			},
+			"backup_id": schema.Int64Attribute{
+				Description: "A Backup ID from another Linode's available backups. Your User must have read_write access " +
+					"to that Linode, the Backup must have a status of successful, and the Linode must be " +
+					"deployed to the same region as the Backup. See /linode/instances/{linodeId}/backups for a " +
+					"Linode's available backups. This field and the image field are mutually exclusive.",
+				Optional: true,
+			},
+			"stackscript_id": schema.Int64Attribute{
+				Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' must also be " +
+					"provided, and must be an Image that is compatible with this StackScript.",
+				Optional: true,
+			},
+			"stackscript_data": schema.MapAttribute{
+				Description: "An object containing responses to any User Defined Fields present in the StackScript being " +
+					"deployed to this Linode. Only accepted if 'stackscript_id' is given. The required values " +
+					"depend on the StackScript being deployed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"label": schema.StringAttribute{
+				Description: "The Linode's label is for display purposes only. If no label is provided for a Linode, a " +
+					"default will be assigned",
+				Optional: true,
+				Computed: true,
+			},
+			"group": schema.StringAttribute{
+				Description:        "The display group of the Linode instance.",
+				DeprecationMessage: "Group label is deprecated. We recommend using tags instead.",
+				Optional:           true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tags_all": schema.SetAttribute{
+				Description: "All tags applied to this object, including the default tags of the provider.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"capabilities": schema.SetAttribute{
+				Description: "A list of capabilities of this Linode instance.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"locks": schema.SetAttribute{
+				Description: "A list of locks applied to this Linode.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"boot_config_label": schema.StringAttribute{
+				Description: "The Label of the Instance Config that should be used to boot the Linode instance.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "This is the location where the Linode was deployed. This cannot be changed without opening " +
+					"a support ticket.",
+				Required: true,
+			},
+			"maintenance_policy": schema.StringAttribute{
+				Description: "This is the maintenance type of the Linode instance. If not provided, the default policy " +
+					"of the account will be applied.",
+				Optional: true,
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of instance to be deployed, determining the price and size.",
+				Optional:    true,
+			},
+			"resize_disk": schema.BoolAttribute{
+				Description: "If true, changes in Linode type will attempt to upsize or downsize implicitly created " +
+					"disks. This must be false if explicit disks are defined. This is an irreversible action as " +
+					"Linode disks cannot be automatically downsized.",
+				Optional: true,
+			},
+			"migration_type": schema.StringAttribute{
+				Description: "The type of migration to use for resize and migration operations.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the instance, indicating the current readiness state.",
+				Computed:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this " +
+					"Instance, an arbitrary address will be used for this field.",
+				DeprecationMessage: "The `ip_address` attribute in linode_instance resource is deprecated. Please consider " +
+					"using the `ipv4` set attribute in the same resource or a `linode_instance_networking` data " +
+					"source instead.",
+				Computed: true,
+			},
+			"ipv6": schema.StringAttribute{
+				Description: "This Linode's IPv6 SLAAC addresses. This address is specific to a Linode, and may not be " +
+					"shared.",
+				Computed: true,
+			},
+			"ipv4": schema.SetAttribute{
+				Description: "This Linode's IPv4 Addresses. Each Linode is assigned a single public IPv4 address upon " +
+					"creation, and may get a single private IPv4 address if needed. You could pass a reserved " +
+					"IPv4 address here to create a linode with a particular reserved IP address. You may need " +
+					"to open a support ticket to get additional IPv4 addresses.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"private_ip": schema.BoolAttribute{
+				Description: "If true, the created Linode will have private networking enabled, allowing use of the " +
+					"192.168.128.0/17 network within the Linode's region.",
+				Optional: true,
+			},
+			"private_ip_address": schema.StringAttribute{
+				Description: "This Linode's Private IPv4 Address.  The regional private IP address range is " +
+					"192.168.128/17 address shared by all Linode Instances in a region.",
+				Computed: true,
+			},
+			"authorized_keys": schema.ListAttribute{
+				Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. Only " +
+					"accepted if 'image' is provided.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"authorized_users": schema.ListAttribute{
+				Description: "A list of Linode usernames. If the usernames have associated SSH keys, the keys will be " +
+					"appended to the `root` user's `~/.ssh/authorized_keys` file automatically. Only accepted " +
+					"if 'image' is provided.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"root_pass": schema.StringAttribute{
+				Description: "The password that will be initially assigned to the 'root' user account.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"swap_size": schema.Int64Attribute{
+				Description: "When deploying from an Image, this field is optional with a Linode API default of 512mb, " +
+					"otherwise it is ignored. This is used to set the swap disk size for the newly-created " +
+					"Linode.",
+				Optional: true,
+				Computed: true,
+			},
+			"backups_enabled": schema.BoolAttribute{
+				Description: "If this field is set to true, the created Linode will automatically be enrolled in the " +
+					"Linode Backup service. This will incur an additional charge. The cost for the Backup " +
+					"service is dependent on the Type of Linode deployed.",
+				Optional: true,
+				Computed: true,
+			},
+			"watchdog_enabled": schema.BoolAttribute{
+				Description: "The watchdog, named Lassie, is a Shutdown Watchdog that monitors your Linode and will " +
+					"reboot it if it powers off unexpectedly. It works by issuing a boot job when your Linode " +
+					"powers off without a shutdown job being responsible. To prevent a loop, Lassie will give " +
+					"up if there have been more than 5 boot jobs issued within 15 minutes.",
+				Optional: true,
+			},
+			"host_uuid": schema.StringAttribute{
+				Description: "The Linode’s host machine, as a UUID.",
+				Computed:    true,
+			},
+			"booted": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"firewall_id": schema.Int64Attribute{
+				Description: "The ID of the firewall applied to the Linode instance during creation.",
+				Optional:    true,
+			},
+			"shared_ipv4": schema.SetAttribute{
+				Description: "A set of IPv4 addresses to share with this Linode.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"network_helper": schema.BoolAttribute{
+				Description: "Whether Network Helper should be enabled for this instance.",
+				Optional:    true,
+			},
+			"placement_group_externally_managed": schema.BoolAttribute{
+				Description: "If true, this placement group's assignment is externally managed and will NOT be updated " +
+					"by this resource.",
+				Optional: true,
+			},
+			"interface_generation": schema.StringAttribute{
+				Description: "Specifies the interface type for the Linode. The default value is determined by the " +
+					"interfaces_for_new_linodes setting in the account settings. If the interface_generation " +
+					"option is set to linode, legacy configuration interfaces can no longer be used on the " +
+					"Linode. NOTE: Linode Interfaces may not currently be available to all users.",
+				Optional: true,
+				Computed: true,
+			},
+			"has_user_data": schema.BoolAttribute{
+				Description: "Whether or not this Instance was created with user-data.",
+				Computed:    true,
+			},
+			"disk_encryption": schema.StringAttribute{
+				Description: "The disk encryption policy for this Instance.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"lke_cluster_id": schema.Int64Attribute{
+				Description: "If applicable, the ID of the LKE cluster this Instance is a node of.",
+				Computed:    true,
+			},
+			"hourly_cost": schema.Float64Attribute{
+				Description: "The estimated hourly cost of the Instance in US dollars, based on its type and region, " +
+					"including the Backup service if enabled.",
+				Computed: true,
+			},
+			"monthly_cost": schema.Float64Attribute{
+				Description: "The estimated monthly cost of the Instance in US dollars, based on its type and region, " +
+					"including the Backup service if enabled.",
+				Computed: true,
+			},
+			"specs": schema.ListAttribute{
+				Description: "Information about the resources available to this Linode.",
+				ElementType: specsObjectType,
+				Computed:    true,
+			},
+			"backups": schema.ListAttribute{
+				Description: "Information about this Linode's backups status.",
+				ElementType: backupsObjectType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metadata": schema.ListNestedBlock{
+				Description: "Various fields related to the Linode Metadata service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"user_data": schema.StringAttribute{
+							Description: "The base64-encoded user-defined data exposed to this instance through the Linode Metadata " +
+								"service. Refer to the base64encode(...) function for information on encoding content for " +
+								"this field.",
+							Optional: true,
+						},
+					},
+				},
+			},
+			"placement_group": schema.ListNestedBlock{
+				Description: "Fields related to the Placement Group this instance is assigned to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the Placement Group to assign this Linode to.",
+							Required:    true,
+						},
+						"compliant_only": schema.BoolAttribute{
+							Optional: true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The label of this Placement Group.",
+							Computed:    true,
+						},
+						"placement_group_type": schema.StringAttribute{
+							Description: "The placement group type for this Placement Group.",
+							Computed:    true,
+						},
+						"placement_group_policy": schema.StringAttribute{
+							Description: "Whether compliance is strictly enforced by this Placement Group.",
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"disk": schema.ListNestedBlock{
+				DeprecationMessage: "The embedded disk block in linode_instance resource is deprecated and scheduled to be " +
+					"removed in the next major version. Please consider migrating it to be the " +
+					"linode_instance_disk resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the Disk (for use in Linode Image resources and Linode Instance Config Devices)",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The disks label, which acts as an identifier in Terraform.",
+							Required:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "The size of the Disk in MB.",
+							Required:    true,
+						},
+						"filesystem": schema.StringAttribute{
+							Description: "The Disk filesystem can be one of: raw, swap, ext3, ext4, initrd (max 32mb)",
+							Optional:    true,
+							Computed:    true,
+						},
+						"read_only": schema.BoolAttribute{
+							Description: "If true, this Disk is read-only.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"image": schema.StringAttribute{
+							Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, while your " +
+								"Images start with private/.",
+							Optional: true,
+							Computed: true,
+						},
+						"authorized_keys": schema.ListAttribute{
+							Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. Only " +
+								"accepted if 'image' is provided.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"authorized_users": schema.ListAttribute{
+							Description: "A list of Linode usernames. If the usernames have associated SSH keys, the keys will be " +
+								"appended to the `root` user's `~/.ssh/authorized_keys` file automatically. Only accepted " +
+								"if 'image' is provided.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"stackscript_id": schema.Int64Attribute{
+							Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' must also be " +
+								"provided, and must be an Image that is compatible with this StackScript.",
+							Optional: true,
+							Computed: true,
+						},
+						"stackscript_data": schema.MapAttribute{
+							Description: "An object containing responses to any User Defined Fields present in the StackScript being " +
+								"deployed to this Linode. Only accepted if 'stackscript_id' is given. The required values " +
+								"depend on the StackScript being deployed.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"root_pass": schema.StringAttribute{
+							Description: "The password that will be initialially assigned to the 'root' user account.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"alerts": schema.ListNestedBlock{
+				Description: "Configuration options for alert triggers on this Linode.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cpu": schema.Int64Attribute{
+							Description: "The percentage of CPU usage required to trigger an alert. If the average CPU usage over " +
+								"two hours exceeds this value, we'll send you an alert. If this is set to 0, the alert is " +
+								"disabled.",
+							Optional: true,
+							Computed: true,
+						},
+						"network_in": schema.Int64Attribute{
+							Description: "The amount of incoming traffic, in Mbit/s, required to trigger an alert. If the average " +
+								"incoming traffic over two hours exceeds this value, we'll send you an alert. If this is " +
+								"set to 0 (zero), the alert is disabled.",
+							Optional: true,
+							Computed: true,
+						},
+						"network_out": schema.Int64Attribute{
+							Description: "The amount of outbound traffic, in Mbit/s, required to trigger an alert. If the average " +
+								"outbound traffic over two hours exceeds this value, we'll send you an alert. If this is " +
+								"set to 0 (zero), the alert is disabled.",
+							Optional: true,
+							Computed: true,
+						},
+						"transfer_quota": schema.Int64Attribute{
+							Description: "The percentage of network transfer that may be used before an alert is triggered. When " +
+								"this value is exceeded, we'll alert you. If this is set to 0 (zero), the alert is " +
+								"disabled.",
+							Optional: true,
+							Computed: true,
+						},
+						"io": schema.Int64Attribute{
+							Description: "The amount of disk IO operation per second required to trigger an alert. If the average " +
+								"disk IO over two hours exceeds this value, we'll send you an alert. If set to 0, this " +
+								"alert is disabled.",
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"interface": schema.ListNestedBlock{
+				Description: "An array of Network Interfaces for this Linode to be created with. If an explicit config " +
+					"or disk is defined, interfaces must be declared in the config block.",
+				NestedObject: resourceInterfaceNestedObjectV0,
+			},
+			"config": schema.ListNestedBlock{
+				Description: "Configuration profiles define the VM settings and boot behavior of the Linode Instance.",
+				DeprecationMessage: "The embedded config is deprecated and scheduled to be removed in the next major " +
+					"version.Please consider migrating it  to linode_instance_config resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The unique ID of this Config.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The Config's label for display purposes.  Also used by `boot_config_label`.",
+							Required:    true,
+						},
+						"kernel": schema.StringAttribute{
+							Description: "A Kernel ID to boot a Linode with. Default is based on image choice. (examples: " +
+								"linode/latest-64bit, linode/grub2, linode/direct-disk)",
+							Optional: true,
+							Computed: true,
+						},
+						"run_level": schema.StringAttribute{
+							Description: "Defines the state of your Linode after booting. Defaults to default.",
+							Optional:    true,
+						},
+						"virt_mode": schema.StringAttribute{
+							Description: "Controls the virtualization mode. Defaults to paravirt.",
+							Optional:    true,
+						},
+						"root_device": schema.StringAttribute{
+							Description: "The root device to boot. The corresponding disk must be attached.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"comments": schema.StringAttribute{
+							Description: "Optional field for arbitrary User comments on this Config.",
+							Optional:    true,
+						},
+						"memory_limit": schema.Int64Attribute{
+							Description: "Defaults to the total RAM of the Linode",
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"helpers": schema.ListNestedBlock{
+							Description: "Helpers enabled when booting to this Linode Config.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"updatedb_disabled": schema.BoolAttribute{
+										Description: "Disables updatedb cron job to avoid disk thrashing.",
+										Optional:    true,
+									},
+									"distro": schema.BoolAttribute{
+										Description: "Controls the behavior of the Linode Config's Distribution Helper setting.",
+										Optional:    true,
+									},
+									"modules_dep": schema.BoolAttribute{
+										Description: "Creates a modules dependency file for the Kernel you run.",
+										Optional:    true,
+									},
+									"network": schema.BoolAttribute{
+										Description: "Controls the behavior of the Linode Config's Network Helper setting, used to automatically " +
+											"configure additional IP addresses assigned to this instance.",
+										Optional: true,
+									},
+									"devtmpfs_automount": schema.BoolAttribute{
+										Description: "Populates the /dev directory early during boot without udev. Defaults to false.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"devices": schema.ListNestedBlock{
+							Description: "Device sda-sdbl can be either a Disk or Volume identified by disk_label or volume_id. Only " +
+								"one type per slot allowed.",
+							NestedObject: schema.NestedBlockObject{
+								Blocks: resourceDeviceBlocksV0(),
+							},
+						},
+						"interface": schema.ListNestedBlock{
+							Description:  "An array of Network Interfaces for this Linode’s Configuration Profile.",
+							NestedObject: resourceInterfaceNestedObjectV0,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+var resourceInterfaceNestedObjectV0 = schema.NestedBlockObject{
+	Attributes: map[string]schema.Attribute{
+		"purpose": schema.StringAttribute{
+			Description: "The type of interface.",
+			Required:    true,
+		},
+		"ipam_address": schema.StringAttribute{
+			Description: "This Network Interface's private IP address in Classless Inter-Domain Routing (CIDR) " +
+				"notation.This attribute is only allowed for VLAN interfaces.",
+			Optional: true,
+		},
+		"label": schema.StringAttribute{
+			Description: "The name of the VALN. This attribute is required for VLAN interfaces. This attribute is " +
+				"only allowed for VLAN interfaces.",
+			Optional: true,
+		},
+		"id": schema.Int64Attribute{
+			Description: "The ID of the interface.",
+			Computed:    true,
+		},
+		"subnet_id": schema.Int64Attribute{
+			Description: "The ID of the subnet which the VPC interface is connected to.This attribute is required " +
+				"for VPC interfaces.This attribute is only allowed for VPC interfaces.",
+			Optional: true,
+		},
+		"vpc_id": schema.Int64Attribute{
+			Description: "The ID of VPC of the subnet which the VPC interface is connected to.",
+			Computed:    true,
+		},
+		"primary": schema.BoolAttribute{
+			Description: "Whether the interface is the primary interface that should have the default route for this " +
+				"Linode.",
+			Optional: true,
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether this interface is currently booted and active.",
+			Computed:    true,
+		},
+		"ip_ranges": schema.ListAttribute{
+			Description: "List of VPC IPs or IP ranges inside the VPC subnet.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"ipv4": schema.ListNestedBlock{
+			Description: "The IPv4 configuration of the VPC interface.This attribute is only allowed for VPC " +
+				"interfaces.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"vpc": schema.StringAttribute{
+						Description: "The IP from the VPC subnet to use for this interface.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"nat_1_1": schema.StringAttribute{
+						Description: "The public IP that will be used for the one-to-one NAT purpose.",
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"ipv6": schema.ListNestedBlock{
+			Description: "The IPv6 configuration of the VPC interface. This attribute is only allowed for VPC " +
+				"interfaces.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"is_public": schema.BoolAttribute{
+						Description: "If true, connections from the interface to IPv6 addresses outside the VPC, and connections " +
+							"from IPv6 addresses outside the VPC to the interface will be permitted.",
+						Optional: true,
+						Computed: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"slaac": schema.ListNestedBlock{
+						Description: "An array of SLAAC prefixes to use for this interface.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"range": schema.StringAttribute{
+									Description: "A SLAAC prefix to add to this interface, or `auto` for a new IPv6 prefix to be " +
+										"automatically allocated.",
+									Optional: true,
+								},
+								"assigned_range": schema.StringAttribute{
+									Description: "The value of `range` computed by the API. This is necessary when needing to access the " +
+										"range implicitly allocated using `auto`.",
+									Computed: true,
+								},
+								"address": schema.StringAttribute{
+									Description: "The SLAAC address chosen for this interface.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+					"range": schema.ListNestedBlock{
+						Description: "An array of SLAAC prefixes to use for this interface.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"range": schema.StringAttribute{
+									Description: "A prefix to add to this interface, or `auto` for a new IPv6 prefix to be automatically " +
+										"allocated.",
+									Optional: true,
+								},
+								"assigned_range": schema.StringAttribute{
+									Description: "The value of `range` computed by the API. This is necessary when needing to access the " +
+										"range implicitly allocated using `auto`.",
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func resourceDeviceBlocksV0() map[string]schema.Block {
+	result := make(map[string]schema.Block, 64)
+
+	for _, key := range helper.GetConfigDeviceKeys() {
+		result[key] = schema.ListNestedBlock{
+			Description: "Device can be either a Disk or Volume identified by disk_id or volume_id. Only one type per slot allowed.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"disk_label": schema.StringAttribute{
+						Description: "The `label` of the `disk` to map to this `device` slot.",
+						Optional:    true,
+					},
+					"disk_id": schema.Int64Attribute{
+						Description: "The Disk ID to map to this disk slot",
+						Optional:    true,
+						Computed:    true,
+					},
+					"volume_id": schema.Int64Attribute{
+						Description: "The Block Storage volume ID to map to this disk slot",
+						Optional:    true,
+					},
+				},
+			},
+		}
+	}
+
+	return result
+}
+
+func resourceDeviceAttributes() map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, 64)
+
+	for _, key := range helper.GetConfigDeviceKeys() {
+		result[key] = schema.ListNestedAttribute{
+			Description: deviceDescription,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: resourceDeviceNestedObject,
+			PlanModifiers: []planmodifier.List{
+				useStateForUnknownDeviceIfUnchanged(),
+			},
+		}
+	}
+
+	return result
+}
+
+// isZeroValue returns whether the given value is null or the zero value of its type,
+// which were not distinguished by the SDKv2 implementation of this resource.
+func isZeroValue(value attr.Value) bool {
+	if value == nil || value.IsNull() {
+		return true
+	}
+
+	if value.IsUnknown() {
+		return false
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString() == ""
+	case types.Int64:
+		return v.ValueInt64() == 0
+	case types.Bool:
+		return !v.ValueBool()
+	case types.Float64:
+		return v.ValueFloat64() == 0
+	case types.List:
+		return len(v.Elements()) == 0
+	case types.Set:
+		return len(v.Elements()) == 0
+	case types.Map:
+		return len(v.Elements()) == 0
+	}
+
+	return false
+}
+
+// requiresReplaceUnlessZero reports whether a change between the given state and plan values
+// requires the resource to be replaced, treating null and zero values as equal.
+func requiresReplaceUnlessZero(stateValue, planValue attr.Value) bool {
+	return !isZeroValue(stateValue) || !isZeroValue(planValue)
+}
+
+const requiresReplaceUnlessZeroDescription = "Changing this value requires the resource to be replaced, " +
+	"unless the value changes between null and its zero value."
+
+func requiresReplaceStringUnlessZero() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = requiresReplaceUnlessZero(req.StateValue, req.PlanValue)
+		},
+		requiresReplaceUnlessZeroDescription,
+		requiresReplaceUnlessZeroDescription,
+	)
+}
+
+func requiresReplaceInt64UnlessZero() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = requiresReplaceUnlessZero(req.StateValue, req.PlanValue)
+		},
+		requiresReplaceUnlessZeroDescription,
+		requiresReplaceUnlessZeroDescription,
+	)
+}
+
+func requiresReplaceBoolUnlessZero() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = requiresReplaceUnlessZero(req.StateValue, req.PlanValue)
+		},
+		requiresReplaceUnlessZeroDescription,
+		requiresReplaceUnlessZeroDescription,
+	)
+}
+
+func requiresReplaceListUnlessZero() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = requiresReplaceUnlessZero(req.StateValue, req.PlanValue)
+		},
+		requiresReplaceUnlessZeroDescription,
+		requiresReplaceUnlessZeroDescription,
+	)
+}
+
+func requiresReplaceMapUnlessZero() planmodifier.Map {
+	return mapplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = requiresReplaceUnlessZero(req.StateValue, req.PlanValue)
+		},
+		requiresReplaceUnlessZeroDescription,
+		requiresReplaceUnlessZeroDescription,
+	)
+}
+
+const requiresReplaceIfConfiguredDescription = "Changing the configured value of this attribute " +
+	"requires the resource to be replaced."
+
+func requiresReplaceStringIfConfigured() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.ConfigValue.IsNull() && !req.StateValue.IsNull()
+		},
+		requiresReplaceIfConfiguredDescription,
+		requiresReplaceIfConfiguredDescription,
+	)
+}
+
+const requiresReplaceInterfaceGenerationUnlessUpgradeDescription = "Changing the configured value of this " +
+	"attribute requires the resource to be replaced, unless the interfaces are upgraded from legacy_config to linode."
+
+func requiresReplaceInterfaceGenerationUnlessUpgrade() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.ConfigValue.IsNull() && !req.StateValue.IsNull() &&
+				!isInterfaceGenerationUpgrade(req.StateValue, req.PlanValue)
+		},
+		requiresReplaceInterfaceGenerationUnlessUpgradeDescription,
+		requiresReplaceInterfaceGenerationUnlessUpgradeDescription,
+	)
+}
+
+func requiresReplaceSetIfConfigured() planmodifier.Set {
+	return setplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.ConfigValue.IsNull() && !req.StateValue.IsNull()
+		},
+		requiresReplaceIfConfiguredDescription,
+		requiresReplaceIfConfiguredDescription,
+	)
+}
+
+// siblingsUnchanged returns whether the parent of the attribute at the given path exists in the state
+// and the values of the given attributes sharing it are known and unchanged in the plan.
+func siblingsUnchanged(
+	ctx context.Context, attrPath path.Path, plan tfsdk.Plan, state tfsdk.State, names ...string,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var stateParent attr.Value
+
+	diags.Append(state.GetAttribute(ctx, attrPath.ParentPath(), &stateParent)...)
+	if diags.HasError() || stateParent == nil || stateParent.IsNull() {
+		return false, diags
+	}
+
+	for _, name := range names {
+		var planValue, stateValue attr.Value
+
+		siblingPath := attrPath.ParentPath().AtName(name)
+
+		diags.Append(plan.GetAttribute(ctx, siblingPath, &planValue)...)
+		diags.Append(state.GetAttribute(ctx, siblingPath, &stateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if planValue == nil || planValue.IsUnknown() || !planValue.Equal(stateValue) {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// rootAttributesUnchanged returns whether the values of the given top-level attributes
+// are known and unchanged between the state and the plan.
+func rootAttributesUnchanged(
+	ctx context.Context, plan tfsdk.Plan, state tfsdk.State, names ...string,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, name := range names {
+		var planValue, stateValue attr.Value
+
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &stateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if planValue == nil || planValue.IsUnknown() || !planValue.Equal(stateValue) {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// useStateForUnknownStringIfUnchanged uses the state value of a nested attribute in place of
+// an unknown planned value if the given sibling attributes are unchanged, e.g. the label of a disk.
+func useStateForUnknownStringIfUnchanged(names ...string) planmodifier.String {
+	return stringplanmodifiers.UseStateForUnknownIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifiers.UseStateForUnknownIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			resp.UseState, resp.Diagnostics = siblingsUnchanged(ctx, req.Path, req.Plan, req.State, names...)
+		},
+	)
+}
+
+func useStateForUnknownInt64IfUnchanged(names ...string) planmodifier.Int64 {
+	return int64planmodifiers.UseStateForUnknownIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifiers.UseStateForUnknownIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			resp.UseState, resp.Diagnostics = siblingsUnchanged(ctx, req.Path, req.Plan, req.State, names...)
+		},
+	)
+}
+
+func useStateForUnknownBoolIfUnchanged(names ...string) planmodifier.Bool {
+	return boolplanmodifiers.UseStateForUnknownIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifiers.UseStateForUnknownIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			resp.UseState, resp.Diagnostics = siblingsUnchanged(ctx, req.Path, req.Plan, req.State, names...)
+		},
+	)
+}
+
+const requiresReplaceIfUnchangedDescription = "Changing this value requires the resource to be replaced " +
+	"unless the nested object is identified differently."
+
+// requiresReplaceStringIfUnchanged requires the resource to be replaced when a known nested value
+// changes while the given sibling attributes are unchanged, e.g. the filesystem of an existing disk.
+func requiresReplaceStringIfUnchanged(names ...string) planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+				return
+			}
+
+			resp.RequiresReplace, resp.Diagnostics = siblingsUnchanged(ctx, req.Path, req.Plan, req.State, names...)
+		},
+		requiresReplaceIfUnchangedDescription,
+		requiresReplaceIfUnchangedDescription,
+	)
+}
+
+func requiresReplaceBoolIfUnchanged(names ...string) planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+				return
+			}
+
+			resp.RequiresReplace, resp.Diagnostics = siblingsUnchanged(ctx, req.Path, req.Plan, req.State, names...)
+		},
+		requiresReplaceIfUnchangedDescription,
+		requiresReplaceIfUnchangedDescription,
+	)
+}
+
+// useStateForUnknownListIfUnchanged uses the state value of a nested list computed from the API
+// if it isn't configured and the given sibling attributes are unchanged, e.g. the helpers of a config.
+func useStateForUnknownListIfUnchanged(names ...string) planmodifier.List {
+	return listplanmodifiers.UseStateForUnknownIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifiers.UseStateForUnknownIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			resp.UseState, resp.Diagnostics = siblingsUnchanged(ctx, req.Path, req.Plan, req.State, names...)
+		},
+	)
+}
+
+// useStateForUnknownListIfRootUnchanged uses the state value of a list computed from the API
+// if it isn't configured and the given top-level attributes are unchanged, e.g. the disks of the instance.
+func useStateForUnknownListIfRootUnchanged(names ...string) planmodifier.List {
+	return listplanmodifiers.UseStateForUnknownIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifiers.UseStateForUnknownIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			resp.UseState, resp.Diagnostics = rootAttributesUnchanged(ctx, req.Plan, req.State, names...)
+		},
+	)
+}
+
+// useStateForUnknownDeviceIfUnchanged uses the state value of a device slot that isn't configured
+// if the label of its config is unchanged.
+func useStateForUnknownDeviceIfUnchanged() planmodifier.List {
+	return listplanmodifiers.UseStateForUnknownIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifiers.UseStateForUnknownIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			// The path of a slot is config[i].devices[0].<slot>
+			devicesPath := req.Path.ParentPath().ParentPath()
+
+			resp.UseState, resp.Diagnostics = siblingsUnchanged(ctx, devicesPath, req.Plan, req.State, "label")
+		},
+	)
+}
+
+// useStateForUnknownConfigIfUnchanged uses the state value of the configs of the instance if they
+// aren't configured and neither its disks nor the options of its interfaces are changed.
+func useStateForUnknownConfigIfUnchanged() planmodifier.List {
+	return listplanmodifiers.UseStateForUnknownIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifiers.UseStateForUnknownIfFuncResponse) {
+			if req.StateValue.IsNull() {
+				return
+			}
+
+			resp.UseState, resp.Diagnostics = rootAttributesUnchanged(ctx, req.Plan, req.State, "disk", "interface_generation")
+			if !resp.UseState || resp.Diagnostics.HasError() {
+				return
+			}
+
+			var planInterfaces, stateInterfaces types.List
+
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("interface"), &planInterfaces)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("interface"), &stateInterfaces)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.UseState = reflect.DeepEqual(
+				expandInterfaceModels(
+					ctx, fillUnknownsFromState(ctx, planInterfaces, stateInterfaces).(types.List), &resp.Diagnostics,
+				),
+				expandInterfaceModels(ctx, stateInterfaces, &resp.Diagnostics),
+			)
+		},
+	)
+}
+
+// defaultBoolIfParentConfigured plans the default value of an unconfigured nested attribute if its
+// parent object is configured. A schema default would also apply to the objects of an unconfigured
+// nested attribute computed from the state.
+func defaultBoolIfParentConfigured(value bool) planmodifier.Bool {
+	return parentConfiguredDefaultModifier{value: types.BoolValue(value)}
+}
+
+// defaultStringIfParentConfigured is the string equivalent of defaultBoolIfParentConfigured.
+func defaultStringIfParentConfigured(value string) planmodifier.String {
+	return parentConfiguredDefaultModifier{value: types.StringValue(value)}
+}
+
+type parentConfiguredDefaultModifier struct {
+	value attr.Value
+}
+
+func (m parentConfiguredDefaultModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("If the enclosing object is configured, defaults to %s.", m.value)
+}
+
+func (m parentConfiguredDefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m parentConfiguredDefaultModifier) PlanModifyBool(
+	ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse,
+) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	if m.parentConfigured(ctx, req.Config, req.Path, &resp.Diagnostics) {
+		resp.PlanValue = m.value.(types.Bool)
+	}
+}
+
+func (m parentConfiguredDefaultModifier) PlanModifyString(
+	ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse,
+) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	if m.parentConfigured(ctx, req.Config, req.Path, &resp.Diagnostics) {
+		resp.PlanValue = m.value.(types.String)
+	}
+}
+
+func (m parentConfiguredDefaultModifier) parentConfigured(
+	ctx context.Context, config tfsdk.Config, attrPath path.Path, diags *diag.Diagnostics,
+) bool {
+	var parent types.Object
+
+	diags.Append(config.GetAttribute(ctx, attrPath.ParentPath(), &parent)...)
+
+	return !diags.HasError() && !parent.IsNull() && !parent.IsUnknown()
+}
+
+// useStateForHashedRootPass uses the state value of a root password if it is the hash
+// of the configured password, as stored by the SDKv2 implementation of this resource.
+func useStateForHashedRootPass() planmodifier.String {
+	return hashedRootPassModifier{}
+}
+
+type hashedRootPassModifier struct{}
+
+func (m hashedRootPassModifier) Description(_ context.Context) string {
+	return "The hashed value of this attribute in state will not change if it matches the configured value."
+}
+
+func (m hashedRootPassModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m hashedRootPassModifier) PlanModifyString(
+	ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse,
+) {
+	if req.StateValue.IsNull() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if hashString(req.ConfigValue.ValueString()) == req.StateValue.ValueString() {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// ipv4AddressValidator validates that a string is an IPv4 address.
+type ipv4AddressValidator struct{}
+
+func (v ipv4AddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 address"
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(
+	ctx context.Context, req validator.StringRequest, resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	ip := net.ParseIP(value)
+	if ip == nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv4 Address", fmt.Sprintf("invalid ipv4 address: %s", value))
+		return
+	}
+
+	if ip.To4() == nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv4 Address", fmt.Sprintf("expected ipv4 address, got %s", value))
+	}
+}
+
+// ipRangeValidator validates that a string is an IPv4 or IPv6 CIDR range.
+type ipRangeValidator struct{}
+
+func (v ipRangeValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 or IPv6 CIDR range"
+}
+
+func (v ipRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipRangeValidator) ValidateString(
+	ctx context.Context, req validator.StringRequest, resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path, "Invalid IP Range", fmt.Sprintf("Invalid IPv4 or IPv6 CIDR range: %s", value),
+		)
+	}
+}
+
+// blockConflictsWith returns a config validator that rejects configurations setting
+// any of the given root attributes or blocks alongside the given block.
+func blockConflictsWith(block string, names ...string) resource.ConfigValidator {
+	return blockValidator{block: block, conflicts: names}
+}
+
+// blockAlsoRequires returns a config validator that rejects configurations setting
+// the given block without all of the given root attributes.
+func blockAlsoRequires(block string, names ...string) resource.ConfigValidator {
+	return blockValidator{block: block, requires: names}
+}
+
+// blockValidator validates the root attributes configured alongside a list block,
+// as empty blocks can't be told apart from unset ones by the framework validators.
+type blockValidator struct {
+	block     string
+	conflicts []string
+	requires  []string
+}
+
+func (v blockValidator) Description(_ context.Context) string {
+	if len(v.requires) > 0 {
+		return fmt.Sprintf("Ensures %s is configured alongside %s.", v.block, strings.Join(v.requires, ", "))
+	}
+
+	return fmt.Sprintf("Ensures %s is not configured alongside %s.", v.block, strings.Join(v.conflicts, ", "))
+}
+
+func (v blockValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v blockValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var attributes map[string]tftypes.Value
+	if err := req.Config.Raw.As(&attributes); err != nil {
+		resp.Diagnostics.AddError("Failed to read resource configuration", err.Error())
+		return
+	}
+
+	if !isConfigured(attributes[v.block]) {
+		return
+	}
+
+	for _, name := range v.conflicts {
+		if !isConfigured(attributes[name]) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q cannot be specified when %q is specified", name, v.block),
+		)
+	}
+
+	for _, name := range v.requires {
+		if isConfigured(attributes[name]) || !attributes[name].IsKnown() {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(v.block),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q must be specified when %q is specified", name, v.block),
+		)
+	}
+}
+
+// isConfigured returns whether the given value is set in the configuration,
+// treating empty blocks as unset.
+func isConfigured(value tftypes.Value) bool {
+	if value.IsNull() {
+		return false
+	}
+
+	if !value.IsKnown() || !value.Type().Is(tftypes.List{}) {
+		return true
+	}
+
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return true
+	}
+
+	return len(elements) > 0
+}
@@ -0,0 +1,180 @@
+//go:build unit
+
+package instance_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func instanceConfig(label string) map[string]any {
+	return map[string]any{
+		"label":     label,
+		"region":    "us-east",
+		"type":      "g6-nanode-1",
+		"image":     "linode/debian12",
+		"root_pass": "fake-Root-Pass-123",
+		"tags":      []any{"test"},
+	}
+}
+
+func TestResourceInstance_fakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	state := tf.Apply("linode_instance", nil, instanceConfig("fake-instance"))
+	require.NotEmpty(t, state.Attr("id"))
+	require.Equal(t, state.Attr("id"), state.IdentityAttr("id"))
+	require.Equal(t, "running", state.Attr("status"))
+	require.NotEmpty(t, state.Attr("ip_address"))
+	require.Equal(t, float64(1), state.Attr("specs.0.vcpus"))
+
+	state = tf.Apply("linode_instance", state, instanceConfig("fake-instance-renamed"))
+	require.Equal(t, "fake-instance-renamed", state.Attr("label"))
+
+	instance, ok := server.Get("linode/instances/" + state.Attr("id").(string))
+	require.True(t, ok)
+	require.Equal(t, "fake-instance-renamed", instance["label"])
+
+	imported := tf.Import("linode_instance", state.Attr("id").(string))
+	require.Equal(t, state.Attr("label"), imported.Attr("label"))
+	require.Equal(t, state.Attr("type"), imported.Attr("type"))
+	require.Equal(t, state.Attr("id"), imported.IdentityAttr("id"))
+
+	imported = tf.ImportIdentity("linode_instance", map[string]any{"id": state.Attr("id")})
+	require.Equal(t, state.Attr("id"), imported.Attr("id"))
+	require.Equal(t, state.Attr("label"), imported.Attr("label"))
+
+	tf.Destroy(state)
+	require.Empty(t, server.List("linode/instances"))
+}
+
+func TestResourceInstance_fakeAPIDisksAndConfigs(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	config := map[string]any{
+		"label":  "fake-instance-disks",
+		"region": "us-east",
+		"type":   "g6-standard-1",
+		"disk": []any{
+			map[string]any{
+				"label":     "boot",
+				"size":      3000,
+				"image":     "linode/debian12",
+				"root_pass": "fake-Root-Pass-123",
+			},
+			map[string]any{
+				"label":      "swap",
+				"size":       512,
+				"filesystem": "swap",
+			},
+		},
+		"config": []any{
+			map[string]any{
+				"label":  "boot-config",
+				"kernel": "linode/latest-64bit",
+				"devices": []any{
+					map[string]any{
+						"sda": []any{map[string]any{"disk_label": "boot"}},
+						"sdb": []any{map[string]any{"disk_label": "swap"}},
+					},
+				},
+			},
+		},
+		"boot_config_label": "boot-config",
+	}
+
+	state := tf.Apply("linode_instance", nil, config)
+	require.Equal(t, "boot", state.Attr("disk.0.label"))
+	require.NotEmpty(t, state.Attr("disk.0.id"))
+	require.Equal(t, "paravirt", state.Attr("config.0.virt_mode"))
+	require.Equal(t, state.Attr("disk.0.id"), state.Attr("config.0.devices.0.sda.0.disk_id"))
+	require.Nil(t, state.Attr("config.0.devices.0.sdc"))
+
+	config["config"].([]any)[0].(map[string]any)["comments"] = "updated"
+
+	state = tf.Apply("linode_instance", state, config)
+	require.Equal(t, "updated", state.Attr("config.0.comments"))
+
+	tf.Destroy(state)
+	require.Empty(t, server.List("linode/instances"))
+}
+
+// sdkv2State is the subset of a Terraform state file describing
+// the instances of its resources.
+type sdkv2State struct {
+	Resources []struct {
+		Instances []struct {
+			SchemaVersion int64           `json:"schema_version"`
+			Attributes    json.RawMessage `json:"attributes"`
+			Private       []byte          `json:"private"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+func TestResourceInstance_fakeAPIUpgradeSDKv2State(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	// testdata/sdkv2_state.json was written by the SDKv2 implementation of linode_instance
+	// after creating this instance on a new fake API server.
+	config := map[string]any{
+		"label":           "sdkv2-instance",
+		"region":          "us-east",
+		"type":            "g6-nanode-1",
+		"image":           "linode/debian12",
+		"root_pass":       "fake-Root-Pass-123",
+		"authorized_keys": []any{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHxeWmtuD8tcIfBrwSkwTlz7OTcoB7Z6vA5m8KaLpT2r user@example"},
+		"tags":            []any{"sdkv2"},
+	}
+
+	instance, err := server.Client().CreateInstance(context.Background(), linodego.InstanceCreateOptions{
+		Label:          config["label"].(string),
+		Region:         config["region"].(string),
+		Type:           config["type"].(string),
+		Image:          config["image"].(string),
+		RootPass:       config["root_pass"].(string),
+		AuthorizedKeys: []string{config["authorized_keys"].([]any)[0].(string)},
+		Tags:           []string{"sdkv2"},
+		Booted:         linodego.Pointer(true),
+	})
+	require.NoError(t, err)
+
+	_, err = server.Client().WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceRunning, 60)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile("testdata/sdkv2_state.json")
+	require.NoError(t, err)
+
+	var stateFile sdkv2State
+	require.NoError(t, json.Unmarshal(data, &stateFile))
+
+	prior := stateFile.Resources[0].Instances[0]
+	require.Equal(t, int64(0), prior.SchemaVersion)
+
+	state := tf.UpgradeState("linode_instance", prior.SchemaVersion, prior.Attributes, prior.Private)
+	require.Equal(t, strconv.Itoa(instance.ID), state.Attr("id"))
+	require.Equal(t, "2de38WW1I43wb64fyzYUllIjqVUFiLkY/dFtYvoFS0hKwlj1sVdu+hHYZrqf7zI3wMOD3z/DRnLGlN8R+x1sLA==", state.Attr("root_pass"))
+	require.Nil(t, state.Attr("root_pass_wo"))
+	require.Nil(t, state.Attr("stackscript_id"))
+	require.Nil(t, state.Attr("group"))
+	require.Equal(t, "debian12 Disk", state.Attr("disk.0.label"))
+	require.Equal(t, "My debian12 Disk Profile", state.Attr("config.0.label"))
+	require.Equal(t, "debian12 Disk", state.Attr("config.0.devices.0.sda.0.disk_label"))
+	require.Equal(t, float64(90), state.Attr("alerts.0.cpu"))
+	require.Equal(t, "sdkv2", state.Attr("tags_all.0"))
+	require.Equal(t, state.Attr("id"), state.IdentityAttr("id"))
+
+	tf.ExpectEmptyPlan(state, config)
+
+	tf.Destroy(state)
+	require.Empty(t, server.List("linode/instances"))
+}
@@ -4,111 +4,60 @@ import (
 	"context"
 	"crypto/sha3"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
 	"slices"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper"
 )
 
-type diskSpec map[string]any
-
 // getDeadlineSeconds gets the seconds remaining until deadline is met.
-func getDeadlineSeconds(ctx context.Context, d *schema.ResourceData) int {
-	duration := d.Timeout(schema.TimeoutUpdate)
+func getDeadlineSeconds(ctx context.Context) int {
+	duration := LinodeInstanceUpdateTimeout
 	if deadline, ok := ctx.Deadline(); ok {
 		duration = time.Until(deadline)
 	}
 	return int(duration.Seconds())
 }
 
-func setPublicIPAddress(d *schema.ResourceData, ip string) {
-	d.Set("ip_address", ip)
-	d.SetConnInfo(map[string]string{
-		"type": "ssh",
-		"host": ip,
-	})
+// diagnosticsError returns an error joining the errors of the given diagnostics, if any.
+func diagnosticsError(diags fwdiag.Diagnostics) error {
+	var errs []error
+
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.Join(errs...)
 }
 
 func createInstanceConfigsFromSet(
 	ctx context.Context,
 	client linodego.Client,
 	instanceID int,
-	cset []any,
+	cset []ConfigModel,
 	diskIDLabelMap map[string]int,
 	detacher volumeDetacher,
 ) (map[int]linodego.InstanceConfig, error) {
 	configIDMap := make(map[int]linodego.InstanceConfig, len(cset))
-	for _, v := range cset {
-		config := v.(map[string]any)
-
-		configOpts := linodego.InstanceConfigCreateOptions{}
-		configOpts.Kernel = config["kernel"].(string)
-		configOpts.Label = config["label"].(string)
-		configOpts.Comments = config["comments"].(string)
-		configOpts.VirtMode = config["virt_mode"].(string)
-		configOpts.MemoryLimit = config["memory_limit"].(int)
-		configOpts.RunLevel = config["run_level"].(string)
-
-		if helpers, ok := config["helpers"].([]any); ok {
-			for _, helper := range helpers {
-				if helperMap, helperMapOk := helper.(map[string]any); helperMapOk {
-					configOpts.Helpers = &linodego.InstanceConfigHelpers{}
-					if updateDBDisabled, ok := helperMap["updatedb_disabled"]; ok {
-						configOpts.Helpers.UpdateDBDisabled = updateDBDisabled.(bool)
-					}
-					if distro, ok := helperMap["distro"]; ok {
-						configOpts.Helpers.Distro = distro.(bool)
-					}
-					if modulesDep, ok := helperMap["modules_dep"]; ok {
-						configOpts.Helpers.ModulesDep = modulesDep.(bool)
-					}
-					if network, ok := helperMap["network"]; ok {
-						configOpts.Helpers.Network = network.(bool)
-					}
-					if devTmpFsAutomount, ok := helperMap["devtmpfs_automount"]; ok {
-						configOpts.Helpers.DevTmpFsAutomount = devTmpFsAutomount.(bool)
-					}
-				}
-			}
-		}
-
-		if interfaces, ok := config["interface"]; ok {
-			interfaces := interfaces.([]any)
-			configOpts.Interfaces = make([]linodego.InstanceConfigInterfaceCreateOptions, len(interfaces))
-
-			for i, ni := range interfaces {
-				configOpts.Interfaces[i] = helper.ExpandConfigInterface(ni.(map[string]any))
-			}
-		}
+	for _, config := range cset {
+		var diags fwdiag.Diagnostics
 
-		rootDevice := config["root_device"].(string)
-		if rootDevice != "" {
-			configOpts.RootDevice = &rootDevice
-		}
-
-		// configOpts.InitRD = config["initrd"].(string)
-		// TODO(displague) need a disk_label to initrd lookup?
-		devices, ok := config["devices"].([]any)
-		if !ok {
-			return configIDMap, fmt.Errorf("Error converting config devices")
-		}
-		for _, device := range devices {
-			deviceMap := device.(map[string]any)
-			confDevices, err := expandInstanceConfigDeviceMap(deviceMap, diskIDLabelMap)
-			if err != nil {
-				return configIDMap, err
-			}
-			configOpts.Devices = *confDevices
+		configOpts := config.GetCreateOptions(ctx, diskIDLabelMap, &diags)
+		if err := diagnosticsError(diags); err != nil {
+			return configIDMap, err
 		}
 
 		if err := detachConfigVolumes(ctx, configOpts.Devices, detacher); err != nil {
@@ -131,15 +80,15 @@ func createInstanceConfigsFromSet(
 func updateInstanceConfigs(
 	ctx context.Context,
 	client linodego.Client,
-	d *schema.ResourceData,
 	instance linodego.Instance,
-	tfConfigsOld, tfConfigsNew any,
+	tfConfigsOld, tfConfigsNew []ConfigModel,
 	diskIDLabelMap map[string]int,
 	bootConfigLabel string,
 ) (bool, map[string]int, []*linodego.InstanceConfig, error) {
 	var updatedConfigMap map[string]int
 	var rebootInstance bool
 	var updatedConfigs []*linodego.InstanceConfig
+	var diags fwdiag.Diagnostics
 
 	configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
 	if err != nil {
@@ -159,84 +108,34 @@ func updateInstanceConfigs(
 	oldConfigLabels := make([]string, 0)
 
 	var oldBootInterfaces, newBootInterfaces []string
-	for _, tfConfigOld := range tfConfigsOld.([]any) {
-		if oldConfig, ok := tfConfigOld.(map[string]any); ok {
-			oldLabel := oldConfig["label"].(string)
-			oldConfigLabels = append(oldConfigLabels, oldLabel)
-			if oldLabel == bootConfigLabel {
-				for _, iface := range oldConfig["interface"].([]any) {
-					oldBootInterfaces = append(oldBootInterfaces, iface.(map[string]any)["ipam_address"].(string))
-				}
+	for _, oldConfig := range tfConfigsOld {
+		oldLabel := oldConfig.Label.ValueString()
+		oldConfigLabels = append(oldConfigLabels, oldLabel)
+		if oldLabel == bootConfigLabel {
+			for _, iface := range blockModels[InterfaceModel](ctx, oldConfig.Interface, &diags) {
+				oldBootInterfaces = append(oldBootInterfaces, iface.IPAMAddress.ValueString())
 			}
 		}
 	}
-	tfConfigs := tfConfigsNew.([]any)
 	updatedConfigs = make([]*linodego.InstanceConfig, 0)
-	updatedConfigMap = make(map[string]int, len(tfConfigs))
-	for _, tfConfig := range tfConfigs {
-		tfc, _ := tfConfig.(map[string]any)
-		label, _ := tfc["label"].(string)
-		rootDevice, _ := tfc["root_device"].(string)
+	updatedConfigMap = make(map[string]int, len(tfConfigsNew))
+	for _, tfc := range tfConfigsNew {
+		label := tfc.Label.ValueString()
 		if existingConfig, existing := configMap[label]; existing {
-			configUpdateOpts := existingConfig.GetUpdateOptions()
-			configUpdateOpts.Kernel = tfc["kernel"].(string)
-			configUpdateOpts.RunLevel = tfc["run_level"].(string)
-			configUpdateOpts.VirtMode = tfc["virt_mode"].(string)
-			configUpdateOpts.RootDevice = rootDevice
-			configUpdateOpts.Comments = tfc["comments"].(string)
-			configUpdateOpts.MemoryLimit = tfc["memory_limit"].(int)
-
-			tfcHelpersRaw, helpersFound := tfc["helpers"]
-			if tfcHelpers, ok := tfcHelpersRaw.([]any); helpersFound && ok {
-				helpersMap := tfcHelpers[0].(map[string]any)
-				configUpdateOpts.Helpers = &linodego.InstanceConfigHelpers{
-					UpdateDBDisabled:  helpersMap["updatedb_disabled"].(bool),
-					Distro:            helpersMap["distro"].(bool),
-					ModulesDep:        helpersMap["modules_dep"].(bool),
-					Network:           helpersMap["network"].(bool),
-					DevTmpFsAutomount: helpersMap["devtmpfs_automount"].(bool),
-				}
-
-			}
-
-			configUpdateOpts.Interfaces = make([]linodego.InstanceConfigInterfaceCreateOptions, 0)
+			configUpdateOpts := tfc.GetUpdateOptions(ctx, existingConfig, diskIDLabelMap, &diags)
 
-			if interfaces, ok := tfc["interface"]; ok {
-				interfaces := interfaces.([]any)
-
-				configUpdateOpts.Interfaces = make([]linodego.InstanceConfigInterfaceCreateOptions, len(interfaces))
-
-				for i, ni := range interfaces {
-					mappedInterface := ni.(map[string]any)
-					configUpdateOpts.Interfaces[i] = helper.ExpandConfigInterface(mappedInterface)
-					if label == bootConfigLabel {
-						newBootInterfaces = append(newBootInterfaces, mappedInterface["ipam_address"].(string))
-					}
+			if label == bootConfigLabel {
+				for _, iface := range blockModels[InterfaceModel](ctx, tfc.Interface, &diags) {
+					newBootInterfaces = append(newBootInterfaces, iface.IPAMAddress.ValueString())
 				}
-
 			}
 
-			if reflect.DeepEqual(configUpdateOpts.Interfaces, existingConfig.GetUpdateOptions().Interfaces) {
-				configUpdateOpts.Interfaces = nil
-			}
-
-			tfcDevicesRaw, devicesFound := tfc["devices"]
-			if tfcDevices, ok := tfcDevicesRaw.([]any); devicesFound && ok {
-				devices := tfcDevices[0].(map[string]any)
-
-				configUpdateOpts.Devices, err = expandInstanceConfigDeviceMap(devices, diskIDLabelMap)
-				if err != nil {
-					return rebootInstance, updatedConfigMap, updatedConfigs, err
-				}
-				if configUpdateOpts.Devices != nil && emptyConfigDeviceMap(*configUpdateOpts.Devices) {
-					configUpdateOpts.Devices = nil
-				}
-			} else {
-				configUpdateOpts.Devices = nil
+			if err := diagnosticsError(diags); err != nil {
+				return rebootInstance, updatedConfigMap, updatedConfigs, err
 			}
 
 			if configUpdateOpts.Devices != nil {
-				detacher := makeVolumeDetacherIgnoreAttached(client, d)
+				detacher := makeVolumeDetacherIgnoreAttached(client, instance.ID)
 
 				if detachErr := detachConfigVolumes(ctx, *configUpdateOpts.Devices, detacher); detachErr != nil {
 					return rebootInstance, updatedConfigMap, updatedConfigs, detachErr
@@ -255,10 +154,10 @@ func updateInstanceConfigs(
 
 			updatedConfigMap[updatedConfig.Label] = updatedConfig.ID
 		} else {
-			detacher := makeVolumeDetacher(client, d)
+			detacher := makeVolumeDetacher(client)
 
 			configIDMap, err := createInstanceConfigsFromSet(
-				ctx, client, instance.ID, []any{tfc}, diskIDLabelMap, detacher)
+				ctx, client, instance.ID, []ConfigModel{tfc}, diskIDLabelMap, detacher)
 			if err != nil {
 				return rebootInstance, updatedConfigMap, updatedConfigs, err
 			}
@@ -541,7 +440,7 @@ func emptyConfigDeviceMap(dmap linodego.InstanceConfigDeviceMap) bool {
 
 type volumeDetacher func(context.Context, int, string) error
 
-func makeVolumeDetacher(client linodego.Client, d *schema.ResourceData) volumeDetacher {
+func makeVolumeDetacher(client linodego.Client) volumeDetacher {
 	return func(ctx context.Context, volumeID int, reason string) error {
 		ctx = helper.SetLogFieldBulk(ctx, map[string]any{
 			"volume_id": volumeID,
@@ -558,7 +457,7 @@ func makeVolumeDetacher(client linodego.Client, d *schema.ResourceData) volumeDe
 		tflog.Debug(ctx, "client.WaitForVolumeLinodeID(...)", map[string]any{
 			"linode_id": nil,
 		})
-		if _, err := client.WaitForVolumeLinodeID(ctx, volumeID, nil, getDeadlineSeconds(ctx, d)); err != nil {
+		if _, err := client.WaitForVolumeLinodeID(ctx, volumeID, nil, getDeadlineSeconds(ctx)); err != nil {
 			return err
 		}
 
@@ -568,18 +467,13 @@ func makeVolumeDetacher(client linodego.Client, d *schema.ResourceData) volumeDe
 	}
 }
 
-func makeVolumeDetacherIgnoreAttached(client linodego.Client, d *schema.ResourceData) volumeDetacher {
+func makeVolumeDetacherIgnoreAttached(client linodego.Client, id int) volumeDetacher {
 	return func(ctx context.Context, volumeID int, reason string) error {
 		ctx = helper.SetLogFieldBulk(ctx, map[string]any{
 			"volume_id": volumeID,
 			"reason":    reason,
 		})
 
-		id, err := strconv.Atoi(d.Id())
-		if err != nil {
-			return err
-		}
-
 		vol, err := client.GetVolume(ctx, volumeID)
 		if err != nil {
 			return err
@@ -590,7 +484,7 @@ func makeVolumeDetacherIgnoreAttached(client linodego.Client, d *schema.Resource
 			return nil
 		}
 
-		return makeVolumeDetacher(client, d)(ctx, volumeID, reason)
+		return makeVolumeDetacher(client)(ctx, volumeID, reason)
 	}
 }
 
@@ -598,63 +492,13 @@ func createInstanceDisk(
 	ctx context.Context,
 	client linodego.Client,
 	instance linodego.Instance,
-	disk diskSpec,
-	d *schema.ResourceData,
+	diskOpts linodego.InstanceDiskCreateOptions,
 ) (*linodego.InstanceDisk, error) {
-	diskOpts := linodego.InstanceDiskCreateOptions{
-		Label:      disk["label"].(string),
-		Filesystem: disk["filesystem"].(string),
-		Size:       disk["size"].(int),
-	}
-
-	if image, ok := disk["image"]; ok {
-		diskOpts.Image = image.(string)
-
-		if rootPass, ok := disk["root_pass"]; ok && rootPass != "" {
-			diskOpts.RootPass = rootPass.(string)
-		} else {
-			var err error
-			diskOpts.RootPass, err = helper.CreateRandomRootPassword()
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if authorizedKeys, ok := disk["authorized_keys"]; ok {
-			for _, sshKey := range authorizedKeys.([]any) {
-				if sshKey == nil {
-					return nil, fmt.Errorf(
-						"invalid input for disk authorized_keys: keys cannot be empty or null")
-				}
-
-				diskOpts.AuthorizedKeys = append(diskOpts.AuthorizedKeys, sshKey.(string))
-			}
-		}
-
-		if authorizedUsers, ok := disk["authorized_users"]; ok {
-			for _, user := range authorizedUsers.([]any) {
-				if user == nil {
-					return nil, fmt.Errorf(
-						"invalid input for disk authorized_users: users cannot be empty or null")
-				}
-
-				diskOpts.AuthorizedUsers = append(diskOpts.AuthorizedUsers, user.(string))
-			}
-		}
-
-		if stackscriptID, ok := disk["stackscript_id"]; ok {
-			diskOpts.StackscriptID = stackscriptID.(int)
-		}
-
-		if stackscriptDataRaw, ok := disk["stackscript_data"]; ok {
-			stackscriptData, ok := stackscriptDataRaw.(map[string]any)
-			if !ok {
-				return nil, fmt.Errorf("Error parsing stackscript_data: expected map[string]interface{}")
-			}
-			diskOpts.StackscriptData = make(map[string]string, len(stackscriptData))
-			for name, value := range stackscriptData {
-				diskOpts.StackscriptData[name] = value.(string)
-			}
+	if diskOpts.Image != "" && diskOpts.RootPass == "" {
+		var err error
+		diskOpts.RootPass, err = helper.CreateRandomRootPassword()
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -685,7 +529,7 @@ func createInstanceDisk(
 	ctx = tflog.SetField(ctx, "disk_id", instanceDisk.ID)
 
 	tflog.Debug(ctx, "Waiting for disk creation to complete")
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("Error waiting for Linode instance %d disk: %s", instanceDisk.ID, err)
 	}
@@ -717,9 +561,8 @@ func getInstanceDisks(
 
 // getInstanceDiskLabelIDMap returns a map of an instances disk labels to their corresponding IDs.
 func getInstanceDiskLabelIDMap(
-	ctx context.Context, client linodego.Client, d *schema.ResourceData, instanceID int,
+	ctx context.Context, client linodego.Client, diskSpecs []DiskModel, instanceID int,
 ) (map[string]int, error) {
-	diskSpecs := d.Get("disk").([]any)
 	disks, err := getInstanceDisks(ctx, client, instanceID)
 	if err != nil {
 		return nil, err
@@ -727,7 +570,7 @@ func getInstanceDiskLabelIDMap(
 
 	labelIDMap := make(map[string]int)
 	for _, spec := range diskSpecs {
-		label := spec.(map[string]any)["label"].(string)
+		label := spec.Label.ValueString()
 		disk, ok := disks[label]
 		if !ok {
 			return nil, fmt.Errorf(`could not map disk label "%s" to an ID; not found`, label)
@@ -738,31 +581,26 @@ func getInstanceDiskLabelIDMap(
 }
 
 // getInstanceDiskSpecChange returns a map of disk specs indexed by label.
-func getInstanceDiskSpecChange(d *schema.ResourceData) (oldDiskSpecs, newDiskSpecs map[string]diskSpec) {
-	old, new := d.GetChange("disk")
-	oldDisk := old.([]any)
-	newDisk := new.([]any)
-	oldDiskSpecs = make(map[string]diskSpec)
-	newDiskSpecs = make(map[string]diskSpec)
+func getInstanceDiskSpecChange(oldDisk, newDisk []DiskModel) (oldDiskSpecs, newDiskSpecs map[string]DiskModel) {
+	oldDiskSpecs = make(map[string]DiskModel)
+	newDiskSpecs = make(map[string]DiskModel)
 
 	for _, spec := range oldDisk {
-		spec := spec.(map[string]any)
-		oldDiskSpecs[spec["label"].(string)] = spec
+		oldDiskSpecs[spec.Label.ValueString()] = spec
 	}
 	for _, spec := range newDisk {
-		spec := spec.(map[string]any)
-		newDiskSpecs[spec["label"].(string)] = spec
+		newDiskSpecs[spec.Label.ValueString()] = spec
 	}
 	return oldDiskSpecs, newDiskSpecs
 }
 
 // getInstanceDiskSpecDiffs sorts the disk specs by added, removed, and existing.
 func getInstanceDiskSpecDiffs(
-	oldDiskSpecs, newDiskSpecs map[string]diskSpec,
-) (added, removed, existing map[string]diskSpec) {
-	added = make(map[string]diskSpec)
-	removed = make(map[string]diskSpec)
-	existing = make(map[string]diskSpec)
+	oldDiskSpecs, newDiskSpecs map[string]DiskModel,
+) (added, removed, existing map[string]DiskModel) {
+	added = make(map[string]DiskModel)
+	removed = make(map[string]DiskModel)
+	existing = make(map[string]DiskModel)
 
 	placed := make(map[string]struct{})
 	for label, spec := range newDiskSpecs {
@@ -789,9 +627,9 @@ func getInstanceDiskSpecDiffs(
 // This function will also warn when there are disks attached to an instance which are not managed by
 // terraform.
 func updateInstanceDisks(
-	ctx context.Context, client linodego.Client, d *schema.ResourceData, instance linodego.Instance,
+	ctx context.Context, client linodego.Client, oldDisks, newDisks []DiskModel, instance linodego.Instance,
 ) (bool, error) {
-	oldDisk, newDisk := getInstanceDiskSpecChange(d)
+	oldDisk, newDisk := getInstanceDiskSpecChange(oldDisks, newDisks)
 	added, removed, existing := getInstanceDiskSpecDiffs(oldDisk, newDisk)
 	disks, err := getInstanceDisks(ctx, client, instance.ID)
 	if err != nil {
@@ -833,7 +671,7 @@ func updateInstanceDisks(
 
 		tflog.Debug(ctx, "Waiting for unused disk to be deleted")
 
-		_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+		_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 		if err != nil {
 			return hasChanges, fmt.Errorf(
 				"error waiting for Instance %d Disk %d to finish deleting: %s", instance.ID, disk.ID, err)
@@ -849,13 +687,13 @@ func updateInstanceDisks(
 		existingDisk := disks[label]
 		// The only non-destructive change supported is resize.
 		// Label renames are not supported because this TF provider relies on the label as an identifier.
-		if spec["size"].(int) != existingDisk.Size {
-			if err := changeInstanceDiskSize(ctx, &client, instance, existingDisk, spec["size"].(int), d); err != nil {
+		if size := int(spec.Size.ValueInt64()); size != existingDisk.Size {
+			if err := changeInstanceDiskSize(ctx, &client, instance, existingDisk, size); err != nil {
 				return hasChanges, err
 			}
 			hasChanges = true
 		}
-		if spec["filesystem"].(string) != string(existingDisk.Filesystem) {
+		if filesystem := spec.Filesystem.ValueString(); filesystem != "" && filesystem != string(existingDisk.Filesystem) {
 			return hasChanges, fmt.Errorf("failed to update disk %d; filesystems can not be changed", existingDisk.ID)
 		}
 		visited[label] = struct{}{}
@@ -863,7 +701,14 @@ func updateInstanceDisks(
 
 	// create disks staged for creation
 	for _, spec := range added {
-		if _, err := createInstanceDisk(ctx, client, instance, spec, d); err != nil {
+		var diags fwdiag.Diagnostics
+
+		diskOpts := spec.GetCreateOptions(ctx, &diags)
+		if err := diagnosticsError(diags); err != nil {
+			return hasChanges, err
+		}
+
+		if _, err := createInstanceDisk(ctx, client, instance, diskOpts); err != nil {
 			return hasChanges, err
 		}
 	}
@@ -879,16 +724,6 @@ func updateInstanceDisks(
 	return hasChanges, nil
 }
 
-// sshKeyState hashes a string passed in as an interface.
-func sshKeyState(val any) string {
-	return hashString(strings.Join(val.([]string), "\n"))
-}
-
-// rootPasswordState hashes a string passed in as an interface.
-func rootPasswordState(val any) string {
-	return hashString(val.(string))
-}
-
 // hashString hashes a string.
 func hashString(key string) string {
 	hash := sha3.Sum512([]byte(key)) //nolint:govet
@@ -933,14 +768,13 @@ func changeInstanceType(
 	targetType string,
 	migrationType linodego.InstanceMigrationType,
 	diskResize bool,
-	d *schema.ResourceData,
 ) (*linodego.Instance, error) {
 	var err error
 	var instance *linodego.Instance
 
 	if migrationType == linodego.ColdMigration {
 		// Cold migration: Ensure instance is offline
-		instance, err = ensureInstanceOffline(ctx, client, instanceID, getDeadlineSeconds(ctx, d))
+		instance, err = ensureInstanceOffline(ctx, client, instanceID, getDeadlineSeconds(ctx))
 		if err != nil {
 			return nil, fmt.Errorf("failed to shut down instance for cold migration: %w", err)
 		}
@@ -956,7 +790,7 @@ func changeInstanceType(
 
 	// We only need to make this request if we plan on resizing the disk
 	if diskResize {
-		primaryDisk, err = getPrimaryImplicitDisk(ctx, d, client, instanceID)
+		primaryDisk, err = getPrimaryImplicitDisk(ctx, client, instanceID)
 		if err != nil {
 			return nil, err
 		}
@@ -983,7 +817,7 @@ func changeInstanceType(
 		return nil, fmt.Errorf("Error resizing Instance %d: %s", instance.ID, err)
 	}
 
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("Error waiting for instance %d to finish resizing: %s", instance.ID, err)
 	}
@@ -992,7 +826,7 @@ func changeInstanceType(
 
 	// Wait for instance status to go back to idle, offline state
 	if instance, err = client.WaitForInstanceStatus(
-		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx),
 	); err != nil {
 		return nil, fmt.Errorf("Error waiting for Instance %d to enter offline state: %s", instance.ID, err)
 	}
@@ -1000,7 +834,7 @@ func changeInstanceType(
 	// Sometimes the API falls behind on updating the disk info, let's make sure it has updated
 	if diskResize && primaryDisk != nil {
 		if _, err := waitForInstanceDiskSizeChange(ctx, client, instance.ID, primaryDisk.ID, primaryDisk.Size,
-			getDeadlineSeconds(ctx, d)); err != nil {
+			getDeadlineSeconds(ctx)); err != nil {
 			return nil, fmt.Errorf("failed to wait for disk resize: %s", err)
 		}
 	}
@@ -1009,19 +843,16 @@ func changeInstanceType(
 }
 
 // returns the amount of disk space used by the new plan and old plan.
-func getDiskSizeChange(oldDisk any, newDisk any) (int, int) {
-	tfDisksOldInterface := oldDisk.([]any)
-	tfDisksNewInterface := newDisk.([]any)
-
+func getDiskSizeChange(oldDisk, newDisk []DiskModel) (int, int) {
 	oldDiskSize := 0
 	newDiskSize := 0
 	// Get total amount of disk usage before and after
-	for _, disk := range tfDisksOldInterface {
-		oldDiskSize += disk.(map[string]any)["size"].(int)
+	for _, disk := range oldDisk {
+		oldDiskSize += int(disk.Size.ValueInt64())
 	}
 
-	for _, disk := range tfDisksNewInterface {
-		newDiskSize += disk.(map[string]any)["size"].(int)
+	for _, disk := range newDisk {
+		newDiskSize += int(disk.Size.ValueInt64())
 	}
 	return oldDiskSize, newDiskSize
 }
@@ -1032,7 +863,6 @@ func changeInstanceDiskSize(
 	instance linodego.Instance,
 	disk linodego.InstanceDisk,
 	targetSize int,
-	d *schema.ResourceData,
 ) error {
 	if instance.Specs.Disk < targetSize {
 		return fmt.Errorf("Error resizing disk %d: size exceeds disk size for Instance %d", disk.ID, instance.ID)
@@ -1045,7 +875,7 @@ func changeInstanceDiskSize(
 		tflog.Debug(ctx, "Waiting for instance to reach offline status for disk resize")
 
 		if _, err := client.WaitForInstanceStatus(
-			ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+			ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx),
 		); err != nil {
 			return fmt.Errorf("Error waiting for Instance %d to go offline: %s", instance.ID, err)
 		}
@@ -1060,7 +890,7 @@ func changeInstanceDiskSize(
 
 	// Wait for instance to go offline. Resize the disk once Linode is shut down.
 	if _, err := client.WaitForInstanceStatus(
-		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx),
 	); err != nil {
 		return fmt.Errorf("Error waiting for Instance %d to go offline: %s", instance.ID, err)
 	}
@@ -1083,14 +913,14 @@ func changeInstanceDiskSize(
 	tflog.Debug(ctx, "Waiting for disk resize operation to complete")
 
 	// Wait for the disk resize operation to complete.
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 	if err != nil {
 		return fmt.Errorf("Error waiting for resize of Instance %d Disk %d: %s", instance.ID, disk.ID, err)
 	}
 
 	// Check to see if the resize operation worked
 	if updatedDisk, err := client.WaitForInstanceDiskStatus(ctx, instance.ID, disk.ID, linodego.DiskReady,
-		getDeadlineSeconds(ctx, d)); err != nil {
+		getDeadlineSeconds(ctx)); err != nil {
 		return fmt.Errorf("Error waiting disk %d on instance %d to be ready: %s", disk.ID, instance.ID, err)
 	} else if updatedDisk.Size != targetSize {
 		return fmt.Errorf(
@@ -1113,12 +943,14 @@ func privateIP(ip net.IP) bool {
 }
 
 func assignConfigDevice(
-	device *linodego.InstanceConfigDevice, dev map[string]any, diskIDLabelMap map[string]int,
+	device *linodego.InstanceConfigDevice, dev DeviceModel, diskIDLabelMap map[string]int,
 ) error {
-	if label, ok := dev["disk_label"].(string); ok && len(label) > 0 {
-		if dev["disk_id"], ok = diskIDLabelMap[label]; !ok {
-			return fmt.Errorf(`Error mapping disk label "%s" to ID`, dev["disk_label"])
+	if label := dev.DiskLabel.ValueString(); len(label) > 0 {
+		diskID, ok := diskIDLabelMap[label]
+		if !ok {
+			return fmt.Errorf(`Error mapping disk label "%s" to ID`, label)
 		}
+		dev.DiskID = types.Int64Value(int64(diskID))
 	}
 	expanded := expandInstanceConfigDevice(dev)
 	if expanded != nil {
@@ -1146,12 +978,9 @@ func getInstanceDefaultDisks(
 // getInstanceTypeChange checks to see if the linode itself was resized.
 func getInstanceTypeChange(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
+	oldType, newType string,
 ) (oldSpec, newSpec *linodego.LinodeType, err error) {
-	old, new := d.GetChange("type")
-	oldType, newType := old.(string), new.(string)
-
 	oldSpec, err = client.GetType(ctx, oldType)
 	if err != nil {
 		return oldSpec, newSpec, err
@@ -1169,24 +998,22 @@ func getInstanceTypeChange(
 // returns bool describing whether change has occurred.
 func applyInstanceDiskSpec(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
 	instance *linodego.Instance,
 	typ *linodego.LinodeType,
+	oldDisks, newDisks []DiskModel,
 ) (bool, error) {
-	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
+	if err := assertDiskConfigFitsInstanceType(newDisks, typ); err != nil {
 		return false, err
 	}
 
-	return updateInstanceDisks(ctx, *client, d, *instance)
+	return updateInstanceDisks(ctx, *client, oldDisks, newDisks, *instance)
 }
 
 // assertDiskConfigFitsInstanceType asserts that the cumulative disk space used by a given disk config fits a given
 // linode type spec for disk capacity.
-func assertDiskConfigFitsInstanceType(d *schema.ResourceData, typ *linodego.LinodeType) error {
-	oldDisks, newDisks := d.GetChange("disk")
-
-	_, newDiskSize := getDiskSizeChange(oldDisks, newDisks)
+func assertDiskConfigFitsInstanceType(newDisks []DiskModel, typ *linodego.LinodeType) error {
+	_, newDiskSize := getDiskSizeChange(nil, newDisks)
 
 	if typ.Disk < newDiskSize {
 		return fmt.Errorf(
@@ -1200,19 +1027,17 @@ func assertDiskConfigFitsInstanceType(d *schema.ResourceData, typ *linodego.Lino
 // specification. If there is sufficient space, it attempts to update the instance type.
 func applyInstanceTypeChange(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
 	instance *linodego.Instance,
 	typ *linodego.LinodeType,
+	resizeDisk bool,
+	migrationType linodego.InstanceMigrationType,
+	newDisks []DiskModel,
+	explicitDisks bool,
 ) (*linodego.Instance, error) {
-	resizeDisk := d.Get("resize_disk").(bool)
-	migrationType := linodego.InstanceMigrationType(
-		d.Get("migration_type").(string),
-	)
-
 	if resizeDisk {
 		// Verify that there are implicit disks defined
-		if d.GetRawConfig().GetAttr("image").IsNull() && d.GetRawConfig().GetAttr("disk").LengthInt() > 0 {
+		if explicitDisks {
 			return nil, fmt.Errorf("resize_disk requires that no explicit disks are defined")
 		}
 
@@ -1220,7 +1045,7 @@ func applyInstanceTypeChange(
 			return nil, err
 		}
 
-		usedSpace, err := getDiskSizeSum(ctx, d, client, instance.ID)
+		usedSpace, err := getDiskSizeSum(ctx, client, instance.ID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to calculate total disk size: %s", err)
 		}
@@ -1230,25 +1055,21 @@ func applyInstanceTypeChange(
 		}
 	}
 
-	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
+	if err := assertDiskConfigFitsInstanceType(newDisks, typ); err != nil {
 		return nil, err
 	}
 
-	return changeInstanceType(ctx, client, instance.ID, typ.ID, migrationType, resizeDisk, d)
+	return changeInstanceType(ctx, client, instance.ID, typ.ID, migrationType, resizeDisk)
 }
 
 // applyInstanceMigration synchronously migrates a Linode to a new region.
 func applyInstanceMigration(
 	ctx context.Context,
-	d *schema.ResourceData,
 	client *linodego.Client,
 	instance *linodego.Instance,
 	targetRegion string,
+	migrationType linodego.InstanceMigrationType,
 ) (*linodego.Instance, error) {
-	migrationType := linodego.InstanceMigrationType(
-		d.Get("migration_type").(string),
-	)
-
 	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
 		"target_region":  targetRegion,
 		"migration_type": migrationType,
@@ -1276,13 +1097,31 @@ func applyInstanceMigration(
 
 	tflog.Debug(ctx, "Waiting for migration to finish")
 
-	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+	_, err = p.WaitForFinished(ctx, getDeadlineSeconds(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to wait for instance %d to finish migration: %w", instance.ID, err)
 	}
 
 	tflog.Debug(ctx, "Instance migration has finished")
 
+	// The migration event can finish before the Linode has been returned
+	// to its original power state in the target region.
+	switch instance.Status {
+	case linodego.InstanceRunning, linodego.InstanceOffline:
+		tflog.Debug(ctx, "Waiting for instance to return to its original status", map[string]any{
+			"status": instance.Status,
+		})
+
+		if _, err := client.WaitForInstanceStatus(
+			ctx, instance.ID, instance.Status, getDeadlineSeconds(ctx),
+		); err != nil {
+			return nil, fmt.Errorf(
+				"failed to wait for instance %d to return to status %s after migration: %w",
+				instance.ID, instance.Status, err,
+			)
+		}
+	}
+
 	result, err := client.GetInstance(ctx, instance.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh instance %d: %w", instance.ID, err)
@@ -1291,6 +1130,80 @@ func applyInstanceMigration(
 	return result, nil
 }
 
+// isInterfaceGenerationUpgrade returns whether changing the interface generation of a Linode
+// from the given old value to the given new value upgrades its interfaces in place.
+func isInterfaceGenerationUpgrade(oldGeneration, newGeneration types.String) bool {
+	return oldGeneration.ValueString() == string(linodego.GenerationLegacyConfig) &&
+		newGeneration.ValueString() == string(linodego.GenerationLinode)
+}
+
+// validateInterfaceUpgrade validates that no legacy configuration interfaces are planned
+// for an Instance whose interfaces are being upgraded to Linode interfaces.
+func validateInterfaceUpgrade(ctx context.Context, plan *ResourceModel, diags *fwdiag.Diagnostics) {
+	const detail = "Legacy configuration interfaces can't be used with Linode interfaces. " +
+		"Remove them from the configuration; they are converted to Linode interfaces by the upgrade."
+
+	if len(plan.Interface.Elements()) > 0 {
+		diags.AddAttributeError(path.Root("interface"), "Legacy Interfaces Configured", detail)
+	}
+
+	for i, config := range blockModels[ConfigModel](ctx, plan.Config, diags) {
+		if len(config.Interface.Elements()) > 0 {
+			diags.AddAttributeError(
+				path.Root("config").AtListIndex(i).AtName("interface"), "Legacy Interfaces Configured", detail,
+			)
+		}
+	}
+}
+
+// upgradeInstanceInterfaces upgrades the legacy configuration interfaces of the config
+// with the given ID to Linode interfaces, using the config the API defaults to if zero.
+func upgradeInstanceInterfaces(
+	ctx context.Context,
+	client *linodego.Client,
+	instanceID, configID int,
+) (*linodego.LinodeInterfacesUpgrade, error) {
+	upgradeOpts := linodego.LinodeInterfacesUpgradeOptions{
+		DryRun: linodego.Pointer(false),
+	}
+
+	if configID != 0 {
+		upgradeOpts.ConfigID = &configID
+	}
+
+	tflog.Debug(ctx, "client.UpgradeInterfaces(...)", map[string]any{
+		"options": upgradeOpts,
+	})
+
+	result, err := client.UpgradeInterfaces(ctx, instanceID, upgradeOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade interfaces of instance %d: %w", instanceID, err)
+	}
+
+	return result, nil
+}
+
+// formatInterfaceImportIDs returns the import IDs of the given Linode interfaces
+// for the linode_interface resource, one per line.
+func formatInterfaceImportIDs(instanceID int, interfaces []linodego.LinodeInterface) string {
+	var sb strings.Builder
+
+	for _, iface := range interfaces {
+		kind := "public"
+
+		switch {
+		case iface.VPC != nil:
+			kind = "vpc"
+		case iface.VLAN != nil:
+			kind = "vlan"
+		}
+
+		fmt.Fprintf(&sb, "\n  %d,%d (%s)", instanceID, iface.ID, kind)
+	}
+
+	return sb.String()
+}
+
 // detachConfigVolumes detaches any volumes associated with an InstanceConfig.Devices struct.
 func detachConfigVolumes(
 	ctx context.Context, dmap linodego.InstanceConfigDeviceMap, detacher volumeDetacher,
@@ -1349,15 +1262,8 @@ func isInstanceBooted(instance *linodego.Instance) bool {
 		instance.Status == linodego.InstanceBooting
 }
 
-func validateBooted(ctx context.Context, d *schema.ResourceData) error {
-	booted := d.Get("booted").(bool)
-	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
-
-	_, imageOk := d.GetOk("image")
-	_, disksOk := d.GetOk("disk")
-	_, configsOk := d.GetOk("config")
-
-	if !bootedNull && booted && !imageOk && (!disksOk || !configsOk) {
+func validateBooted(booted types.Bool, imageOk, disksOk, configsOk bool) error {
+	if booted.ValueBool() && !imageOk && (!disksOk || !configsOk) {
 		return fmt.Errorf("booted requires an image or disk/config be defined")
 	}
 
@@ -1365,16 +1271,11 @@ func validateBooted(ctx context.Context, d *schema.ResourceData) error {
 }
 
 func handleBootedUpdate(
-	ctx context.Context, d *schema.ResourceData, meta any, instanceID, configID int,
+	ctx context.Context, client linodego.Client, booted types.Bool, instanceID, configID int,
 ) error {
-	client := meta.(*helper.ProviderMeta).Client
-
-	deadlineSeconds := getDeadlineSeconds(ctx, d)
+	deadlineSeconds := getDeadlineSeconds(ctx)
 
-	booted := d.Get("booted")
-	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
-
-	if bootedNull {
+	if booted.IsNull() || booted.IsUnknown() {
 		return nil
 	}
 
@@ -1384,13 +1285,13 @@ func handleBootedUpdate(
 	}
 
 	// Boot or shutdown the instance if necessary
-	if instStatus != linodego.InstanceRunning && booted.(bool) {
+	if instStatus != linodego.InstanceRunning && booted.ValueBool() {
 		if err := helper.BootInstanceSync(ctx, &client, instanceID, configID, deadlineSeconds); err != nil {
 			return err
 		}
 	}
 
-	if instStatus != linodego.InstanceOffline && !booted.(bool) {
+	if instStatus != linodego.InstanceOffline && !booted.ValueBool() {
 		if err := helper.ShutDownInstanceSync(ctx, &client, instanceID, deadlineSeconds); err != nil {
 			return err
 		}
@@ -1399,7 +1300,7 @@ func handleBootedUpdate(
 	return nil
 }
 
-func getDiskSizeSum(ctx context.Context, d *schema.ResourceData,
+func getDiskSizeSum(ctx context.Context,
 	client *linodego.Client, instanceID int,
 ) (int, error) {
 	disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
@@ -1448,7 +1349,7 @@ func validateImplicitDisks(ctx context.Context,
 	return nil
 }
 
-func getPrimaryImplicitDisk(ctx context.Context, d *schema.ResourceData,
+func getPrimaryImplicitDisk(ctx context.Context,
 	client *linodego.Client, instanceID int,
 ) (*linodego.InstanceDisk, error) {
 	disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
@@ -1655,23 +1556,152 @@ func reassignPlacementGroup(
 	return nil
 }
 
-// expandInstancePlacementGroup expands the user-defined `placement_group` block into the
-// linodego InstanceCreatePlacementGroupOptions struct.
-func getPlacementGroupCreateOptions(ctx context.Context, d *schema.ResourceData) *linodego.InstanceCreatePlacementGroupOptions {
-	if _, ok := d.GetOk("placement_group.0"); !ok {
-		return nil
+// getInstanceConfigLabelIDMap returns a map of an instances config labels to their corresponding IDs.
+func getInstanceConfigLabelIDMap(
+	ctx context.Context, client *linodego.Client, instanceID int,
+) (map[string]int, error) {
+	configs, err := client.ListInstanceConfigs(ctx, instanceID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching the config for Instance %d: %s", instanceID, err)
 	}
 
-	pgOptions := linodego.InstanceCreatePlacementGroupOptions{
-		// ID is required when the block is defined, so we don't
-		// need to do an OK check here
-		ID: d.Get("placement_group.0.id").(int),
-		CompliantOnly: helper.SDKv2UnwrapOptionalConfigAttr[bool](
-			ctx,
-			d,
-			"placement_group.0.compliant_only",
-		),
+	labelIDMap := make(map[string]int, len(configs))
+	for _, config := range configs {
+		labelIDMap[config.Label] = config.ID
+	}
+
+	return labelIDMap, nil
+}
+
+// recentEventsCount is the number of recent events included in
+// diagnostics for instances that fail to reach their expected status.
+const recentEventsCount = 5
+
+// recentConsoleLinesCount is the number of lines of console output included
+// in diagnostics for instances that fail to reach their expected status.
+const recentConsoleLinesCount = 20
+
+// describeInstanceWaitFailure returns the given wait error followed by the most recent
+// events of the instance and, if a Lish private key is configured, the most recent
+// lines of its console output.
+func describeInstanceWaitFailure(
+	ctx context.Context,
+	client *linodego.Client,
+	lishPrivateKey types.String,
+	instanceID int,
+	waitErr error,
+) string {
+	// The wait may have failed because the deadline was exceeded,
+	// so the details are fetched with a short deadline of their own.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+
+	var sb strings.Builder
+
+	sb.WriteString(waitErr.Error())
+
+	events, err := listRecentInstanceEvents(ctx, client, instanceID, recentEventsCount)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list recent instance events", map[string]any{
+			"error": err.Error(),
+		})
+	} else if len(events) > 0 {
+		fmt.Fprintf(&sb, "\n\nMost recent events of Linode Instance %d:\n%s", instanceID, formatEvents(events))
+	}
+
+	if lishPrivateKey.IsNull() {
+		return sb.String()
+	}
+
+	lines, err := getRecentConsoleOutput(ctx, client, lishPrivateKey.ValueString(), instanceID)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to get recent instance console output", map[string]any{
+			"error": err.Error(),
+		})
+	} else if len(lines) > 0 {
+		fmt.Fprintf(
+			&sb, "\n\nMost recent console output of Linode Instance %d:\n%s\n",
+			instanceID, strings.Join(lines, "\n"),
+		)
+	}
+
+	return sb.String()
+}
+
+// getRecentConsoleOutput reads the most recent lines of console output
+// of the given instance through Lish.
+func getRecentConsoleOutput(
+	ctx context.Context,
+	client *linodego.Client,
+	privateKey string,
+	instanceID int,
+) ([]string, error) {
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := client.GetProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.GetLishConsoleOutput(
+		ctx, privateKey, profile.Username, instance.Region, instance.Label, recentConsoleLinesCount,
+	)
+}
+
+// listRecentInstanceEvents lists up to count of the most recent events for the given instance.
+func listRecentInstanceEvents(
+	ctx context.Context,
+	client *linodego.Client,
+	instanceID,
+	count int,
+) ([]linodego.Event, error) {
+	filter, err := json.Marshal(map[string]any{
+		"entity.id":   instanceID,
+		"entity.type": linodego.EntityLinode,
+		"+order_by":   "created",
+		"+order":      "desc",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the first page is requested to avoid paginating through the entire event history
+	events, err := client.ListEvents(ctx, &linodego.ListOptions{
+		PageOptions: &linodego.PageOptions{Page: 1},
+		Filter:      string(filter),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) > count {
+		events = events[:count]
+	}
+
+	return events, nil
+}
+
+// formatEvents formats the given events as a human-readable list for diagnostics.
+func formatEvents(events []linodego.Event) string {
+	var sb strings.Builder
+
+	for _, event := range events {
+		created := "unknown time"
+		if event.Created != nil {
+			created = event.Created.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(&sb, "- %s %s (%s, %d%%)", created, event.Action, event.Status, event.PercentComplete)
+
+		if event.Message != "" {
+			fmt.Fprintf(&sb, ": %s", event.Message)
+		}
+
+		sb.WriteString("\n")
 	}
 
-	return &pgOptions
+	return sb.String()
 }
@@ -0,0 +1,62 @@
+//go:build unit
+
+package instance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatEvents(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	events := []linodego.Event{
+		{
+			Action:          linodego.ActionLinodeBoot,
+			Status:          linodego.EventFailed,
+			PercentComplete: 40,
+			Message:         "Linode failed to boot",
+			Created:         &created,
+		},
+		{
+			Action:          linodego.ActionLinodeCreate,
+			Status:          linodego.EventFinished,
+			PercentComplete: 100,
+		},
+	}
+
+	require.Equal(
+		t,
+		"- 2024-01-02T03:04:05Z linode_boot (failed, 40%): Linode failed to boot\n"+
+			"- unknown time linode_create (finished, 100%)\n",
+		formatEvents(events),
+	)
+}
+
+func TestFormatEvents_empty(t *testing.T) {
+	require.Empty(t, formatEvents(nil))
+}
+
+func TestIsInterfaceGenerationUpgrade(t *testing.T) {
+	legacy := types.StringValue(string(linodego.GenerationLegacyConfig))
+	linode := types.StringValue(string(linodego.GenerationLinode))
+
+	require.True(t, isInterfaceGenerationUpgrade(legacy, linode))
+	require.False(t, isInterfaceGenerationUpgrade(linode, legacy))
+	require.False(t, isInterfaceGenerationUpgrade(legacy, legacy))
+	require.False(t, isInterfaceGenerationUpgrade(types.StringNull(), linode))
+}
+
+func TestFormatInterfaceImportIDs(t *testing.T) {
+	result := formatInterfaceImportIDs(123, []linodego.LinodeInterface{
+		{ID: 1, Public: &linodego.PublicInterface{}},
+		{ID: 2, VPC: &linodego.VPCInterface{}},
+		{ID: 3, VLAN: &linodego.VLANInterface{}},
+	})
+
+	require.Equal(t, "\n  123,1 (public)\n  123,2 (vpc)\n  123,3 (vlan)", result)
+}
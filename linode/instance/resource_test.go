@@ -17,9 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper"
@@ -121,6 +123,51 @@ func TestAccResourceInstance_basic_smoke(t *testing.T) {
 	})
 }
 
+// sdkv2ExternalProviders pins the 3.x releases of the provider, which serve linode_instance
+// with the SDKv2, to create the state upgraded by the framework implementation.
+var sdkv2ExternalProviders = map[string]resource.ExternalProvider{
+	"linode": {
+		Source:            "linode/linode",
+		VersionConstraint: "~> 3.0",
+	},
+}
+
+func TestAccResourceInstance_upgradeFromSDKv2(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	config := tmpl.Basic(t, instanceName, acceptance.PublicKeyMaterial, testRegion, rootPass)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		CheckDestroy: acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: sdkv2ExternalProviders,
+				Config:            config,
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "label", instanceName),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_vpu(t *testing.T) {
 	t.Parallel()
 
@@ -1007,6 +1054,65 @@ func TestAccResourceInstance_resetRootPass(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstance_resetRootPassWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+
+	rootPass := acctest.RandString(64)
+
+	var originalInstanceID int
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.RootPassWriteOnly(t, instanceName, testRegion, rootPass, 1),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+
+					func(s *terraform.State) error {
+						originalInstanceID = instance.ID
+						return nil
+					},
+
+					resource.TestCheckNoResourceAttr(resName, "root_pass"),
+					resource.TestCheckNoResourceAttr(resName, "root_pass_wo"),
+					resource.TestCheckResourceAttr(resName, "root_pass_wo_version", "1"),
+				),
+			},
+			{
+				Config: tmpl.RootPassWriteOnly(t, instanceName, testRegion, rootPass+"updated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+
+					func(s *terraform.State) error {
+						if instance.ID != originalInstanceID {
+							return fmt.Errorf(
+								"instance was recreated: original ID %d, new ID %d",
+								originalInstanceID,
+								instance.ID,
+							)
+						}
+						return nil
+					},
+
+					resource.TestCheckNoResourceAttr(resName, "root_pass_wo"),
+					resource.TestCheckResourceAttr(resName, "root_pass_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_updateMaintenancePolicy(t *testing.T) {
 	t.Parallel()
 	var instance linodego.Instance
@@ -2302,6 +2408,41 @@ func TestAccResourceInstance_userData(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstance_userDataMultipart(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{linodego.CapabilityMetadata}, "core")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      tmpl.UserDataInvalid(t, instanceName, region, rootPass),
+				ExpectError: regexp.MustCompile("invalid cloud-config YAML"),
+			},
+			{
+				Config: tmpl.UserDataMultipart(t, instanceName, region, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "has_user_data", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_requestQuantity(t *testing.T) {
 	t.Skip("firewall no longer available in old test provider")
 	t.Parallel()
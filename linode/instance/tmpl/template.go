@@ -18,6 +18,8 @@ type TemplateData struct {
 	Region   string
 	RootPass string
 
+	RootPassVersion int
+
 	SwapSize int
 
 	StackScriptName string
@@ -47,6 +49,17 @@ func Basic(t testing.TB, label, pubKey, region string, rootPass string) string {
 		})
 }
 
+func RootPassWriteOnly(t testing.TB, label, region, rootPass string, rootPassVersion int) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_root_pass_wo", TemplateData{
+			Label:           label,
+			Image:           acceptance.TestImageLatest,
+			Region:          region,
+			RootPass:        rootPass,
+			RootPassVersion: rootPassVersion,
+		})
+}
+
 func VPU(t testing.TB, label, pubKey, region string, rootPass string) string {
 	return acceptance.ExecuteTemplate(t,
 		"instance_vpu", TemplateData{
@@ -666,6 +679,26 @@ func UserData(t testing.TB, label, region string, rootPass string) string {
 		})
 }
 
+func UserDataMultipart(t testing.TB, label, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_userdata_multipart", TemplateData{
+			Label:    label,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func UserDataInvalid(t testing.TB, label, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_userdata_invalid", TemplateData{
+			Label:    label,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
 func DiskEncryption(
 	t testing.TB,
 	label,
@@ -13,22 +13,27 @@ import (
 )
 
 type ResourceModel struct {
-	ID              types.String      `tfsdk:"id"`
-	Label           types.String      `tfsdk:"label"`
-	LinodeID        types.Int64       `tfsdk:"linode_id"`
-	Size            types.Int64       `tfsdk:"size"`
-	AuthorizedKeys  types.Set         `tfsdk:"authorized_keys"`
-	AuthorizedUsers types.Set         `tfsdk:"authorized_users"`
-	Filesystem      types.String      `tfsdk:"filesystem"`
-	Image           types.String      `tfsdk:"image"`
-	RootPass        types.String      `tfsdk:"root_pass"`
-	StackScriptData types.Map         `tfsdk:"stackscript_data"`
-	StackScriptID   types.Int64       `tfsdk:"stackscript_id"`
-	Created         timetypes.RFC3339 `tfsdk:"created"`
-	Updated         timetypes.RFC3339 `tfsdk:"updated"`
-	Status          types.String      `tfsdk:"status"`
-	DiskEncryption  types.String      `tfsdk:"disk_encryption"`
-	Timeouts        timeouts.Value    `tfsdk:"timeouts"`
+	ID                       types.String      `tfsdk:"id"`
+	Label                    types.String      `tfsdk:"label"`
+	LinodeID                 types.Int64       `tfsdk:"linode_id"`
+	Size                     types.Int64       `tfsdk:"size"`
+	AuthorizedKeys           types.Set         `tfsdk:"authorized_keys"`
+	AuthorizedUsers          types.Set         `tfsdk:"authorized_users"`
+	Filesystem               types.String      `tfsdk:"filesystem"`
+	Image                    types.String      `tfsdk:"image"`
+	RootPass                 types.String      `tfsdk:"root_pass"`
+	RootPassWO               types.String      `tfsdk:"root_pass_wo"`
+	RootPassWOVersion        types.Int64       `tfsdk:"root_pass_wo_version"`
+	StackScriptData          types.Map         `tfsdk:"stackscript_data"`
+	StackScriptDataWO        types.Map         `tfsdk:"stackscript_data_wo"`
+	StackScriptDataWOVersion types.Int64       `tfsdk:"stackscript_data_wo_version"`
+	StackScriptID            types.Int64       `tfsdk:"stackscript_id"`
+	CloneFromDiskID          types.Int64       `tfsdk:"clone_from_disk_id"`
+	Created                  timetypes.RFC3339 `tfsdk:"created"`
+	Updated                  timetypes.RFC3339 `tfsdk:"updated"`
+	Status                   types.String      `tfsdk:"status"`
+	DiskEncryption           types.String      `tfsdk:"disk_encryption"`
+	Timeouts                 timeouts.Value    `tfsdk:"timeouts"`
 }
 
 func (data *ResourceModel) FlattenDisk(disk *linodego.InstanceDisk, preserveKnown bool) {
@@ -63,12 +68,21 @@ func (data *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
 	data.Filesystem = helper.KeepOrUpdateValue(data.Filesystem, other.Filesystem, preserveKnown)
 	data.Image = helper.KeepOrUpdateValue(data.Image, other.Image, preserveKnown)
 	data.RootPass = helper.KeepOrUpdateValue(data.RootPass, other.RootPass, preserveKnown)
+	data.RootPassWOVersion = helper.KeepOrUpdateValue(
+		data.RootPassWOVersion, other.RootPassWOVersion, preserveKnown,
+	)
 	data.StackScriptData = helper.KeepOrUpdateValue(
 		data.StackScriptData, other.StackScriptData, preserveKnown,
 	)
+	data.StackScriptDataWOVersion = helper.KeepOrUpdateValue(
+		data.StackScriptDataWOVersion, other.StackScriptDataWOVersion, preserveKnown,
+	)
 	data.StackScriptID = helper.KeepOrUpdateValue(
 		data.StackScriptID, other.StackScriptID, preserveKnown,
 	)
+	data.CloneFromDiskID = helper.KeepOrUpdateValue(
+		data.CloneFromDiskID, other.CloneFromDiskID, preserveKnown,
+	)
 	data.Created = helper.KeepOrUpdateValue(data.Created, other.Created, preserveKnown)
 	data.Updated = helper.KeepOrUpdateValue(data.Updated, other.Updated, preserveKnown)
 	data.Status = helper.KeepOrUpdateValue(data.Status, other.Status, preserveKnown)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,6 +22,8 @@ const (
 	DefaultVolumeDeleteTimeout = 10 * time.Minute
 )
 
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
 func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
@@ -49,8 +52,9 @@ func (r *Resource) Create(
 ) {
 	tflog.Debug(ctx, "Create "+r.Config.Name)
 
-	var plan ResourceModel
+	var plan, config ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -81,6 +85,11 @@ func (r *Resource) Create(
 		return
 	}
 
+	if !plan.CloneFromDiskID.IsNull() {
+		r.createFromClone(ctx, plan, resp, linodeID, diskSize, timeoutSeconds)
+		return
+	}
+
 	createOpts := linodego.InstanceDiskCreateOptions{
 		Filesystem:    plan.Filesystem.ValueString(),
 		Image:         plan.Image.ValueString(),
@@ -92,16 +101,22 @@ func (r *Resource) Create(
 	resp.Diagnostics.Append(plan.AuthorizedKeys.ElementsAs(ctx, &createOpts.AuthorizedKeys, false)...)
 	resp.Diagnostics.Append(plan.AuthorizedUsers.ElementsAs(ctx, &createOpts.AuthorizedUsers, false)...)
 	resp.Diagnostics.Append(plan.StackScriptData.ElementsAs(ctx, &createOpts.StackscriptData, false)...)
+	if !config.StackScriptDataWO.IsNull() {
+		resp.Diagnostics.Append(config.StackScriptDataWO.ElementsAs(ctx, &createOpts.StackscriptData, false)...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if plan.RootPass.IsNull() {
+	switch {
+	case !config.RootPassWO.IsNull():
+		createOpts.RootPass = config.RootPassWO.ValueString()
+	case plan.RootPass.IsNull():
 		createOpts.RootPass = helper.FrameworkCreateRandomRootPassword(&resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-	} else {
+	default:
 		createOpts.RootPass = plan.RootPass.ValueString()
 	}
 
@@ -165,6 +180,144 @@ func (r *Resource) Create(
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// createFromClone creates the disk by cloning another disk of the same Linode,
+// then applies the planned label and size to the clone.
+func (r *Resource) createFromClone(
+	ctx context.Context,
+	plan ResourceModel,
+	resp *resource.CreateResponse,
+	linodeID,
+	size,
+	timeoutSeconds int,
+) {
+	client := r.Meta.Client
+
+	sourceDiskID := helper.FrameworkSafeInt64ToInt(plan.CloneFromDiskID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "source_disk_id", sourceDiskID)
+
+	p, err := client.NewEventPoller(ctx, linodeID, linodego.EntityLinode, linodego.ActionDiskDuplicate)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Poll for Events", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CloneInstanceDisk(...)")
+	disk, err := client.CloneInstanceDisk(ctx, linodeID, sourceDiskID, linodego.InstanceDiskCloneOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Clone Disk %d on Linode Instance %d", sourceDiskID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	diskID := disk.ID
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	AddDiskResource(ctx, *disk, resp, plan)
+
+	ctx = tflog.SetField(ctx, "disk_id", diskID)
+
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for the Disk Clone Event on Linode Disk (%d)", diskID),
+			err.Error(),
+		)
+		return
+	}
+
+	disk, err = client.WaitForInstanceDiskStatus(ctx, linodeID, diskID, linodego.DiskReady, timeoutSeconds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Disk (%d) to be Ready", diskID), err.Error(),
+		)
+		return
+	}
+
+	if disk.Label != plan.Label.ValueString() {
+		updateOpts := linodego.InstanceDiskUpdateOptions{
+			Label: plan.Label.ValueString(),
+		}
+
+		tflog.Debug(ctx, "client.UpdateInstanceDisk(...)", map[string]any{
+			"options": updateOpts,
+		})
+		if _, err := client.UpdateInstanceDisk(ctx, linodeID, diskID, updateOpts); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Update Disk %d", diskID), err.Error(),
+			)
+			return
+		}
+	}
+
+	if disk.Size != size {
+		resp.Diagnostics.Append(
+			resizeDiskSync(ctx, client, r.Meta, linodeID, diskID, size, timeoutSeconds)...,
+		)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// get latest status of the disk
+	tflog.Trace(ctx, "client.GetInstanceDisk(...)")
+	disk, err = client.GetInstanceDisk(ctx, linodeID, diskID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Disk %d of Linode Instance %d", diskID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(disk.ID))
+
+	plan.FlattenDisk(disk, true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to validate on creation or destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Size.IsUnknown() || plan.Size.ValueInt64() >= state.Size.ValueInt64() {
+		return
+	}
+
+	// The API does not report the used size of a disk's filesystem,
+	// so shrinking can only be validated when the resize is applied.
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("size"),
+		"Shrinking Linode Instance Disk",
+		fmt.Sprintf(
+			"Disk %s will be shrunk from %d MB to %d MB. The resize will fail if the data "+
+				"on the disk does not fit in the new size. The Linode will be shut down "+
+				"during the resize if it is running.",
+			state.ID.ValueString(), state.Size.ValueInt64(), plan.Size.ValueInt64(),
+		),
+	)
+}
+
 func (r *Resource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
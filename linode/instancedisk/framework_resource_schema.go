@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/int64planmodifiers"
 )
 
 var frameworkResourceSchema = schema.Schema{
@@ -119,6 +120,53 @@ var frameworkResourceSchema = schema.Schema{
 				mapvalidator.AlsoRequires(path.MatchRoot("image")),
 			},
 		},
+		"root_pass_wo": schema.StringAttribute{
+			Description: "A write-only password that sets the root user's password on a " +
+				"newly-created Linode Disk when deploying from an Image. This value is never stored in the state.",
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(
+					helper.RootPassMinimumCharacters,
+					helper.RootPassMaximumCharacters,
+				),
+				stringvalidator.ConflictsWith(path.MatchRoot("root_pass")),
+			},
+		},
+		"root_pass_wo_version": schema.Int64Attribute{
+			Description: "The version of root_pass_wo. Changing this value forces the creation of a new Disk.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifiers.RequiresReplaceIfVersionChanged(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("root_pass_wo")),
+			},
+		},
+		"stackscript_data_wo": schema.MapAttribute{
+			Description: "A write-only object containing responses to any User Defined " +
+				"Fields present in the StackScript being deployed to this Disk. " +
+				"This value is never stored in the state.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Validators: []validator.Map{
+				mapvalidator.ConflictsWith(path.MatchRoot("stackscript_data")),
+				mapvalidator.AlsoRequires(path.MatchRoot("image")),
+			},
+		},
+		"stackscript_data_wo_version": schema.Int64Attribute{
+			Description: "The version of stackscript_data_wo. Changing this value forces the creation of a new Disk.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifiers.RequiresReplaceIfVersionChanged(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("stackscript_data_wo")),
+			},
+		},
 		"stackscript_id": schema.Int64Attribute{
 			Description: "A StackScript ID that will cause the referenced " +
 				"StackScript to be run during deployment of this Linode.",
@@ -130,6 +178,27 @@ var frameworkResourceSchema = schema.Schema{
 				int64validator.AlsoRequires(path.MatchRoot("image")),
 			},
 		},
+		"clone_from_disk_id": schema.Int64Attribute{
+			Description: "The ID of a disk on the same Linode to clone this disk from. " +
+				"The cloned disk is resized to the given size if necessary.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.ConflictsWith(
+					path.MatchRoot("image"),
+					path.MatchRoot("filesystem"),
+					path.MatchRoot("authorized_keys"),
+					path.MatchRoot("authorized_users"),
+					path.MatchRoot("root_pass"),
+					path.MatchRoot("root_pass_wo"),
+					path.MatchRoot("stackscript_id"),
+					path.MatchRoot("stackscript_data"),
+					path.MatchRoot("stackscript_data_wo"),
+				),
+			},
+		},
 		"created": schema.StringAttribute{
 			Description: "When this disk was created.",
 			Computed:    true,
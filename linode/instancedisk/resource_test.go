@@ -212,6 +212,43 @@ func TestAccResourceInstanceDisk_bootedResize(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstanceDisk_clone(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_disk.foobar"
+	sourceName := "linode_instance_disk.source"
+	label := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Clone(t, label, testRegion, 3072, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					checkExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "label", label),
+					resource.TestCheckResourceAttr(resName, "size", "3072"),
+					resource.TestCheckResourceAttr(resName, "status", "ready"),
+					resource.TestCheckResourceAttrPair(resName, "clone_from_disk_id", sourceName, "id"),
+					resource.TestCheckResourceAttrPair(resName, "filesystem", sourceName, "filesystem"),
+				),
+			},
+			// Shrink the cloned disk
+			{
+				Config: tmpl.Clone(t, label, testRegion, 2560, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					checkExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "size", "2560"),
+					resource.TestCheckResourceAttr(resName, "status", "ready"),
+				),
+			},
+		},
+	})
+}
+
 func checkExists(name string, disk *linodego.InstanceDisk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
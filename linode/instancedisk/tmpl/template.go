@@ -48,3 +48,14 @@ func BootedResizeWithImage(t testing.TB, label, region string, size int, image,
 			RootPass: rootPass,
 		})
 }
+
+func Clone(t testing.TB, label, region string, size int, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_disk_clone", TemplateData{
+			Label:    label,
+			Size:     size,
+			Region:   region,
+			Image:    "linode/debian13",
+			RootPass: rootPass,
+		})
+}
@@ -0,0 +1,305 @@
+package instancegroup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+type ResourceModel struct {
+	ID             types.String       `tfsdk:"id"`
+	LabelPrefix    types.String       `tfsdk:"label_prefix"`
+	Size           types.Int64        `tfsdk:"size"`
+	MaxUnavailable types.Int64        `tfsdk:"max_unavailable"`
+	Template       TemplateModel      `tfsdk:"template"`
+	NodeBalancer   *NodeBalancerModel `tfsdk:"nodebalancer"`
+	Instances      types.List         `tfsdk:"instances"`
+	Timeouts       timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type TemplateModel struct {
+	Region           types.String `tfsdk:"region"`
+	Type             types.String `tfsdk:"type"`
+	Image            types.String `tfsdk:"image"`
+	AuthorizedKeys   types.List   `tfsdk:"authorized_keys"`
+	Tags             types.Set    `tfsdk:"tags"`
+	PrivateIP        types.Bool   `tfsdk:"private_ip"`
+	FirewallID       types.Int64  `tfsdk:"firewall_id"`
+	PlacementGroupID types.Int64  `tfsdk:"placement_group_id"`
+	Interface        types.List   `tfsdk:"interface"`
+}
+
+type InterfaceModel struct {
+	Purpose     types.String `tfsdk:"purpose"`
+	Label       types.String `tfsdk:"label"`
+	IPAMAddress types.String `tfsdk:"ipam_address"`
+	SubnetID    types.Int64  `tfsdk:"subnet_id"`
+}
+
+type NodeBalancerModel struct {
+	NodeBalancerID types.Int64  `tfsdk:"nodebalancer_id"`
+	ConfigID       types.Int64  `tfsdk:"config_id"`
+	Port           types.Int64  `tfsdk:"port"`
+	Weight         types.Int64  `tfsdk:"weight"`
+	Mode           types.String `tfsdk:"mode"`
+}
+
+type MemberModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Label              types.String `tfsdk:"label"`
+	Status             types.String `tfsdk:"status"`
+	IPv4               types.String `tfsdk:"ipv4"`
+	PrivateIPAddress   types.String `tfsdk:"private_ip_address"`
+	NodeBalancerNodeID types.Int64  `tfsdk:"nodebalancer_node_id"`
+	Type               types.String `tfsdk:"type"`
+	TemplateRevision   types.String `tfsdk:"template_revision"`
+}
+
+// RequiresMemberReplacement returns whether changing from the other template to this template
+// requires the Linodes of the group to be replaced.
+func (t TemplateModel) RequiresMemberReplacement(other TemplateModel) bool {
+	return !t.Image.Equal(other.Image) ||
+		!t.AuthorizedKeys.Equal(other.AuthorizedKeys) ||
+		!t.PrivateIP.Equal(other.PrivateIP) ||
+		!t.FirewallID.Equal(other.FirewallID) ||
+		!t.PlacementGroupID.Equal(other.PlacementGroupID) ||
+		!t.Interface.Equal(other.Interface)
+}
+
+// Revision returns a digest of the fields of this template which require the Linodes
+// of the group to be replaced when changed. Each member records the revision of the
+// template it was created from, so that a rollout resumed after a failure skips
+// the members which were already replaced.
+func (t TemplateModel) Revision() string {
+	revision := templateRevision{
+		Image:            t.Image.ValueStringPointer(),
+		PrivateIP:        t.PrivateIP.ValueBoolPointer(),
+		FirewallID:       t.FirewallID.ValueInt64Pointer(),
+		PlacementGroupID: t.PlacementGroupID.ValueInt64Pointer(),
+	}
+
+	if !t.AuthorizedKeys.IsNull() {
+		revision.AuthorizedKeys = make([]*string, 0, len(t.AuthorizedKeys.Elements()))
+		for _, key := range t.AuthorizedKeys.Elements() {
+			revision.AuthorizedKeys = append(revision.AuthorizedKeys, key.(types.String).ValueStringPointer())
+		}
+	}
+
+	if !t.Interface.IsNull() {
+		revision.Interfaces = make([]interfaceRevision, 0, len(t.Interface.Elements()))
+		for _, iface := range t.Interface.Elements() {
+			attributes := iface.(types.Object).Attributes()
+			revision.Interfaces = append(revision.Interfaces, interfaceRevision{
+				Purpose:     attributes["purpose"].(types.String).ValueStringPointer(),
+				Label:       attributes["label"].(types.String).ValueStringPointer(),
+				IPAMAddress: attributes["ipam_address"].(types.String).ValueStringPointer(),
+				SubnetID:    attributes["subnet_id"].(types.Int64).ValueInt64Pointer(),
+			})
+		}
+	}
+
+	// The revision is hashed from the plain values rather than the string representation
+	// of the framework values, which isn't guaranteed to be stable. Null values are encoded as null.
+	encoded, err := json.Marshal(revision)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode template revision: %s", err))
+	}
+
+	hash := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(hash[:])[:16]
+}
+
+// templateRevision holds the canonical values of the template fields of a revision.
+type templateRevision struct {
+	Image            *string             `json:"image"`
+	AuthorizedKeys   []*string           `json:"authorized_keys"`
+	PrivateIP        *bool               `json:"private_ip"`
+	FirewallID       *int64              `json:"firewall_id"`
+	PlacementGroupID *int64              `json:"placement_group_id"`
+	Interfaces       []interfaceRevision `json:"interface"`
+}
+
+type interfaceRevision struct {
+	Purpose     *string `json:"purpose"`
+	Label       *string `json:"label"`
+	IPAMAddress *string `json:"ipam_address"`
+	SubnetID    *int64  `json:"subnet_id"`
+}
+
+// RequiresReplacement returns whether the given member of a group must be replaced
+// to match this template. Members created before template revisions were recorded
+// are compared through the old template of the group.
+func (t TemplateModel) RequiresReplacement(member MemberModel, oldTemplate TemplateModel) bool {
+	if member.TemplateRevision.IsNull() || member.TemplateRevision.IsUnknown() {
+		return t.RequiresMemberReplacement(oldTemplate)
+	}
+
+	return member.TemplateRevision.ValueString() != t.Revision()
+}
+
+// RequiresResize returns whether the given member of a group must be resized to match this template.
+func (t TemplateModel) RequiresResize(member MemberModel, oldTemplate TemplateModel) bool {
+	if member.Type.IsNull() || member.Type.IsUnknown() {
+		return !t.Type.Equal(oldTemplate.Type)
+	}
+
+	return !t.Type.Equal(member.Type)
+}
+
+// GetCreateOptions returns the options to create a Linode of this group with the given label.
+func (t TemplateModel) GetCreateOptions(
+	ctx context.Context,
+	label string,
+	diags *diag.Diagnostics,
+) linodego.InstanceCreateOptions {
+	booted := true
+
+	result := linodego.InstanceCreateOptions{
+		Label:      label,
+		Region:     t.Region.ValueString(),
+		Type:       t.Type.ValueString(),
+		Image:      t.Image.ValueString(),
+		PrivateIP:  t.PrivateIP.ValueBool(),
+		FirewallID: helper.FrameworkSafeInt64ToInt(t.FirewallID.ValueInt64(), diags),
+		Booted:     &booted,
+	}
+
+	diags.Append(t.AuthorizedKeys.ElementsAs(ctx, &result.AuthorizedKeys, false)...)
+	diags.Append(t.Tags.ElementsAs(ctx, &result.Tags, false)...)
+
+	if !t.PlacementGroupID.IsNull() {
+		result.PlacementGroup = &linodego.InstanceCreatePlacementGroupOptions{
+			ID: helper.FrameworkSafeInt64ToInt(t.PlacementGroupID.ValueInt64(), diags),
+		}
+	}
+
+	var interfaces []InterfaceModel
+	diags.Append(t.Interface.ElementsAs(ctx, &interfaces, false)...)
+
+	for _, iface := range interfaces {
+		createOpts := linodego.InstanceConfigInterfaceCreateOptions{
+			Purpose:     linodego.ConfigInterfacePurpose(iface.Purpose.ValueString()),
+			Label:       iface.Label.ValueString(),
+			IPAMAddress: iface.IPAMAddress.ValueString(),
+		}
+
+		if !iface.SubnetID.IsNull() {
+			subnetID := helper.FrameworkSafeInt64ToInt(iface.SubnetID.ValueInt64(), diags)
+			createOpts.SubnetID = &subnetID
+		}
+
+		result.Interfaces = append(result.Interfaces, createOpts)
+	}
+
+	return result
+}
+
+// GetMembers returns the members of this group, or nil if they are not known.
+func (m *ResourceModel) GetMembers(ctx context.Context, diags *diag.Diagnostics) []MemberModel {
+	if m.Instances.IsNull() || m.Instances.IsUnknown() {
+		return nil
+	}
+
+	var result []MemberModel
+	diags.Append(m.Instances.ElementsAs(ctx, &result, false)...)
+
+	return result
+}
+
+// SetMembers sets the members of this group, ordered by their index.
+func (m *ResourceModel) SetMembers(ctx context.Context, members []MemberModel, diags *diag.Diagnostics) {
+	prefix := m.LabelPrefix.ValueString()
+
+	slices.SortFunc(members, func(a, b MemberModel) int {
+		aIndex, _ := getMemberIndex(prefix, a.Label.ValueString())
+		bIndex, _ := getMemberIndex(prefix, b.Label.ValueString())
+		return aIndex - bIndex
+	})
+
+	instances, newDiags := types.ListValueFrom(ctx, memberObjectType, members)
+	diags.Append(newDiags...)
+
+	m.Instances = instances
+}
+
+// FlattenInstance updates this member from the given Linode.
+func (m *MemberModel) FlattenInstance(instance *linodego.Instance) {
+	m.ID = types.Int64Value(int64(instance.ID))
+	m.Label = types.StringValue(instance.Label)
+	m.Status = types.StringValue(string(instance.Status))
+	m.Type = types.StringValue(instance.Type)
+	m.IPv4 = types.StringNull()
+	m.PrivateIPAddress = types.StringNull()
+
+	for _, ip := range instance.IPv4 {
+		if ip == nil {
+			continue
+		}
+
+		if ip.IsPrivate() {
+			if m.PrivateIPAddress.IsNull() {
+				m.PrivateIPAddress = types.StringValue(ip.String())
+			}
+			continue
+		}
+
+		if m.IPv4.IsNull() {
+			m.IPv4 = types.StringValue(ip.String())
+		}
+	}
+
+	if m.NodeBalancerNodeID.IsUnknown() {
+		m.NodeBalancerNodeID = types.Int64Null()
+	}
+
+	if m.TemplateRevision.IsUnknown() {
+		m.TemplateRevision = types.StringNull()
+	}
+}
+
+// getMemberLabel returns the label of the group member with the given index.
+func getMemberLabel(prefix string, index int) string {
+	return fmt.Sprintf("%s-%d", prefix, index)
+}
+
+// getMemberIndex returns the index of the group member with the given label.
+func getMemberIndex(prefix, label string) (int, bool) {
+	suffix, found := strings.CutPrefix(label, prefix+"-")
+	if !found {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index < 0 {
+		return 0, false
+	}
+
+	return index, true
+}
+
+// getBatches splits the given members into batches of at most batchSize members.
+func getBatches[T any](members []T, batchSize int) [][]T {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	var result [][]T
+
+	for batch := range slices.Chunk(members, batchSize) {
+		result = append(result, batch)
+	}
+
+	return result
+}
@@ -0,0 +1,260 @@
+//go:build unit
+
+package instancegroup
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMemberIndex(t *testing.T) {
+	index, ok := getMemberIndex("web", getMemberLabel("web", 12))
+	require.True(t, ok)
+	require.Equal(t, 12, index)
+
+	_, ok = getMemberIndex("web", "api-1")
+	require.False(t, ok)
+
+	_, ok = getMemberIndex("web", "web-abc")
+	require.False(t, ok)
+
+	_, ok = getMemberIndex("web", "web--1")
+	require.False(t, ok)
+}
+
+func TestGetBatches(t *testing.T) {
+	members := make([]MemberModel, 5)
+
+	batches := getBatches(members, 2)
+	require.Len(t, batches, 3)
+	require.Len(t, batches[0], 2)
+	require.Len(t, batches[1], 2)
+	require.Len(t, batches[2], 1)
+
+	require.Len(t, getBatches(members, 10), 1)
+	require.Len(t, getBatches(members, 0), 5)
+	require.Empty(t, getBatches[MemberModel](nil, 1))
+}
+
+func TestRequiresMemberReplacement(t *testing.T) {
+	base := TemplateModel{
+		Region:           types.StringValue("us-mia"),
+		Type:             types.StringValue("g6-nanode-1"),
+		Image:            types.StringValue("linode/debian12"),
+		AuthorizedKeys:   types.ListNull(types.StringType),
+		Tags:             types.SetNull(types.StringType),
+		PrivateIP:        types.BoolValue(false),
+		FirewallID:       types.Int64Null(),
+		PlacementGroupID: types.Int64Null(),
+		Interface:        types.ListNull(types.ObjectType{}),
+	}
+
+	resized := base
+	resized.Type = types.StringValue("g6-standard-1")
+	require.False(t, resized.RequiresMemberReplacement(base))
+
+	retagged := base
+	retagged.Tags = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web")})
+	require.False(t, retagged.RequiresMemberReplacement(base))
+
+	reimaged := base
+	reimaged.Image = types.StringValue("linode/debian13")
+	require.True(t, reimaged.RequiresMemberReplacement(base))
+
+	firewalled := base
+	firewalled.FirewallID = types.Int64Value(123)
+	require.True(t, firewalled.RequiresMemberReplacement(base))
+}
+
+func TestRequiresReplacement(t *testing.T) {
+	oldTemplate := TemplateModel{
+		Region:           types.StringValue("us-mia"),
+		Type:             types.StringValue("g6-nanode-1"),
+		Image:            types.StringValue("linode/debian12"),
+		AuthorizedKeys:   types.ListNull(types.StringType),
+		Tags:             types.SetNull(types.StringType),
+		PrivateIP:        types.BoolValue(false),
+		FirewallID:       types.Int64Null(),
+		PlacementGroupID: types.Int64Null(),
+		Interface:        types.ListNull(types.ObjectType{}),
+	}
+
+	newTemplate := oldTemplate
+	newTemplate.Image = types.StringValue("linode/debian13")
+	newTemplate.Type = types.StringValue("g6-standard-1")
+
+	retagged := oldTemplate
+	retagged.Tags = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web")})
+	require.Equal(t, oldTemplate.Revision(), retagged.Revision())
+	require.NotEqual(t, oldTemplate.Revision(), newTemplate.Revision())
+
+	// Revisions are computed from the plain values of the template, so they are stable across
+	// framework versions, and null values are distinguished from empty ones.
+	require.Equal(t, "d25e92c6a68e17e7", oldTemplate.Revision())
+
+	keyless := oldTemplate
+	keyless.AuthorizedKeys = types.ListValueMust(types.StringType, nil)
+	require.NotEqual(t, oldTemplate.Revision(), keyless.Revision())
+
+	// Members already rolled by a failed rollout are skipped when it is resumed
+	rolled := MemberModel{
+		Type:             types.StringValue("g6-standard-1"),
+		TemplateRevision: types.StringValue(newTemplate.Revision()),
+	}
+	require.False(t, newTemplate.RequiresReplacement(rolled, oldTemplate))
+	require.False(t, newTemplate.RequiresResize(rolled, oldTemplate))
+
+	pending := MemberModel{
+		Type:             types.StringValue("g6-nanode-1"),
+		TemplateRevision: types.StringValue(oldTemplate.Revision()),
+	}
+	require.True(t, newTemplate.RequiresReplacement(pending, oldTemplate))
+	require.True(t, newTemplate.RequiresResize(pending, oldTemplate))
+
+	// Members without a recorded revision are compared through the old template
+	legacy := MemberModel{
+		Type:             types.StringNull(),
+		TemplateRevision: types.StringNull(),
+	}
+	require.True(t, newTemplate.RequiresReplacement(legacy, oldTemplate))
+	require.True(t, newTemplate.RequiresResize(legacy, oldTemplate))
+	require.False(t, retagged.RequiresReplacement(legacy, oldTemplate))
+	require.False(t, retagged.RequiresResize(legacy, oldTemplate))
+}
+
+func TestGetCreateOptions(t *testing.T) {
+	interfaceType := frameworkResourceSchema.Attributes["template"].GetType().(types.ObjectType).
+		AttrTypes["interface"].(types.ListType).ElemType
+
+	interfaces, diags := types.ListValueFrom(context.Background(), interfaceType, []InterfaceModel{
+		{
+			Purpose:     types.StringValue("public"),
+			Label:       types.StringNull(),
+			IPAMAddress: types.StringNull(),
+			SubnetID:    types.Int64Null(),
+		},
+		{
+			Purpose:     types.StringValue("vpc"),
+			Label:       types.StringNull(),
+			IPAMAddress: types.StringNull(),
+			SubnetID:    types.Int64Value(456),
+		},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	template := TemplateModel{
+		Region:           types.StringValue("us-mia"),
+		Type:             types.StringValue("g6-nanode-1"),
+		Image:            types.StringValue("linode/debian12"),
+		AuthorizedKeys:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ssh-ed25519 AAAA")}),
+		Tags:             types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web")}),
+		PrivateIP:        types.BoolValue(true),
+		FirewallID:       types.Int64Value(123),
+		PlacementGroupID: types.Int64Value(789),
+		Interface:        interfaces,
+	}
+
+	opts := template.GetCreateOptions(context.Background(), "web-0", &diags)
+	require.False(t, diags.HasError(), diags)
+
+	require.Equal(t, "web-0", opts.Label)
+	require.Equal(t, "us-mia", opts.Region)
+	require.Equal(t, "g6-nanode-1", opts.Type)
+	require.Equal(t, "linode/debian12", opts.Image)
+	require.Equal(t, []string{"ssh-ed25519 AAAA"}, opts.AuthorizedKeys)
+	require.Equal(t, []string{"web"}, opts.Tags)
+	require.True(t, opts.PrivateIP)
+	require.Equal(t, 123, opts.FirewallID)
+	require.Equal(t, 789, opts.PlacementGroup.ID)
+	require.True(t, *opts.Booted)
+
+	require.Len(t, opts.Interfaces, 2)
+	require.Equal(t, linodego.InterfacePurposePublic, opts.Interfaces[0].Purpose)
+	require.Nil(t, opts.Interfaces[0].SubnetID)
+	require.Equal(t, linodego.InterfacePurposeVPC, opts.Interfaces[1].Purpose)
+	require.Equal(t, 456, *opts.Interfaces[1].SubnetID)
+}
+
+func TestFlattenInstance(t *testing.T) {
+	publicIP := net.ParseIP("172.105.1.2")
+	privateIP := net.ParseIP("192.168.130.4")
+
+	var member MemberModel
+	member.NodeBalancerNodeID = types.Int64Unknown()
+
+	member.FlattenInstance(&linodego.Instance{
+		ID:     123,
+		Label:  "web-0",
+		Status: linodego.InstanceRunning,
+		IPv4:   []*net.IP{&privateIP, &publicIP},
+	})
+
+	require.Equal(t, int64(123), member.ID.ValueInt64())
+	require.Equal(t, "web-0", member.Label.ValueString())
+	require.Equal(t, "running", member.Status.ValueString())
+	require.Equal(t, "172.105.1.2", member.IPv4.ValueString())
+	require.Equal(t, "192.168.130.4", member.PrivateIPAddress.ValueString())
+	require.True(t, member.NodeBalancerNodeID.IsNull())
+}
+
+func TestSetMembers(t *testing.T) {
+	model := ResourceModel{LabelPrefix: types.StringValue("web")}
+
+	newMember := func(label string) MemberModel {
+		return MemberModel{
+			ID:                 types.Int64Value(1),
+			Label:              types.StringValue(label),
+			Status:             types.StringValue("running"),
+			IPv4:               types.StringNull(),
+			PrivateIPAddress:   types.StringNull(),
+			NodeBalancerNodeID: types.Int64Null(),
+		}
+	}
+
+	var diags diag.Diagnostics
+	model.SetMembers(
+		context.Background(),
+		[]MemberModel{newMember("web-10"), newMember("web-2"), newMember("web-0")},
+		&diags,
+	)
+	require.False(t, diags.HasError(), diags)
+
+	members := model.GetMembers(context.Background(), &diags)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, members, 3)
+	require.Equal(t, "web-0", members[0].Label.ValueString())
+	require.Equal(t, "web-2", members[1].Label.ValueString())
+	require.Equal(t, "web-10", members[2].Label.ValueString())
+}
+
+func TestNodeBalancersEqual(t *testing.T) {
+	nb := NodeBalancerModel{
+		NodeBalancerID: types.Int64Value(1),
+		ConfigID:       types.Int64Value(2),
+		Port:           types.Int64Value(80),
+		Weight:         types.Int64Value(50),
+		Mode:           types.StringValue("accept"),
+	}
+
+	other := nb
+	other.Port = types.Int64Value(8080)
+
+	require.True(t, nodeBalancersEqual(nil, nil))
+	require.False(t, nodeBalancersEqual(&nb, nil))
+	require.False(t, nodeBalancersEqual(nil, &nb))
+	require.True(t, nodeBalancersEqual(&nb, &NodeBalancerModel{
+		NodeBalancerID: types.Int64Value(1),
+		ConfigID:       types.Int64Value(2),
+		Port:           types.Int64Value(80),
+		Weight:         types.Int64Value(50),
+		Mode:           types.StringValue("accept"),
+	}))
+	require.False(t, nodeBalancersEqual(&nb, &other))
+}
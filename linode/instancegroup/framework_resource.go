@@ -0,0 +1,286 @@
+package instancegroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+const (
+	DefaultCreateTimeout = 30 * time.Minute
+	DefaultUpdateTimeout = 2 * time.Hour
+	DefaultDeleteTimeout = 15 * time.Minute
+
+	// maxNodeLabelPrefixLength leaves room for the index suffix
+	// within the 32 character limit of NodeBalancer node labels.
+	maxNodeLabelPrefixLength = 24
+)
+
+var (
+	_ resource.ResourceWithModifyPlan     = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_group",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.NodeBalancer == nil {
+		return
+	}
+
+	if !config.Template.PrivateIP.IsUnknown() && !config.Template.PrivateIP.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template").AtName("private_ip"),
+			"Private IP Required",
+			"Linodes must have a private IPv4 address to be registered with a NodeBalancer.",
+		)
+	}
+
+	if !config.LabelPrefix.IsUnknown() && len(config.LabelPrefix.ValueString()) > maxNodeLabelPrefixLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("label_prefix"),
+			"Label Prefix Too Long",
+			"The label prefix must be at most 24 characters long when registering Linodes with a NodeBalancer.",
+		)
+	}
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to reconcile on creation or destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := state.GetMembers(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Members which were removed outside of Terraform or which are not registered
+	// with the NodeBalancer config need to be reconciled even if the config is unchanged.
+	drifted := plan.Size.IsUnknown() || int64(len(members)) != plan.Size.ValueInt64()
+	for _, member := range members {
+		if plan.NodeBalancer != nil && member.NodeBalancerNodeID.IsNull() {
+			drifted = true
+		}
+	}
+
+	if drifted {
+		resp.Diagnostics.Append(
+			resp.Plan.SetAttribute(ctx, path.Root("instances"), types.ListUnknown(memberObjectType))...,
+		)
+	}
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ctx = tflog.SetField(ctx, "label_prefix", plan.LabelPrefix.ValueString())
+
+	size := helper.FrameworkSafeInt64ToInt(plan.Size.ValueInt64(), &resp.Diagnostics)
+	maxUnavailable := helper.FrameworkSafeInt64ToInt(plan.MaxUnavailable.ValueInt64(), &resp.Diagnostics)
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.LabelPrefix
+
+	operator := newGroupOperator(r.Meta.Client, plan.LabelPrefix.ValueString(), timeoutSeconds, nil)
+
+	resp.Diagnostics.Append(
+		operator.Apply(ctx, size, maxUnavailable, nil, plan.Template, nil, plan.NodeBalancer)...,
+	)
+
+	// Members are always saved to the state to prevent
+	// dangling resources (resources created but not managed by TF)
+	plan.SetMembers(ctx, operator.Members(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "label_prefix", state.LabelPrefix.ValueString())
+
+	members := state.GetMembers(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operator := newGroupOperator(r.Meta.Client, state.LabelPrefix.ValueString(), 0, members)
+
+	resp.Diagnostics.Append(operator.Refresh(ctx, state.NodeBalancer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.SetMembers(ctx, operator.Members(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	ctx = tflog.SetField(ctx, "label_prefix", state.LabelPrefix.ValueString())
+
+	size := helper.FrameworkSafeInt64ToInt(plan.Size.ValueInt64(), &resp.Diagnostics)
+	maxUnavailable := helper.FrameworkSafeInt64ToInt(plan.MaxUnavailable.ValueInt64(), &resp.Diagnostics)
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(updateTimeout.Seconds(), &resp.Diagnostics)
+	members := state.GetMembers(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operator := newGroupOperator(r.Meta.Client, state.LabelPrefix.ValueString(), timeoutSeconds, members)
+
+	resp.Diagnostics.Append(
+		operator.Apply(
+			ctx, size, maxUnavailable, &state.Template, plan.Template, state.NodeBalancer, plan.NodeBalancer,
+		)...,
+	)
+
+	// Partial progress is saved to the state so that the
+	// remaining changes are planned again on the next apply.
+	if resp.Diagnostics.HasError() {
+		state.SetMembers(ctx, operator.Members(), &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.SetMembers(ctx, operator.Members(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	ctx = tflog.SetField(ctx, "label_prefix", state.LabelPrefix.ValueString())
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(deleteTimeout.Seconds(), &resp.Diagnostics)
+	members := state.GetMembers(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operator := newGroupOperator(r.Meta.Client, state.LabelPrefix.ValueString(), timeoutSeconds, members)
+
+	resp.Diagnostics.Append(operator.Destroy(ctx, state.NodeBalancer)...)
+}
@@ -0,0 +1,237 @@
+package instancegroup
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+)
+
+var memberObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                   types.Int64Type,
+		"label":                types.StringType,
+		"status":               types.StringType,
+		"ipv4":                 types.StringType,
+		"private_ip_address":   types.StringType,
+		"nodebalancer_node_id": types.Int64Type,
+		"type":                 types.StringType,
+		"template_revision":    types.StringType,
+	},
+}
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the instance group, which is its label prefix.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"label_prefix": schema.StringAttribute{
+			Description: "The prefix of the labels of the Linodes in this group. " +
+				"Each Linode is labeled with this prefix followed by its index, e.g. `web-0`.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 56),
+			},
+		},
+		"size": schema.Int64Attribute{
+			Description: "The number of Linodes in this group.",
+			Required:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"max_unavailable": schema.Int64Attribute{
+			Description: "The maximum number of Linodes that can be unavailable at the same time " +
+				"while the group is being updated.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(1),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"template": schema.SingleNestedAttribute{
+			Description: "The template of the Linodes in this group.",
+			Required:    true,
+			Attributes: map[string]schema.Attribute{
+				"region": schema.StringAttribute{
+					Description: "The region of the Linodes. Changing this forces the creation of a new group.",
+					Required:    true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"type": schema.StringAttribute{
+					Description: "The type of the Linodes. Changing this resizes the Linodes in a rolling manner.",
+					Required:    true,
+				},
+				"image": schema.StringAttribute{
+					Description: "The image to deploy the Linodes from. " +
+						"Changing this replaces the Linodes in a rolling manner.",
+					Required: true,
+				},
+				"authorized_keys": schema.ListAttribute{
+					Description: "A list of SSH public keys to deploy for the root user of the Linodes. " +
+						"Changing this replaces the Linodes in a rolling manner.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"tags": schema.SetAttribute{
+					Description: "A set of tags applied to the Linodes.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"private_ip": schema.BoolAttribute{
+					Description: "If true, the Linodes will have private networking enabled. " +
+						"Changing this replaces the Linodes in a rolling manner.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+				"firewall_id": schema.Int64Attribute{
+					Description: "The ID of the Firewall to attach the Linodes to. " +
+						"Changing this replaces the Linodes in a rolling manner.",
+					Optional: true,
+				},
+				"placement_group_id": schema.Int64Attribute{
+					Description: "The ID of the Placement Group to assign the Linodes to. " +
+						"Changing this replaces the Linodes in a rolling manner.",
+					Optional: true,
+				},
+				"interface": schema.ListNestedAttribute{
+					Description: "The Network Interfaces of the Linodes' configuration profile. " +
+						"Changing this replaces the Linodes in a rolling manner.",
+					Optional: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"purpose": schema.StringAttribute{
+								Description: "The type of interface.",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(
+										string(linodego.InterfacePurposePublic),
+										string(linodego.InterfacePurposeVLAN),
+										string(linodego.InterfacePurposeVPC),
+									),
+								},
+							},
+							"label": schema.StringAttribute{
+								Description: "The name of the VLAN to join. Only valid for `vlan` interfaces.",
+								Optional:    true,
+							},
+							"ipam_address": schema.StringAttribute{
+								Description: "This Network Interface's private IP address in CIDR notation. " +
+									"Only valid for `vlan` interfaces.",
+								Optional: true,
+							},
+							"subnet_id": schema.Int64Attribute{
+								Description: "The ID of the VPC subnet to join. Only valid for `vpc` interfaces.",
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"nodebalancer": schema.SingleNestedAttribute{
+			Description: "A NodeBalancer config to register the Linodes in this group with as nodes. " +
+				"Requires `template.private_ip` to be true.",
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"nodebalancer_id": schema.Int64Attribute{
+					Description: "The ID of the NodeBalancer.",
+					Required:    true,
+				},
+				"config_id": schema.Int64Attribute{
+					Description: "The ID of the NodeBalancer config.",
+					Required:    true,
+				},
+				"port": schema.Int64Attribute{
+					Description: "The port the Linodes receive traffic on.",
+					Required:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"weight": schema.Int64Attribute{
+					Description: "The weight of each node (1-255).",
+					Optional:    true,
+					Computed:    true,
+					Default:     int64default.StaticInt64(50),
+					Validators: []validator.Int64{
+						int64validator.Between(1, 255),
+					},
+				},
+				"mode": schema.StringAttribute{
+					Description: "The mode of each node.",
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(string(linodego.ModeAccept)),
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							string(linodego.ModeAccept),
+							string(linodego.ModeReject),
+							string(linodego.ModeDrain),
+							string(linodego.ModeBackup),
+						),
+					},
+				},
+			},
+		},
+		"instances": schema.ListNestedAttribute{
+			Description: "The Linodes in this group.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Description: "The ID of the Linode.",
+						Computed:    true,
+					},
+					"label": schema.StringAttribute{
+						Description: "The label of the Linode.",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "The status of the Linode.",
+						Computed:    true,
+					},
+					"ipv4": schema.StringAttribute{
+						Description: "The public IPv4 address of the Linode.",
+						Computed:    true,
+					},
+					"private_ip_address": schema.StringAttribute{
+						Description: "The private IPv4 address of the Linode.",
+						Computed:    true,
+					},
+					"nodebalancer_node_id": schema.Int64Attribute{
+						Description: "The ID of the NodeBalancer node of the Linode.",
+						Computed:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "The type of the Linode.",
+						Computed:    true,
+					},
+					"template_revision": schema.StringAttribute{
+						Description: "The revision of the template the Linode was created from.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,101 @@
+//go:build unit
+
+package instancegroup_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceInstanceGroup_fakeAPINodeBalancerSwitch(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	nb := tf.Apply("linode_nodebalancer", nil, map[string]any{
+		"label":  "fake-group-nb",
+		"region": "us-east",
+	})
+	nbID := parseID(t, nb.Attr("id").(string))
+
+	configIDs := make([]int, 2)
+	for i, port := range []int{80, 8080} {
+		config := tf.Apply("linode_nodebalancer_config", nil, map[string]any{
+			"nodebalancer_id": nbID,
+			"port":            port,
+		})
+		configIDs[i] = parseID(t, config.Attr("id").(string))
+	}
+
+	groupConfig := func(image string, configID int) map[string]any {
+		return map[string]any{
+			"label_prefix":    "fake-group",
+			"size":            3,
+			"max_unavailable": 1,
+			"template": map[string]any{
+				"region":     "us-east",
+				"type":       "g6-nanode-1",
+				"image":      image,
+				"private_ip": true,
+			},
+			"nodebalancer": map[string]any{
+				"nodebalancer_id": nbID,
+				"config_id":       configID,
+				"port":            80,
+			},
+		}
+	}
+
+	nodesPath := func(configID int) string {
+		return fmt.Sprintf("nodebalancers/%d/configs/%d/nodes", nbID, configID)
+	}
+
+	group := tf.Apply("linode_instance_group", nil, groupConfig("linode/debian12", configIDs[0]))
+	require.Len(t, server.List(nodesPath(configIDs[0])), 3)
+
+	// All members are moved to the new NodeBalancer config before any of them is replaced,
+	// so every member ends up registered with the new config only.
+	group = tf.Apply("linode_instance_group", group, groupConfig("linode/debian13", configIDs[1]))
+	require.Empty(t, server.List(nodesPath(configIDs[0])))
+
+	nodes := server.List(nodesPath(configIDs[1]))
+	require.Len(t, nodes, 3)
+
+	registered := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		registered[node["label"].(string)] = true
+	}
+
+	for i := range 3 {
+		label := fmt.Sprintf("fake-group-%d", i)
+		require.Equal(t, label, group.Attr(fmt.Sprintf("instances.%d.label", i)))
+		require.True(t, registered[label], "%s is not registered with the new config", label)
+	}
+
+	// Changing the weight of the same config updates the existing nodes in place
+	weighted := groupConfig("linode/debian13", configIDs[1])
+	weighted["nodebalancer"].(map[string]any)["weight"] = 100
+
+	group = tf.Apply("linode_instance_group", group, weighted)
+
+	updated := server.List(nodesPath(configIDs[1]))
+	require.Len(t, updated, 3)
+	for i, node := range updated {
+		require.Equal(t, nodes[i]["id"], node["id"])
+		require.Equal(t, float64(100), node["weight"])
+	}
+
+	tf.Destroy(group)
+}
+
+func parseID(t *testing.T, id string) int {
+	t.Helper()
+
+	result, err := strconv.Atoi(id)
+	require.NoError(t, err)
+
+	return result
+}
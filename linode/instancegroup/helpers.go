@@ -0,0 +1,664 @@
+package instancegroup
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+// groupOperator applies changes to the members of an instance group.
+// Members are tracked by their index so that partial progress
+// can be saved to the state if an operation fails.
+type groupOperator struct {
+	client         *linodego.Client
+	prefix         string
+	timeoutSeconds int
+	members        map[int]MemberModel
+}
+
+func newGroupOperator(
+	client *linodego.Client,
+	prefix string,
+	timeoutSeconds int,
+	members []MemberModel,
+) *groupOperator {
+	result := &groupOperator{
+		client:         client,
+		prefix:         prefix,
+		timeoutSeconds: timeoutSeconds,
+		members:        make(map[int]MemberModel, len(members)),
+	}
+
+	for i, member := range members {
+		index, ok := getMemberIndex(prefix, member.Label.ValueString())
+		if !ok {
+			// Members should always be labeled by index, but we shouldn't lose track of them if not
+			index = -(i + 1)
+		}
+
+		result.members[index] = member
+	}
+
+	return result
+}
+
+// Members returns the current members of the group.
+func (o *groupOperator) Members() []MemberModel {
+	result := make([]MemberModel, 0, len(o.members))
+	for _, member := range o.members {
+		result = append(result, member)
+	}
+	return result
+}
+
+// Apply converges the members of the group from the old template and NodeBalancer config
+// to the new ones. The old template is nil if the group is being created.
+func (o *groupOperator) Apply(
+	ctx context.Context,
+	size int,
+	maxUnavailable int,
+	oldTemplate *TemplateModel,
+	newTemplate TemplateModel,
+	oldNodeBalancer *NodeBalancerModel,
+	newNodeBalancer *NodeBalancerModel,
+) (diags diag.Diagnostics) {
+	// Scale down the group
+	for index, member := range o.members {
+		if index >= 0 && index < size {
+			continue
+		}
+
+		diags.Append(o.deregisterMember(ctx, oldNodeBalancer, member)...)
+		diags.Append(o.deleteMember(ctx, member, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		delete(o.members, index)
+	}
+
+	// Move the remaining members to the new NodeBalancer config before rolling any of them,
+	// so that the whole group is served by the same NodeBalancer config during the rollout.
+	if !nodeBalancersEqual(oldNodeBalancer, newNodeBalancer) {
+		diags.Append(o.switchNodeBalancer(ctx, oldNodeBalancer, newNodeBalancer)...)
+		if diags.HasError() {
+			return diags
+		}
+		oldNodeBalancer = newNodeBalancer
+	}
+
+	if oldTemplate != nil {
+		// Members are compared individually rather than through the old template,
+		// since a previous rollout may have failed after rolling some of them.
+		var toReplace, toResize []int
+		var kept []MemberModel
+
+		// Members created before template revisions were recorded match the old template
+		if !newTemplate.RequiresMemberReplacement(*oldTemplate) {
+			for index, member := range o.members {
+				if member.TemplateRevision.IsNull() {
+					member.TemplateRevision = types.StringValue(newTemplate.Revision())
+					o.members[index] = member
+				}
+			}
+		}
+
+		for _, index := range slices.Sorted(maps.Keys(o.members)) {
+			member := o.members[index]
+
+			switch {
+			case newTemplate.RequiresReplacement(member, *oldTemplate):
+				toReplace = append(toReplace, index)
+			case newTemplate.RequiresResize(member, *oldTemplate):
+				toResize = append(toResize, index)
+				kept = append(kept, member)
+			default:
+				kept = append(kept, member)
+			}
+		}
+
+		for _, roll := range []struct {
+			indexes []int
+			replace bool
+		}{
+			{toReplace, true},
+			{toResize, false},
+		} {
+			for _, batch := range getBatches(roll.indexes, maxUnavailable) {
+				diags.Append(o.rollBatch(ctx, batch, roll.replace, newTemplate, oldNodeBalancer, newNodeBalancer)...)
+				if diags.HasError() {
+					return diags
+				}
+			}
+		}
+
+		if !newTemplate.Tags.Equal(oldTemplate.Tags) {
+			diags.Append(o.updateTags(ctx, kept, newTemplate)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	// Scale up the group, which also recreates members that no longer exist
+	var missing []int
+	for index := range size {
+		if _, ok := o.members[index]; !ok {
+			missing = append(missing, index)
+		}
+	}
+
+	diags.Append(o.createMembers(ctx, missing, newTemplate)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Register all members that are not registered with the NodeBalancer config yet
+	for index, member := range o.members {
+		if member.NodeBalancerNodeID.IsNull() {
+			diags.Append(o.registerMember(ctx, index, newNodeBalancer)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// Refresh updates all members of the group from their current state,
+// dropping members which no longer exist.
+func (o *groupOperator) Refresh(ctx context.Context, nodeBalancer *NodeBalancerModel) (diags diag.Diagnostics) {
+	for index, member := range o.members {
+		linodeID := helper.FrameworkSafeInt64ToInt(member.ID.ValueInt64(), &diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		instance, err := o.client.GetInstance(ctx, linodeID)
+		if err != nil {
+			if linodego.IsNotFound(err) {
+				tflog.Warn(ctx, "Linode of instance group no longer exists", map[string]any{
+					"linode_id": linodeID,
+				})
+				delete(o.members, index)
+				continue
+			}
+
+			diags.AddError(fmt.Sprintf("Failed to Get Linode %d", linodeID), err.Error())
+			return diags
+		}
+
+		member.FlattenInstance(instance)
+
+		if nodeBalancer != nil && !member.NodeBalancerNodeID.IsNull() {
+			nbID, configID := nodeBalancer.getIDs(&diags)
+			nodeID := helper.FrameworkSafeInt64ToInt(member.NodeBalancerNodeID.ValueInt64(), &diags)
+			if diags.HasError() {
+				return diags
+			}
+
+			if _, err := o.client.GetNodeBalancerNode(ctx, nbID, configID, nodeID); err != nil {
+				if !linodego.IsNotFound(err) {
+					diags.AddError(fmt.Sprintf("Failed to Get NodeBalancer Node %d", nodeID), err.Error())
+					return diags
+				}
+
+				member.NodeBalancerNodeID = types.Int64Null()
+			}
+		}
+
+		o.members[index] = member
+	}
+
+	return diags
+}
+
+// Destroy deletes all members of the group.
+func (o *groupOperator) Destroy(ctx context.Context, nodeBalancer *NodeBalancerModel) (diags diag.Diagnostics) {
+	for _, member := range o.members {
+		diags.Append(o.deregisterMember(ctx, nodeBalancer, member)...)
+		diags.Append(o.deleteMember(ctx, member, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// rollBatch replaces or resizes the members with the given indexes, waiting for them to be running
+// and re-registering them with the NodeBalancer config before returning.
+// Members are identified by their index rather than their label, which may have been changed.
+func (o *groupOperator) rollBatch(
+	ctx context.Context,
+	indexes []int,
+	replace bool,
+	template TemplateModel,
+	oldNodeBalancer *NodeBalancerModel,
+	newNodeBalancer *NodeBalancerModel,
+) (diags diag.Diagnostics) {
+	batch := make([]MemberModel, len(indexes))
+
+	for i, index := range indexes {
+		member := o.members[index]
+		batch[i] = member
+
+		diags.Append(o.deregisterMember(ctx, oldNodeBalancer, member)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		member.NodeBalancerNodeID = types.Int64Null()
+		o.members[index] = member
+	}
+
+	tflog.Info(ctx, "Rolling instance group batch", map[string]any{
+		"indexes": indexes,
+		"replace": replace,
+	})
+
+	if replace {
+		for i, member := range batch {
+			diags.Append(o.deleteMember(ctx, member, true)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			delete(o.members, indexes[i])
+		}
+
+		diags.Append(o.createMembers(ctx, indexes, template)...)
+	} else {
+		diags.Append(o.resizeMembers(ctx, indexes, template.Type.ValueString())...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, index := range indexes {
+		diags.Append(o.registerMember(ctx, index, newNodeBalancer)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// createMembers creates the members with the given indexes and waits for them to be running.
+func (o *groupOperator) createMembers(
+	ctx context.Context,
+	indexes []int,
+	template TemplateModel,
+) (diags diag.Diagnostics) {
+	for _, index := range indexes {
+		label := getMemberLabel(o.prefix, index)
+
+		createOpts := template.GetCreateOptions(ctx, label, &diags)
+		createOpts.RootPass = helper.FrameworkCreateRandomRootPassword(&diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		tflog.Debug(ctx, "client.CreateInstance(...)", map[string]any{
+			"label": label,
+		})
+
+		instance, err := o.client.CreateInstance(ctx, createOpts)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Create Linode %s", label), err.Error())
+			return diags
+		}
+
+		member := MemberModel{
+			NodeBalancerNodeID: types.Int64Null(),
+			TemplateRevision:   types.StringValue(template.Revision()),
+		}
+		member.FlattenInstance(instance)
+		o.members[index] = member
+	}
+
+	// The members boot concurrently, so waiting for them in order doesn't slow down the batch
+	return o.waitForMembersRunning(ctx, indexes)
+}
+
+// resizeMembers resizes the members with the given indexes and waits for them to be running.
+func (o *groupOperator) resizeMembers(
+	ctx context.Context,
+	indexes []int,
+	targetType string,
+) (diags diag.Diagnostics) {
+	pollers := make([]*linodego.EventPoller, len(indexes))
+
+	for i, index := range indexes {
+		linodeID := helper.FrameworkSafeInt64ToInt(o.members[index].ID.ValueInt64(), &diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		p, err := o.client.NewEventPoller(ctx, linodeID, linodego.EntityLinode, linodego.ActionLinodeResize)
+		if err != nil {
+			diags.AddError("Failed to Initialize Event Poller", err.Error())
+			return diags
+		}
+
+		tflog.Debug(ctx, "client.ResizeInstance(...)", map[string]any{
+			"linode_id":   linodeID,
+			"target_type": targetType,
+		})
+
+		if err := o.client.ResizeInstance(ctx, linodeID, linodego.InstanceResizeOptions{
+			Type: targetType,
+		}); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Resize Linode %d", linodeID), err.Error())
+			return diags
+		}
+
+		pollers[i] = p
+	}
+
+	for _, p := range pollers {
+		if _, err := p.WaitForFinished(ctx, o.timeoutSeconds); err != nil {
+			diags.AddError("Failed to Wait for Linode Resize to Finish", err.Error())
+			return diags
+		}
+	}
+
+	return o.waitForMembersRunning(ctx, indexes)
+}
+
+// updateTags updates the tags of the given members in place.
+func (o *groupOperator) updateTags(
+	ctx context.Context,
+	members []MemberModel,
+	template TemplateModel,
+) (diags diag.Diagnostics) {
+	tags := []string{}
+	diags.Append(template.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, member := range members {
+		linodeID := helper.FrameworkSafeInt64ToInt(member.ID.ValueInt64(), &diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+			"linode_id": linodeID,
+			"tags":      tags,
+		})
+
+		if _, err := o.client.UpdateInstance(ctx, linodeID, linodego.InstanceUpdateOptions{
+			Tags: &tags,
+		}); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Update Tags of Linode %d", linodeID), err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (o *groupOperator) waitForMembersRunning(ctx context.Context, indexes []int) (diags diag.Diagnostics) {
+	for _, index := range indexes {
+		member := o.members[index]
+
+		linodeID := helper.FrameworkSafeInt64ToInt(member.ID.ValueInt64(), &diags)
+		if diags.HasError() {
+			return diags
+		}
+
+		instance, err := o.client.WaitForInstanceStatus(
+			ctx, linodeID, linodego.InstanceRunning, o.timeoutSeconds,
+		)
+		if err != nil {
+			diags.AddError(
+				fmt.Sprintf("Timed Out Waiting for Linode %d to be Running", linodeID),
+				err.Error(),
+			)
+			return diags
+		}
+
+		member.FlattenInstance(instance)
+		o.members[index] = member
+	}
+
+	return diags
+}
+
+// deleteMember deletes the Linode of the given member, optionally waiting for the deletion
+// to finish so that its label can be reused.
+func (o *groupOperator) deleteMember(ctx context.Context, member MemberModel, wait bool) (diags diag.Diagnostics) {
+	linodeID := helper.FrameworkSafeInt64ToInt(member.ID.ValueInt64(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	var p *linodego.EventPoller
+	if wait {
+		var err error
+		p, err = o.client.NewEventPoller(ctx, linodeID, linodego.EntityLinode, linodego.ActionLinodeDelete)
+		if err != nil {
+			diags.AddError("Failed to Initialize Event Poller", err.Error())
+			return diags
+		}
+	}
+
+	tflog.Debug(ctx, "client.DeleteInstance(...)", map[string]any{
+		"linode_id": linodeID,
+	})
+
+	if err := o.client.DeleteInstance(ctx, linodeID); err != nil {
+		if linodego.IsNotFound(err) {
+			return diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to Delete Linode %d", linodeID), err.Error())
+		return diags
+	}
+
+	if p != nil {
+		if _, err := p.WaitForFinished(ctx, o.timeoutSeconds); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to Wait for Linode %d to be Deleted", linodeID), err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// switchNodeBalancer moves all members from the old NodeBalancer config to the new one.
+// Members are registered with the new config before being removed from the old one,
+// and nodes of the same config are updated in place.
+func (o *groupOperator) switchNodeBalancer(
+	ctx context.Context,
+	oldNodeBalancer *NodeBalancerModel,
+	newNodeBalancer *NodeBalancerModel,
+) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "Moving instance group to new NodeBalancer config")
+
+	for _, index := range slices.Sorted(maps.Keys(o.members)) {
+		member := o.members[index]
+
+		if sameNodeBalancerConfig(oldNodeBalancer, newNodeBalancer) && !member.NodeBalancerNodeID.IsNull() {
+			diags.Append(o.updateMemberNode(ctx, member, newNodeBalancer)...)
+			if diags.HasError() {
+				return diags
+			}
+			continue
+		}
+
+		unregistered := member
+		unregistered.NodeBalancerNodeID = types.Int64Null()
+		o.members[index] = unregistered
+
+		diags.Append(o.registerMember(ctx, index, newNodeBalancer)...)
+		diags.Append(o.deregisterMember(ctx, oldNodeBalancer, member)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// updateMemberNode updates the existing NodeBalancer node of the given member
+// to match the NodeBalancer config.
+func (o *groupOperator) updateMemberNode(
+	ctx context.Context,
+	member MemberModel,
+	nodeBalancer *NodeBalancerModel,
+) (diags diag.Diagnostics) {
+	nbID, configID := nodeBalancer.getIDs(&diags)
+	nodeID := helper.FrameworkSafeInt64ToInt(member.NodeBalancerNodeID.ValueInt64(), &diags)
+	weight := helper.FrameworkSafeInt64ToInt(nodeBalancer.Weight.ValueInt64(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	updateOpts := linodego.NodeBalancerNodeUpdateOptions{
+		Address: fmt.Sprintf("%s:%d", member.PrivateIPAddress.ValueString(), nodeBalancer.Port.ValueInt64()),
+		Weight:  weight,
+		Mode:    linodego.NodeMode(nodeBalancer.Mode.ValueString()),
+	}
+
+	tflog.Debug(ctx, "client.UpdateNodeBalancerNode(...)", map[string]any{
+		"nodebalancer_id": nbID,
+		"config_id":       configID,
+		"node_id":         nodeID,
+		"options":         updateOpts,
+	})
+
+	if _, err := o.client.UpdateNodeBalancerNode(ctx, nbID, configID, nodeID, updateOpts); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Update NodeBalancer Node of %s", member.Label.ValueString()),
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// registerMember registers the member with the given index as a node of the NodeBalancer config.
+func (o *groupOperator) registerMember(
+	ctx context.Context,
+	index int,
+	nodeBalancer *NodeBalancerModel,
+) (diags diag.Diagnostics) {
+	member := o.members[index]
+
+	if nodeBalancer == nil || !member.NodeBalancerNodeID.IsNull() {
+		return diags
+	}
+
+	if member.PrivateIPAddress.IsNull() {
+		diags.AddError(
+			fmt.Sprintf("Failed to Register %s with NodeBalancer", member.Label.ValueString()),
+			"The Linode does not have a private IPv4 address.",
+		)
+		return diags
+	}
+
+	nbID, configID := nodeBalancer.getIDs(&diags)
+	weight := helper.FrameworkSafeInt64ToInt(nodeBalancer.Weight.ValueInt64(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	createOpts := linodego.NodeBalancerNodeCreateOptions{
+		Address: fmt.Sprintf("%s:%d", member.PrivateIPAddress.ValueString(), nodeBalancer.Port.ValueInt64()),
+		Label:   member.Label.ValueString(),
+		Weight:  weight,
+		Mode:    linodego.NodeMode(nodeBalancer.Mode.ValueString()),
+	}
+
+	tflog.Debug(ctx, "client.CreateNodeBalancerNode(...)", map[string]any{
+		"nodebalancer_id": nbID,
+		"config_id":       configID,
+		"options":         createOpts,
+	})
+
+	node, err := o.client.CreateNodeBalancerNode(ctx, nbID, configID, createOpts)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Register %s with NodeBalancer %d", member.Label.ValueString(), nbID),
+			err.Error(),
+		)
+		return diags
+	}
+
+	member.NodeBalancerNodeID = types.Int64Value(int64(node.ID))
+	o.members[index] = member
+
+	return diags
+}
+
+// deregisterMember removes the NodeBalancer node of the given member, if any.
+func (o *groupOperator) deregisterMember(
+	ctx context.Context,
+	nodeBalancer *NodeBalancerModel,
+	member MemberModel,
+) (diags diag.Diagnostics) {
+	if nodeBalancer == nil || member.NodeBalancerNodeID.IsNull() || member.NodeBalancerNodeID.IsUnknown() {
+		return diags
+	}
+
+	nbID, configID := nodeBalancer.getIDs(&diags)
+	nodeID := helper.FrameworkSafeInt64ToInt(member.NodeBalancerNodeID.ValueInt64(), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, "client.DeleteNodeBalancerNode(...)", map[string]any{
+		"nodebalancer_id": nbID,
+		"config_id":       configID,
+		"node_id":         nodeID,
+	})
+
+	if err := o.client.DeleteNodeBalancerNode(ctx, nbID, configID, nodeID); err != nil && !linodego.IsNotFound(err) {
+		diags.AddError(
+			fmt.Sprintf("Failed to Deregister %s from NodeBalancer %d", member.Label.ValueString(), nbID),
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+func (m *NodeBalancerModel) getIDs(diags *diag.Diagnostics) (int, int) {
+	nbID := helper.FrameworkSafeInt64ToInt(m.NodeBalancerID.ValueInt64(), diags)
+	configID := helper.FrameworkSafeInt64ToInt(m.ConfigID.ValueInt64(), diags)
+	return nbID, configID
+}
+
+// nodeBalancersEqual returns whether members registered with the old NodeBalancer config
+// can be kept as they are for the new NodeBalancer config.
+func nodeBalancersEqual(oldNodeBalancer, newNodeBalancer *NodeBalancerModel) bool {
+	if oldNodeBalancer == nil || newNodeBalancer == nil {
+		return oldNodeBalancer == newNodeBalancer
+	}
+
+	return *oldNodeBalancer == *newNodeBalancer
+}
+
+// sameNodeBalancerConfig returns whether both NodeBalancer configs refer to the same config,
+// whose existing nodes can be updated rather than recreated.
+func sameNodeBalancerConfig(oldNodeBalancer, newNodeBalancer *NodeBalancerModel) bool {
+	if oldNodeBalancer == nil || newNodeBalancer == nil {
+		return false
+	}
+
+	return oldNodeBalancer.NodeBalancerID.Equal(newNodeBalancer.NodeBalancerID) &&
+		oldNodeBalancer.ConfigID.Equal(newNodeBalancer.ConfigID)
+}
@@ -0,0 +1,173 @@
+//go:build integration || instancegroup
+
+package instancegroup_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/instancegroup/tmpl"
+)
+
+const testGroupResName = "linode_instance_group.foobar"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{linodego.CapabilityLinodes, linodego.CapabilityNodeBalancers}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceGroup_basic(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             checkGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, "g6-nanode-1", 2, "foo"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testGroupResName, "id", label),
+					resource.TestCheckResourceAttr(testGroupResName, "size", "2"),
+					resource.TestCheckResourceAttr(testGroupResName, "max_unavailable", "2"),
+					resource.TestCheckResourceAttr(testGroupResName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(testGroupResName, "instances.0.label", label+"-0"),
+					resource.TestCheckResourceAttr(testGroupResName, "instances.0.status", "running"),
+					resource.TestCheckResourceAttrSet(testGroupResName, "instances.0.ipv4"),
+					resource.TestCheckResourceAttr(testGroupResName, "instances.1.label", label+"-1"),
+					checkGroupMembers(testGroupResName, "g6-nanode-1", "foo"),
+				),
+			},
+			// Scale up, resize and retag the existing members in place
+			{
+				Config: tmpl.Basic(t, label, testRegion, "g6-standard-1", 3, "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testGroupResName, "instances.#", "3"),
+					resource.TestCheckResourceAttr(testGroupResName, "instances.2.label", label+"-2"),
+					checkGroupMembers(testGroupResName, "g6-standard-1", "bar"),
+				),
+			},
+			// Scale down
+			{
+				Config: tmpl.Basic(t, label, testRegion, "g6-standard-1", 1, "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testGroupResName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(testGroupResName, "instances.0.label", label+"-0"),
+					checkGroupMembers(testGroupResName, "g6-standard-1", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInstanceGroup_nodeBalancer(t *testing.T) {
+	t.Parallel()
+
+	// NodeBalancer node labels are limited to 32 characters
+	label := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             checkGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.NodeBalancer(t, label, testRegion, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testGroupResName, "instances.#", "2"),
+					resource.TestCheckResourceAttrSet(testGroupResName, "instances.0.private_ip_address"),
+					resource.TestCheckResourceAttrSet(testGroupResName, "instances.0.nodebalancer_node_id"),
+					resource.TestCheckResourceAttrSet(testGroupResName, "instances.1.nodebalancer_node_id"),
+					resource.TestCheckResourceAttr(testGroupResName, "nodebalancer.weight", "50"),
+					resource.TestCheckResourceAttr(testGroupResName, "nodebalancer.mode", "accept"),
+				),
+			},
+			{
+				Config: tmpl.NodeBalancer(t, label, testRegion, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testGroupResName, "instances.#", "1"),
+					resource.TestCheckResourceAttrSet(testGroupResName, "instances.0.nodebalancer_node_id"),
+				),
+			},
+		},
+	})
+}
+
+// checkGroupMembers checks that every Linode of the group has the given type and tag.
+func checkGroupMembers(name, instanceType, tag string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["instances.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := range count {
+			id, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("instances.%d.id", i)])
+			if err != nil {
+				return err
+			}
+
+			instance, err := client.GetInstance(context.Background(), id)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Linode %d: %s", id, err)
+			}
+
+			if instance.Type != instanceType {
+				return fmt.Errorf("expected Linode %d to have type %s, got %s", id, instanceType, instance.Type)
+			}
+
+			if len(instance.Tags) != 1 || instance.Tags[0] != tag {
+				return fmt.Errorf("expected Linode %d to have tags [%s], got %v", id, tag, instance.Tags)
+			}
+		}
+
+		return nil
+	}
+}
+
+func checkGroupDestroy(s *terraform.State) error {
+	client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_group" {
+			continue
+		}
+
+		filter := fmt.Sprintf("{\"label\": {\"+contains\": \"%s-\"}}", rs.Primary.ID)
+
+		instances, err := client.ListInstances(context.Background(), linodego.NewListOptions(0, filter))
+		if err != nil {
+			return fmt.Errorf("Error listing Linodes: %s", err)
+		}
+
+		if len(instances) > 0 {
+			return fmt.Errorf("Linode instance group %s still has %d Linodes", rs.Primary.ID, len(instances))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label  string
+	Region string
+	Type   string
+	Size   int
+	Tag    string
+	PubKey string
+}
+
+func Basic(t testing.TB, label, region, instanceType string, size int, tag string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_group_basic", TemplateData{
+			Label:  label,
+			Region: region,
+			Type:   instanceType,
+			Size:   size,
+			Tag:    tag,
+			PubKey: acceptance.PublicKeyMaterial,
+		})
+}
+
+func NodeBalancer(t testing.TB, label, region string, size int) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_group_nodebalancer", TemplateData{
+			Label:  label,
+			Region: region,
+			Type:   "g6-nanode-1",
+			Size:   size,
+			PubKey: acceptance.PublicKeyMaterial,
+		})
+}
@@ -0,0 +1,79 @@
+package instanceinterfaceupgrade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_instance_interface_upgrade",
+				Schema: &frameworkDataSourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data."+d.Config.Name)
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linodeID := helper.FrameworkSafeInt64ToInt(data.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", linodeID)
+
+	// A dry run reports the resulting interfaces without upgrading them
+	upgradeOpts := linodego.LinodeInterfacesUpgradeOptions{
+		DryRun: linodego.Pointer(true),
+	}
+
+	if !data.ConfigID.IsNull() {
+		upgradeOpts.ConfigID = linodego.Pointer(
+			helper.FrameworkSafeInt64ToInt(data.ConfigID.ValueInt64(), &resp.Diagnostics),
+		)
+	}
+
+	tflog.Trace(ctx, "client.UpgradeInterfaces(...)", map[string]any{
+		"options": upgradeOpts,
+	})
+
+	upgrade, err := d.Meta.Client.UpgradeInterfaces(ctx, linodeID, upgradeOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Preview Interface Upgrade for Linode %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ParseUpgrade(ctx, linodeID, upgrade, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
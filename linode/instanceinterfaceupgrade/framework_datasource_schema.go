@@ -0,0 +1,34 @@
+package instanceinterfaceupgrade
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/linode/terraform-provider-linode/v3/linode/linodeinterface"
+)
+
+var interfaceObject = schema.NestedAttributeObject{
+	Attributes: linodeinterface.DataSourceInterfaceAttributes(),
+}
+
+var frameworkDataSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode.",
+			Computed:    true,
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to preview the interface upgrade of.",
+			Required:    true,
+		},
+		"config_id": schema.Int64Attribute{
+			Description: "The ID of the configuration profile to upgrade the interfaces of. " +
+				"Defaults to the configuration profile selected by the API.",
+			Optional: true,
+			Computed: true,
+		},
+		"interfaces": schema.ListNestedAttribute{
+			Description:  "The Linode interfaces the legacy configuration interfaces would be upgraded to.",
+			Computed:     true,
+			NestedObject: interfaceObject,
+		},
+	},
+}
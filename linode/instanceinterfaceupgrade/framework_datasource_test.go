@@ -0,0 +1,86 @@
+//go:build integration || instanceinterfaceupgrade
+
+package instanceinterfaceupgrade_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/instanceinterfaceupgrade/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps(
+		[]string{linodego.CapabilityLinodes, linodego.CapabilityLinodeInterfaces}, "core",
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccDataSourceInstanceInterfaceUpgrade_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := "linode_instance.foobar"
+	dataSourceName := "data.linode_instance_interface_upgrade.foobar"
+
+	var instance linodego.Instance
+
+	label := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, rootPass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acceptance.CheckInstanceExists(instanceName, &instance),
+					resource.TestCheckResourceAttr(instanceName, "interface_generation", "legacy_config"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "linode_id", instanceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "config_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "interfaces.#", "1"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "interfaces.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "interfaces.0.public.ipv4.addresses.#"),
+				),
+			},
+			// Flipping the interface generation upgrades the interfaces in place
+			{
+				Config: tmpl.Upgraded(t, label, testRegion, rootPass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(instanceName, "interface_generation", "linode"),
+					resource.TestCheckResourceAttr(instanceName, "interface.#", "0"),
+					checkInstanceNotReplaced(instanceName, &instance),
+				),
+			},
+		},
+	})
+}
+
+// checkInstanceNotReplaced checks that the Linode still has the ID of the given Linode.
+func checkInstanceNotReplaced(name string, instance *linodego.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID != fmt.Sprint(instance.ID) {
+			return fmt.Errorf("expected Linode %d to be upgraded in place, got Linode %s", instance.ID, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
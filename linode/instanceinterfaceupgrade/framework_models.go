@@ -0,0 +1,57 @@
+package instanceinterfaceupgrade
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/linodeinterface"
+)
+
+type DataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	LinodeID   types.Int64  `tfsdk:"linode_id"`
+	ConfigID   types.Int64  `tfsdk:"config_id"`
+	Interfaces types.List   `tfsdk:"interfaces"`
+}
+
+func (data *DataSourceModel) ParseUpgrade(
+	ctx context.Context,
+	linodeID int,
+	upgrade *linodego.LinodeInterfacesUpgrade,
+	diags *diag.Diagnostics,
+) {
+	data.ID = types.StringValue(strconv.Itoa(linodeID))
+	data.ConfigID = types.Int64Value(int64(upgrade.ConfigID))
+
+	attrTypes := interfaceObject.Type().(types.ObjectType).AttrTypes
+
+	interfaces := make([]linodeinterface.DataSourceModel, len(upgrade.Interfaces))
+
+	for i, iface := range upgrade.Interfaces {
+		interfaces[i] = linodeinterface.DataSourceModel{
+			LinodeID:     types.Int64Value(int64(linodeID)),
+			DefaultRoute: types.ObjectNull(attrTypes["default_route"].(types.ObjectType).AttrTypes),
+			Public:       types.ObjectNull(attrTypes["public"].(types.ObjectType).AttrTypes),
+			VLAN:         types.ObjectNull(attrTypes["vlan"].(types.ObjectType).AttrTypes),
+			VPC:          types.ObjectNull(attrTypes["vpc"].(types.ObjectType).AttrTypes),
+		}
+
+		interfaces[i].FlattenInterface(ctx, iface, diags)
+		if diags.HasError() {
+			return
+		}
+
+		// Interfaces are only assigned IDs once the upgrade is performed
+		if iface.ID == 0 {
+			interfaces[i].ID = types.StringNull()
+		}
+	}
+
+	list, newDiags := types.ListValueFrom(ctx, interfaceObject.Type(), interfaces)
+	diags.Append(newDiags...)
+
+	data.Interfaces = list
+}
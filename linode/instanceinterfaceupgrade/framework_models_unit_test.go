@@ -0,0 +1,65 @@
+//go:build unit
+
+package instanceinterfaceupgrade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/linodeinterface"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUpgrade(t *testing.T) {
+	upgrade := &linodego.LinodeInterfacesUpgrade{
+		ConfigID: 456,
+		DryRun:   true,
+		Interfaces: []linodego.LinodeInterface{
+			{
+				DefaultRoute: &linodego.InterfaceDefaultRoute{
+					IPv4: linodego.Pointer(true),
+				},
+				Public: &linodego.PublicInterface{
+					IPv4: &linodego.PublicInterfaceIPv4{
+						Addresses: []linodego.PublicInterfaceIPv4Address{
+							{Address: "172.105.1.2", Primary: true},
+						},
+					},
+					IPv6: &linodego.PublicInterfaceIPv6{},
+				},
+			},
+			{
+				VLAN: &linodego.VLANInterface{
+					VLANLabel:   "my-vlan",
+					IPAMAddress: linodego.Pointer("10.0.0.1/24"),
+				},
+			},
+		},
+	}
+
+	var data DataSourceModel
+	var diags diag.Diagnostics
+
+	data.ParseUpgrade(context.Background(), 123, upgrade, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	require.Equal(t, "123", data.ID.ValueString())
+	require.Equal(t, int64(456), data.ConfigID.ValueInt64())
+
+	var interfaces []linodeinterface.DataSourceModel
+	require.False(t, data.Interfaces.ElementsAs(context.Background(), &interfaces, false).HasError())
+	require.Len(t, interfaces, 2)
+
+	require.True(t, interfaces[0].ID.IsNull())
+	require.Equal(t, int64(123), interfaces[0].LinodeID.ValueInt64())
+	require.False(t, interfaces[0].Public.IsNull())
+	require.True(t, interfaces[0].VLAN.IsNull())
+	require.True(t, interfaces[0].VPC.IsNull())
+
+	require.True(t, interfaces[1].Public.IsNull())
+	require.True(t, interfaces[1].DefaultRoute.IsNull())
+	require.Equal(t, types.StringValue("my-vlan"), interfaces[1].VLAN.Attributes()["vlan_label"])
+}
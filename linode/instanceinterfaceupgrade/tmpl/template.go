@@ -0,0 +1,31 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label    string
+	Region   string
+	RootPass string
+}
+
+func Basic(t testing.TB, label, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_interface_upgrade_basic", TemplateData{
+			Label:    label,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func Upgraded(t testing.TB, label, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_interface_upgrade_upgraded", TemplateData{
+			Label:    label,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
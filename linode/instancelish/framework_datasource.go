@@ -0,0 +1,93 @@
+package instancelish
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_instance_lish",
+				Schema: &frameworkDatasourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data."+d.Config.Name)
+
+	client := d.Meta.Client
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linodeID := helper.FrameworkSafeInt64ToInt(
+		data.LinodeID.ValueInt64(),
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get Linode Instance %d", linodeID), err.Error(),
+		)
+		return
+	}
+
+	profile, err := client.GetProfile(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Profile", err.Error())
+		return
+	}
+
+	var consoleOutput []string
+
+	if privateKey := d.Meta.Config.LishPrivateKey; !privateKey.IsNull() {
+		lines := defaultConsoleLines
+		if !data.ConsoleLines.IsNull() {
+			lines = helper.FrameworkSafeInt64ToInt(data.ConsoleLines.ValueInt64(), &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		consoleOutput, err = helper.GetLishConsoleOutput(
+			ctx, privateKey.ValueString(), profile.Username, instance.Region, instance.Label, lines,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get Console Output of Linode Instance %d", linodeID), err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.parseLish(ctx, instance, profile, consoleOutput)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
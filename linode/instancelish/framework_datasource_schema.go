@@ -0,0 +1,56 @@
+package instancelish
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultConsoleLines = 50
+
+var frameworkDatasourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode.",
+			Required:    true,
+		},
+		"console_lines": schema.Int64Attribute{
+			Description: "The number of the most recent lines of console output to return. (default `50`)",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode.",
+			Computed:    true,
+		},
+		"ssh_gateway": schema.StringAttribute{
+			Description: "The hostname of the Lish SSH gateway of the Linode's region.",
+			Computed:    true,
+		},
+		"ssh_command": schema.StringAttribute{
+			Description: "The SSH command that connects to the console of the Linode through Lish.",
+			Computed:    true,
+		},
+		"auth_method": schema.StringAttribute{
+			Description: "The methods of authentication allowed when connecting through Lish.",
+			Computed:    true,
+		},
+		"weblish_url": schema.StringAttribute{
+			Description: "The URL of the Weblish console of the Linode in Cloud Manager.",
+			Computed:    true,
+		},
+		"glish_url": schema.StringAttribute{
+			Description: "The URL of the Glish graphical console of the Linode in Cloud Manager.",
+			Computed:    true,
+		},
+		"console_output": schema.ListAttribute{
+			Description: "The most recent lines of console output of the Linode. " +
+				"Only available if the provider's `lish_private_key` is set.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	},
+}
@@ -0,0 +1,56 @@
+//go:build integration || instancelish
+
+package instancelish_test
+
+import (
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/instancelish/tmpl"
+)
+
+const testInstanceLishResName = "data.linode_instance_lish.test"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{linodego.CapabilityLinodes}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccDataSourceInstanceLish_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.DataBasic(t, name, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testInstanceLishResName, "id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttr(testInstanceLishResName, "ssh_gateway", "lish-"+testRegion+".linode.com"),
+					resource.TestMatchResourceAttr(
+						testInstanceLishResName, "ssh_command",
+						regexp.MustCompile(`^ssh -t \S+@lish-`+testRegion+`\.linode\.com `+name+`$`),
+					),
+					resource.TestCheckResourceAttrSet(testInstanceLishResName, "auth_method"),
+					resource.TestMatchResourceAttr(testInstanceLishResName, "weblish_url", regexp.MustCompile(`/lish/weblish$`)),
+					resource.TestMatchResourceAttr(testInstanceLishResName, "glish_url", regexp.MustCompile(`/lish/glish$`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package instancelish
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+type DataSourceModel struct {
+	LinodeID      types.Int64  `tfsdk:"linode_id"`
+	ConsoleLines  types.Int64  `tfsdk:"console_lines"`
+	ID            types.String `tfsdk:"id"`
+	SSHGateway    types.String `tfsdk:"ssh_gateway"`
+	SSHCommand    types.String `tfsdk:"ssh_command"`
+	AuthMethod    types.String `tfsdk:"auth_method"`
+	WeblishURL    types.String `tfsdk:"weblish_url"`
+	GlishURL      types.String `tfsdk:"glish_url"`
+	ConsoleOutput types.List   `tfsdk:"console_output"`
+}
+
+func (data *DataSourceModel) parseLish(
+	ctx context.Context,
+	instance *linodego.Instance,
+	profile *linodego.Profile,
+	consoleOutput []string,
+) diag.Diagnostics {
+	data.ID = types.StringValue(strconv.Itoa(instance.ID))
+	data.SSHGateway = types.StringValue(helper.LishGateway(instance.Region))
+	data.SSHCommand = types.StringValue(helper.LishSSHCommand(profile.Username, instance.Region, instance.Label))
+	data.AuthMethod = types.StringValue(string(profile.LishAuthMethod))
+	data.WeblishURL = types.StringValue(helper.WeblishURL(instance.ID))
+	data.GlishURL = types.StringValue(helper.GlishURL(instance.ID))
+
+	if consoleOutput == nil {
+		data.ConsoleOutput = types.ListNull(types.StringType)
+		return nil
+	}
+
+	output, diags := types.ListValueFrom(ctx, types.StringType, consoleOutput)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ConsoleOutput = output
+
+	return nil
+}
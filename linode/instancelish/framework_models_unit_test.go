@@ -0,0 +1,46 @@
+//go:build unit
+
+package instancelish
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLish(t *testing.T) {
+	instance := &linodego.Instance{
+		ID:     123,
+		Label:  "my-linode",
+		Region: "us-east",
+	}
+
+	profile := &linodego.Profile{
+		Username:       "tester",
+		LishAuthMethod: linodego.AuthMethodKeysOnly,
+	}
+
+	var data DataSourceModel
+
+	diags := data.parseLish(context.Background(), instance, profile, nil)
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "123", data.ID.ValueString())
+	require.Equal(t, "lish-us-east.linode.com", data.SSHGateway.ValueString())
+	require.Equal(t, "ssh -t tester@lish-us-east.linode.com my-linode", data.SSHCommand.ValueString())
+	require.Equal(t, "keys_only", data.AuthMethod.ValueString())
+	require.Equal(t, "https://cloud.linode.com/linodes/123/lish/weblish", data.WeblishURL.ValueString())
+	require.Equal(t, "https://cloud.linode.com/linodes/123/lish/glish", data.GlishURL.ValueString())
+	require.True(t, data.ConsoleOutput.IsNull())
+
+	diags = data.parseLish(context.Background(), instance, profile, []string{"localhost login:"})
+	require.False(t, diags.HasError())
+
+	var output []string
+	require.False(t, data.ConsoleOutput.ElementsAs(context.Background(), &output, false).HasError())
+	require.Equal(t, []string{"localhost login:"}, output)
+	require.Equal(t, types.StringType, data.ConsoleOutput.ElementType(context.Background()))
+}
@@ -0,0 +1,20 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label  string
+	Region string
+}
+
+func DataBasic(t testing.TB, instanceLabel, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_lish_data_basic", TemplateData{
+			Label:  instanceLabel,
+			Region: region,
+		})
+}
@@ -0,0 +1,86 @@
+package instancerescue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+type ResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	LinodeID        types.Int64    `tfsdk:"linode_id"`
+	BootConfigLabel types.String   `tfsdk:"boot_config_label"`
+	Device          types.Set      `tfsdk:"device"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+type DeviceModel struct {
+	DeviceName types.String `tfsdk:"device_name"`
+	DiskID     types.Int64  `tfsdk:"disk_id"`
+	VolumeID   types.Int64  `tfsdk:"volume_id"`
+}
+
+func (m *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	m.ID = helper.KeepOrUpdateValue(m.ID, other.ID, preserveKnown)
+	m.LinodeID = helper.KeepOrUpdateValue(m.LinodeID, other.LinodeID, preserveKnown)
+	m.BootConfigLabel = helper.KeepOrUpdateValue(m.BootConfigLabel, other.BootConfigLabel, preserveKnown)
+	m.Device = helper.KeepOrUpdateValue(m.Device, other.Device, preserveKnown)
+}
+
+// GetRescueOptions converts the device blocks of this model into
+// options accepted by the instance rescue endpoint.
+func (m *ResourceModel) GetRescueOptions(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) linodego.InstanceRescueOptions {
+	var result linodego.InstanceRescueOptions
+
+	if m.Device.IsNull() || m.Device.IsUnknown() {
+		return result
+	}
+
+	var devices []DeviceModel
+	diags.Append(m.Device.ElementsAs(ctx, &devices, false)...)
+	if diags.HasError() {
+		return result
+	}
+
+	seenDevices := make(map[string]bool, len(devices))
+
+	for _, device := range devices {
+		deviceName := device.DeviceName.ValueString()
+		if seenDevices[deviceName] {
+			diags.AddError(
+				"Duplicate Rescue Device",
+				fmt.Sprintf("Device %s was defined more than once.", deviceName),
+			)
+			return result
+		}
+		seenDevices[deviceName] = true
+
+		rescueDevice := linodego.InstanceConfigDevice{
+			DiskID:   helper.FrameworkSafeInt64ToInt(device.DiskID.ValueInt64(), diags),
+			VolumeID: helper.FrameworkSafeInt64ToInt(device.VolumeID.ValueInt64(), diags),
+		}
+		if diags.HasError() {
+			return result
+		}
+
+		field := reflect.Indirect(
+			reflect.ValueOf(&result.Devices),
+		).FieldByName(
+			strings.ToUpper(deviceName),
+		)
+
+		field.Set(reflect.ValueOf(&rescueDevice))
+	}
+
+	return result
+}
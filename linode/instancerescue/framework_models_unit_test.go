@@ -0,0 +1,90 @@
+//go:build unit
+
+package instancerescue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeviceSet(t *testing.T, devices ...DeviceModel) types.Set {
+	t.Helper()
+
+	elemType := frameworkResourceSchema.Blocks["device"].Type().(basetypes.SetType).ElemType
+
+	result, diags := types.SetValueFrom(context.Background(), elemType, devices)
+	require.False(t, diags.HasError(), diags)
+
+	return result
+}
+
+func TestGetRescueOptions(t *testing.T) {
+	model := ResourceModel{
+		Device: newDeviceSet(
+			t,
+			DeviceModel{
+				DeviceName: types.StringValue("sda"),
+				DiskID:     types.Int64Value(123),
+				VolumeID:   types.Int64Null(),
+			},
+			DeviceModel{
+				DeviceName: types.StringValue("sdc"),
+				DiskID:     types.Int64Null(),
+				VolumeID:   types.Int64Value(456),
+			},
+		),
+	}
+
+	var diags diag.Diagnostics
+	opts := model.GetRescueOptions(context.Background(), &diags)
+	require.False(t, diags.HasError(), diags)
+
+	require.NotNil(t, opts.Devices.SDA)
+	require.Equal(t, 123, opts.Devices.SDA.DiskID)
+	require.Zero(t, opts.Devices.SDA.VolumeID)
+
+	require.Nil(t, opts.Devices.SDB)
+
+	require.NotNil(t, opts.Devices.SDC)
+	require.Equal(t, 456, opts.Devices.SDC.VolumeID)
+	require.Zero(t, opts.Devices.SDC.DiskID)
+}
+
+func TestGetRescueOptions_noDevices(t *testing.T) {
+	model := ResourceModel{
+		Device: newDeviceSet(t),
+	}
+
+	var diags diag.Diagnostics
+	opts := model.GetRescueOptions(context.Background(), &diags)
+	require.False(t, diags.HasError(), diags)
+
+	require.Nil(t, opts.Devices.SDA)
+}
+
+func TestGetRescueOptions_duplicateDevice(t *testing.T) {
+	model := ResourceModel{
+		Device: newDeviceSet(
+			t,
+			DeviceModel{
+				DeviceName: types.StringValue("sda"),
+				DiskID:     types.Int64Value(123),
+				VolumeID:   types.Int64Null(),
+			},
+			DeviceModel{
+				DeviceName: types.StringValue("sda"),
+				DiskID:     types.Int64Null(),
+				VolumeID:   types.Int64Value(456),
+			},
+		),
+	}
+
+	var diags diag.Diagnostics
+	model.GetRescueOptions(context.Background(), &diags)
+	require.True(t, diags.HasError())
+}
@@ -0,0 +1,389 @@
+package instancerescue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+const (
+	DefaultCreateTimeout = 15 * time.Minute
+	DefaultDeleteTimeout = 15 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_rescue",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", linodeID)
+
+	rescueOpts := plan.GetRescueOptions(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := helper.WaitForInstanceNonTransientStatus(ctx, client, linodeID, timeoutSeconds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Linode %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// The boot config needs to be resolved before entering rescue mode
+	// because the rescue boot event does not reference a config.
+	if plan.BootConfigLabel.IsUnknown() {
+		plan.BootConfigLabel = getDefaultBootConfigLabel(ctx, client, linodeID, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Rescuing a running Linode reboots it, otherwise it is booted.
+	action := linodego.ActionLinodeBoot
+	if status == linodego.InstanceRunning {
+		action = linodego.ActionLinodeReboot
+	}
+
+	p, err := client.NewEventPoller(ctx, linodeID, linodego.EntityLinode, action)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.RescueInstance(...)", map[string]any{
+		"options": rescueOpts,
+	})
+	if err := client.RescueInstance(ctx, linodeID, rescueOpts); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Boot Linode %d into Rescue Mode", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Linode %d to Boot into Rescue Mode", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := client.WaitForInstanceStatus(
+		ctx, linodeID, linodego.InstanceRunning, timeoutSeconds,
+	); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Linode %d to be Running", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(linodeID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", linodeID)
+
+	client := r.Meta.Client
+
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode No Longer Exists",
+				fmt.Sprintf(
+					"Removing Linode rescue %s from state because the "+
+						"target Linode no longer exists",
+					state.ID.String(),
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Transient statuses can't tell us whether the Linode is still in rescue mode
+	if instance.Status != linodego.InstanceRunning && instance.Status != linodego.InstanceOffline {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	configID, err := helper.GetCurrentBootedConfig(ctx, client, linodeID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Current Booted Config of Linode %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// A Linode that is offline or booted into a config has left rescue mode
+	// due to external modification, so this resource should be recreated.
+	if instance.Status == linodego.InstanceOffline || configID != 0 {
+		resp.Diagnostics.AddWarning(
+			"Marking Rescue for Recreation",
+			fmt.Sprintf("Linode (%d) is no longer booted into Rescue Mode.", linodeID),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var state, plan ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only boot_config_label and timeouts can be updated in place,
+	// and neither requires an API call.
+	plan.CopyFrom(state, true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(deleteTimeout.Seconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "linode_id", linodeID)
+
+	status, err := helper.WaitForInstanceNonTransientStatus(ctx, client, linodeID, timeoutSeconds)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf(
+					"Attempted to boot Linode %d out of Rescue Mode but the Linode was not found",
+					linodeID,
+				),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Linode %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	configID := getConfigIDByLabel(ctx, client, linodeID, state.BootConfigLabel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if status == linodego.InstanceRunning {
+		resp.Diagnostics.Append(helper.FrameworkRebootInstance(ctx, linodeID, client, configID)...)
+		return
+	}
+
+	if err := helper.BootInstanceSync(ctx, client, linodeID, configID, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Boot Linode %d", linodeID),
+			err.Error(),
+		)
+	}
+}
+
+// getDefaultBootConfigLabel returns the label of the config the Linode is currently booted into,
+// falling back to the first config of the Linode.
+func getDefaultBootConfigLabel(
+	ctx context.Context,
+	client *linodego.Client,
+	linodeID int,
+	diags *diag.Diagnostics,
+) types.String {
+	bootedConfigID, err := helper.GetCurrentBootedConfig(ctx, client, linodeID)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to Get Current Booted Config of Linode %d", linodeID),
+			err.Error(),
+		)
+		return types.StringNull()
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to List Configs of Linode %d", linodeID),
+			err.Error(),
+		)
+		return types.StringNull()
+	}
+
+	if len(configs) < 1 {
+		return types.StringNull()
+	}
+
+	for _, config := range configs {
+		if config.ID == bootedConfigID {
+			return types.StringValue(config.Label)
+		}
+	}
+
+	return types.StringValue(configs[0].Label)
+}
+
+// getConfigIDByLabel resolves the ID of the Linode's config with the given label.
+// A null label resolves to 0, which boots the Linode into its default config.
+func getConfigIDByLabel(
+	ctx context.Context,
+	client *linodego.Client,
+	linodeID int,
+	label types.String,
+	diags *diag.Diagnostics,
+) int {
+	if label.IsNull() || label.IsUnknown() {
+		return 0
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to List Configs of Linode %d", linodeID),
+			err.Error(),
+		)
+		return 0
+	}
+
+	for _, config := range configs {
+		if config.Label == label.ValueString() {
+			return config.ID
+		}
+	}
+
+	diags.AddError(
+		"Boot Config Not Found",
+		fmt.Sprintf("Linode %d has no config with label %q.", linodeID, label.ValueString()),
+	)
+
+	return 0
+}
@@ -0,0 +1,82 @@
+package instancerescue
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// rescueDeviceNames contains the device slots accepted by the rescue endpoint.
+// sdh is reserved for the Finnix recovery image.
+var rescueDeviceNames = []string{"sda", "sdb", "sdc", "sdd", "sde", "sdf", "sdg"}
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode booted into Rescue Mode.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to boot into Rescue Mode.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"boot_config_label": schema.StringAttribute{
+			Description: "The label of the configuration profile to boot the Linode into when " +
+				"this resource is destroyed. Defaults to the configuration profile the Linode " +
+				"was booted into before entering Rescue Mode.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"device": schema.SetNestedBlock{
+			Description: "A disk or volume to attach to the Linode while in Rescue Mode.",
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Set{
+				setvalidator.SizeAtMost(len(rescueDeviceNames)),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"device_name": schema.StringAttribute{
+						Description: "The device slot to attach the disk or volume to (sda-sdg).",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(rescueDeviceNames...),
+						},
+					},
+					"disk_id": schema.Int64Attribute{
+						Description: "The ID of the disk to attach to this device slot.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("volume_id"),
+							),
+						},
+					},
+					"volume_id": schema.Int64Attribute{
+						Description: "The ID of the volume to attach to this device slot.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,110 @@
+//go:build integration || instancerescue
+
+package instancerescue_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/instancerescue/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{linodego.CapabilityLinodes}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceRescue_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := "linode_instance.foobar"
+	rescueName := "linode_instance_rescue.foobar"
+
+	var instance linodego.Instance
+
+	label := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, rootPass, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acceptance.CheckInstanceExists(instanceName, &instance),
+					resource.TestCheckResourceAttrSet(rescueName, "id"),
+					resource.TestCheckResourceAttrPair(rescueName, "linode_id", instanceName, "id"),
+					resource.TestCheckResourceAttr(rescueName, "boot_config_label", "config"),
+					resource.TestCheckResourceAttr(rescueName, "device.#", "2"),
+					checkBootedConfig(instanceName, ""),
+				),
+			},
+			// Drop the rescue resource and make sure the Linode is booted back into its config
+			{
+				Config: tmpl.Basic(t, label, testRegion, rootPass, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(instanceName, "status", "running"),
+					checkBootedConfig(instanceName, "config"),
+				),
+			},
+		},
+	})
+}
+
+// checkBootedConfig checks that the Linode is booted into the config with the given label,
+// where an empty label means the Linode is booted into Rescue Mode.
+func checkBootedConfig(name, configLabel string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+
+		configID, err := helper.GetCurrentBootedConfig(context.Background(), &client, id)
+		if err != nil {
+			return fmt.Errorf("Error getting booted config of Linode %d: %s", id, err)
+		}
+
+		if configLabel == "" {
+			if configID != 0 {
+				return fmt.Errorf("expected Linode %d to be booted into Rescue Mode, got config %d", id, configID)
+			}
+			return nil
+		}
+
+		config, err := client.GetInstanceConfig(context.Background(), id, configID)
+		if err != nil {
+			return fmt.Errorf("Error getting config %d of Linode %d: %s", configID, id, err)
+		}
+
+		if config.Label != configLabel {
+			return fmt.Errorf("expected Linode %d to be booted into config %s, got %s", id, configLabel, config.Label)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label        string
+	Region       string
+	RootPass     string
+	RescueExists bool
+}
+
+func Basic(t testing.TB, label, region, rootPass string, rescueExists bool) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_rescue_basic", TemplateData{
+			Label:        label,
+			Region:       region,
+			RootPass:     rootPass,
+			RescueExists: rescueExists,
+		})
+}
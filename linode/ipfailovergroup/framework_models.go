@@ -0,0 +1,61 @@
+package ipfailovergroup
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+type ResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	LinodeIDs types.Set    `tfsdk:"linode_ids"`
+	Addresses types.Set    `tfsdk:"addresses"`
+}
+
+// GetLinodeIDs returns the IDs of the members of this group.
+func (data *ResourceModel) GetLinodeIDs(ctx context.Context, diags *diag.Diagnostics) []int {
+	var linodeIDs []int64
+
+	diags.Append(data.LinodeIDs.ElementsAs(ctx, &linodeIDs, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := make([]int, len(linodeIDs))
+
+	for i, id := range linodeIDs {
+		result[i] = helper.FrameworkSafeInt64ToInt(id, diags)
+	}
+
+	return result
+}
+
+// GetAddresses returns the addresses shared between the members of this group.
+func (data *ResourceModel) GetAddresses(ctx context.Context, diags *diag.Diagnostics) []string {
+	var addresses []string
+
+	diags.Append(data.Addresses.ElementsAs(ctx, &addresses, false)...)
+
+	return addresses
+}
+
+// FlattenGroup updates the members and addresses of this group from their current state.
+func (data *ResourceModel) FlattenGroup(
+	ctx context.Context, linodeIDs []int, addresses []string, diags *diag.Diagnostics,
+) {
+	linodeIDValues := make([]int64, len(linodeIDs))
+	for i, id := range linodeIDs {
+		linodeIDValues[i] = int64(id)
+	}
+
+	linodeIDSet, newDiags := types.SetValueFrom(ctx, types.Int64Type, linodeIDValues)
+	diags.Append(newDiags...)
+
+	addressSet, newDiags := types.SetValueFrom(ctx, types.StringType, addresses)
+	diags.Append(newDiags...)
+
+	data.LinodeIDs = linodeIDSet
+	data.Addresses = addressSet
+}
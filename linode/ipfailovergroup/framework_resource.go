@@ -0,0 +1,249 @@
+package ipfailovergroup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_ip_failover_group",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+// syncGroup shares the new addresses with the new members and unshares the old
+// addresses from members that are no longer part of the group.
+func syncGroup(
+	ctx context.Context,
+	client *linodego.Client,
+	oldLinodeIDs, newLinodeIDs []int,
+	oldAddresses, newAddresses []string,
+	diags *diag.Diagnostics,
+) {
+	removed := slices.Concat(oldAddresses, newAddresses)
+
+	for _, linodeID := range newLinodeIDs {
+		if err := syncMemberSharedIPs(ctx, client, linodeID, removed, newAddresses); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Failed to share addresses with Linode %d", linodeID),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, linodeID := range oldLinodeIDs {
+		if slices.Contains(newLinodeIDs, linodeID) {
+			continue
+		}
+
+		err := syncMemberSharedIPs(ctx, client, linodeID, oldAddresses, nil)
+		if err != nil && !linodego.IsNotFound(err) {
+			diags.AddError(
+				fmt.Sprintf("Failed to unshare addresses from Linode %d", linodeID),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linodeIDs := plan.GetLinodeIDs(ctx, &resp.Diagnostics)
+	addresses := plan.GetAddresses(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, linodeIDs)
+
+	validateGroupRegion(ctx, client, linodeIDs, addresses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to generate UUID",
+			fmt.Sprintf("An error occurred while generating a UUID for IP failover group resource: %s", err.Error()),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id.String())
+
+	syncGroup(ctx, client, nil, linodeIDs, nil, addresses, &resp.Diagnostics)
+
+	// Save the group even if sharing failed partway through so that
+	// it is tainted and its addresses are unshared on destroy.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	linodeIDs := state.GetLinodeIDs(ctx, &resp.Diagnostics)
+	addresses := state.GetAddresses(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, linodeIDs)
+
+	members := make([]int, 0, len(linodeIDs))
+	var missing []string
+
+	for _, linodeID := range linodeIDs {
+		networking, err := getMemberNetworking(ctx, client, linodeID)
+		if err != nil {
+			if linodego.IsNotFound(err) {
+				tflog.Warn(ctx, fmt.Sprintf("Linode %d no longer exists, removing it from the group", linodeID))
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get networking for Linode %d", linodeID),
+				err.Error(),
+			)
+			return
+		}
+
+		members = append(members, linodeID)
+		missing = append(missing, getMissingAddresses(networking, addresses)...)
+	}
+
+	// Addresses that are not shared with every member are dropped
+	// so they will be shared again on the next apply.
+	addresses = slices.DeleteFunc(addresses, func(address string) bool {
+		return slices.Contains(missing, address)
+	})
+
+	state.FlattenGroup(ctx, members, addresses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldLinodeIDs := state.GetLinodeIDs(ctx, &resp.Diagnostics)
+	oldAddresses := state.GetAddresses(ctx, &resp.Diagnostics)
+	newLinodeIDs := plan.GetLinodeIDs(ctx, &resp.Diagnostics)
+	newAddresses := plan.GetAddresses(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, newLinodeIDs)
+
+	validateGroupRegion(ctx, client, newLinodeIDs, newAddresses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncGroup(ctx, client, oldLinodeIDs, newLinodeIDs, oldAddresses, newAddresses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linodeIDs := state.GetLinodeIDs(ctx, &resp.Diagnostics)
+	addresses := state.GetAddresses(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, linodeIDs)
+
+	syncGroup(ctx, client, linodeIDs, nil, addresses, nil, &resp.Diagnostics)
+}
+
+func populateLogAttributes(ctx context.Context, linodeIDs []int) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_ids": linodeIDs,
+	})
+}
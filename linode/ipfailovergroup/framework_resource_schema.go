@@ -0,0 +1,74 @@
+package ipfailovergroup
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique ID of this failover group.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_ids": schema.SetAttribute{
+			Description: "The IDs of the Linodes in this failover group. " +
+				"All Linodes must be in the same region.",
+			ElementType: types.Int64Type,
+			Required:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(2),
+			},
+		},
+		"addresses": schema.SetAttribute{
+			Description: "The IPv4 addresses and IPv6 ranges shared between the Linodes in this failover group. " +
+				"IPv6 ranges are specified without their prefix length.",
+			ElementType: types.StringType,
+			Required:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(ipAddressValidator{}),
+			},
+		},
+	},
+}
+
+// ipAddressValidator validates that a string is an IPv4 address or an IPv6 range address.
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 address or an IPv6 range without its prefix length"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(
+	ctx context.Context, req validator.StringRequest, resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if net.ParseIP(value) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Expected an IPv4 address or an IPv6 range without its prefix length, got %s", value),
+		)
+	}
+}
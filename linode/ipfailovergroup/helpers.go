@@ -0,0 +1,230 @@
+package ipfailovergroup
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// memberNetworking contains the addresses owned by and shared with a member Linode.
+type memberNetworking struct {
+	owned  []string
+	shared []string
+}
+
+// isIPv4 returns whether the given address is an IPv4 address rather than an IPv6 range.
+func isIPv4(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() != nil
+}
+
+// flattenMemberNetworking returns the IPv4 addresses owned by a Linode and the
+// addresses shared with it.
+func flattenMemberNetworking(networking *linodego.InstanceIPAddressResponse) memberNetworking {
+	var result memberNetworking
+
+	if networking.IPv4 != nil {
+		for _, ips := range [][]*linodego.InstanceIP{
+			networking.IPv4.Public, networking.IPv4.Private, networking.IPv4.Reserved,
+		} {
+			for _, ip := range ips {
+				result.owned = append(result.owned, ip.Address)
+			}
+		}
+
+		for _, ip := range networking.IPv4.Shared {
+			result.shared = append(result.shared, ip.Address)
+		}
+	}
+
+	if networking.IPv6 != nil {
+		for _, ip := range networking.IPv6.Global {
+			// BGP ips will not have a route target
+			if ip.RouteTarget != "" {
+				continue
+			}
+
+			result.shared = append(result.shared, ip.Range)
+		}
+	}
+
+	return result
+}
+
+// getMemberAddresses returns the group addresses that should be shared with a member.
+// IPv4 addresses are never shared with the Linode they are assigned to, while
+// IPv6 ranges are shared with every member so they can be announced over BGP.
+func getMemberAddresses(owned, addresses []string) []string {
+	result := make([]string, 0, len(addresses))
+
+	for _, address := range addresses {
+		if isIPv4(address) && slices.Contains(owned, address) {
+			continue
+		}
+
+		result = append(result, address)
+	}
+
+	return result
+}
+
+// getMemberSharedIPs returns the full list of addresses to share with a member.
+// Addresses shared outside of this group are preserved, addresses in removed
+// are unshared and addresses in added are shared.
+func getMemberSharedIPs(shared, removed, added []string) []string {
+	result := make([]string, 0, len(shared)+len(added))
+
+	for _, address := range shared {
+		if slices.Contains(removed, address) || slices.Contains(added, address) {
+			continue
+		}
+
+		result = append(result, address)
+	}
+
+	result = append(result, added...)
+	slices.Sort(result)
+
+	return result
+}
+
+// getMissingAddresses returns the group addresses that are not shared with a member.
+func getMissingAddresses(networking memberNetworking, addresses []string) []string {
+	var result []string
+
+	for _, address := range getMemberAddresses(networking.owned, addresses) {
+		if !slices.Contains(networking.shared, address) {
+			result = append(result, address)
+		}
+	}
+
+	return result
+}
+
+// getMemberNetworking returns the networking information of a member Linode.
+func getMemberNetworking(
+	ctx context.Context, client *linodego.Client, linodeID int,
+) (memberNetworking, error) {
+	networking, err := client.GetInstanceIPAddresses(ctx, linodeID)
+	if err != nil {
+		return memberNetworking{}, err
+	}
+
+	return flattenMemberNetworking(networking), nil
+}
+
+// syncMemberSharedIPs unshares the removed addresses from a member Linode and
+// shares the added addresses with it, leaving any other shared addresses untouched.
+func syncMemberSharedIPs(
+	ctx context.Context, client *linodego.Client, linodeID int, removed, added []string,
+) error {
+	networking, err := getMemberNetworking(ctx, client, linodeID)
+	if err != nil {
+		return err
+	}
+
+	sharedIPs := getMemberSharedIPs(
+		networking.shared, removed, getMemberAddresses(networking.owned, added),
+	)
+
+	current := slices.Clone(networking.shared)
+	slices.Sort(current)
+
+	if slices.Equal(current, sharedIPs) {
+		return nil
+	}
+
+	options := linodego.IPAddressesShareOptions{
+		LinodeID: linodeID,
+		IPs:      sharedIPs,
+	}
+
+	tflog.Debug(ctx, "client.ShareIPAddresses(...)", map[string]any{
+		"options": options,
+	})
+
+	return client.ShareIPAddresses(ctx, options)
+}
+
+// validateGroupRegion validates that all members and addresses of a group are in the same region,
+// which is required for IP sharing.
+func validateGroupRegion(
+	ctx context.Context,
+	client *linodego.Client,
+	linodeIDs []int,
+	addresses []string,
+	diags *diag.Diagnostics,
+) {
+	var region string
+	var regionLinodeID int
+
+	for _, linodeID := range linodeIDs {
+		instance, err := client.GetInstance(ctx, linodeID)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to get Linode %d", linodeID), err.Error())
+			return
+		}
+
+		if region == "" {
+			region, regionLinodeID = instance.Region, linodeID
+			continue
+		}
+
+		if instance.Region != region {
+			diags.AddAttributeError(
+				path.Root("linode_ids"),
+				"Members Are in Different Regions",
+				fmt.Sprintf(
+					"All members of an IP failover group must be in the same region, "+
+						"but Linode %d is in %s and Linode %d is in %s.",
+					regionLinodeID, region, linodeID, instance.Region,
+				),
+			)
+			return
+		}
+	}
+
+	for _, address := range addresses {
+		addressRegion, err := getAddressRegion(ctx, client, address)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to get address %s", address), err.Error())
+			return
+		}
+
+		if addressRegion != region {
+			diags.AddAttributeError(
+				path.Root("addresses"),
+				"Address Is in a Different Region",
+				fmt.Sprintf(
+					"Address %s is in %s, but the members of this IP failover group are in %s.",
+					address, addressRegion, region,
+				),
+			)
+		}
+	}
+}
+
+// getAddressRegion returns the region of an IPv4 address or IPv6 range.
+func getAddressRegion(ctx context.Context, client *linodego.Client, address string) (string, error) {
+	if isIPv4(address) {
+		ip, err := client.GetIPAddress(ctx, address)
+		if err != nil {
+			return "", err
+		}
+
+		return ip.Region, nil
+	}
+
+	ipv6Range, err := client.GetIPv6Range(ctx, address)
+	if err != nil {
+		return "", err
+	}
+
+	return ipv6Range.Region, nil
+}
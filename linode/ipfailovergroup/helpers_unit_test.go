@@ -0,0 +1,88 @@
+//go:build unit
+
+package ipfailovergroup
+
+import (
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsIPv4(t *testing.T) {
+	require.True(t, isIPv4("172.105.1.2"))
+	require.False(t, isIPv4("2600:3c03:e000:123::"))
+	require.False(t, isIPv4("invalid"))
+}
+
+func TestFlattenMemberNetworking(t *testing.T) {
+	networking := flattenMemberNetworking(&linodego.InstanceIPAddressResponse{
+		IPv4: &linodego.InstanceIPv4Response{
+			Public:   []*linodego.InstanceIP{{Address: "172.105.1.2"}},
+			Private:  []*linodego.InstanceIP{{Address: "192.168.130.4"}},
+			Reserved: []*linodego.InstanceIP{{Address: "172.105.1.3"}},
+			Shared:   []*linodego.InstanceIP{{Address: "172.105.1.4"}},
+		},
+		IPv6: &linodego.InstanceIPv6Response{
+			Global: []linodego.IPv6Range{
+				{Range: "2600:3c03:e000:123::", RouteTarget: "2600:3c03::f03c:91ff:fe24:3a2f"},
+				{Range: "2600:3c03:e000:456::"},
+			},
+		},
+	})
+
+	require.Equal(t, []string{"172.105.1.2", "192.168.130.4", "172.105.1.3"}, networking.owned)
+	require.Equal(t, []string{"172.105.1.4", "2600:3c03:e000:456::"}, networking.shared)
+}
+
+func TestGetMemberAddresses(t *testing.T) {
+	addresses := []string{"172.105.1.2", "172.105.1.3", "2600:3c03:e000:123::"}
+
+	require.Equal(
+		t,
+		[]string{"172.105.1.3", "2600:3c03:e000:123::"},
+		getMemberAddresses([]string{"172.105.1.2"}, addresses),
+	)
+	require.Equal(t, addresses, getMemberAddresses(nil, addresses))
+}
+
+func TestGetMemberSharedIPs(t *testing.T) {
+	// Unrelated shares are preserved
+	require.Equal(
+		t,
+		[]string{"172.105.1.2", "172.105.1.9"},
+		getMemberSharedIPs([]string{"172.105.1.9"}, nil, []string{"172.105.1.2"}),
+	)
+
+	// Replaced group addresses are unshared
+	require.Equal(
+		t,
+		[]string{"172.105.1.3", "172.105.1.9"},
+		getMemberSharedIPs(
+			[]string{"172.105.1.2", "172.105.1.9"},
+			[]string{"172.105.1.2", "172.105.1.3"},
+			[]string{"172.105.1.3"},
+		),
+	)
+
+	// Removed members keep only their unrelated shares
+	require.Equal(
+		t,
+		[]string{"172.105.1.9"},
+		getMemberSharedIPs([]string{"172.105.1.2", "172.105.1.9"}, []string{"172.105.1.2"}, nil),
+	)
+}
+
+func TestGetMissingAddresses(t *testing.T) {
+	networking := memberNetworking{
+		owned:  []string{"172.105.1.2"},
+		shared: []string{"2600:3c03:e000:123::"},
+	}
+
+	require.Empty(t, getMissingAddresses(networking, []string{"172.105.1.2", "2600:3c03:e000:123::"}))
+	require.Equal(
+		t,
+		[]string{"172.105.1.3"},
+		getMissingAddresses(networking, []string{"172.105.1.2", "172.105.1.3"}),
+	)
+}
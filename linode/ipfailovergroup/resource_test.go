@@ -0,0 +1,96 @@
+//go:build integration || ipfailovergroup
+
+package ipfailovergroup_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/instancesharedips"
+	"github.com/linode/terraform-provider-linode/v3/linode/ipfailovergroup/tmpl"
+)
+
+const (
+	testResourceName  = "linode_ip_failover_group.foobar"
+	resourcePrimary   = "linode_instance.primary"
+	resourceSecondary = "linode_instance.secondary"
+	resourceTertiary  = "linode_instance.tertiary"
+)
+
+// TODO: don't hardcode this once IPv6 sharing has a proper capability string
+const testRegion = "eu-central"
+
+func TestAccResourceIPFailoverGroup_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, name, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "linode_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(testResourceName, "linode_ids.*", resourcePrimary, "id"),
+					resource.TestCheckTypeSetElemAttrPair(testResourceName, "linode_ids.*", resourceSecondary, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "addresses.#", "1"),
+
+					checkInstanceSharedIPCount(resourcePrimary, 1),
+					checkInstanceSharedIPCount(resourceSecondary, 1),
+					checkInstanceSharedIPCount(resourceTertiary, 0),
+				),
+			},
+			{
+				// Replace the secondary member with the tertiary Linode
+				Config: tmpl.Updates(t, name, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "linode_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(testResourceName, "linode_ids.*", resourcePrimary, "id"),
+					resource.TestCheckTypeSetElemAttrPair(testResourceName, "linode_ids.*", resourceTertiary, "id"),
+
+					checkInstanceSharedIPCount(resourcePrimary, 1),
+					checkInstanceSharedIPCount(resourceSecondary, 0),
+					checkInstanceSharedIPCount(resourceTertiary, 1),
+				),
+			},
+		},
+	})
+}
+
+func checkInstanceSharedIPCount(name string, length int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := &acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ips, err := instancesharedips.GetSharedIPsForLinode(context.Background(), client, id)
+		if err != nil {
+			return err
+		}
+
+		if len(ips) != length {
+			return fmt.Errorf("lengths do not match: %d != %d", len(ips), length)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,28 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label  string
+	Region string
+}
+
+func Basic(t testing.TB, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"ip_failover_group_basic", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
+
+func Updates(t testing.TB, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"ip_failover_group_updates", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
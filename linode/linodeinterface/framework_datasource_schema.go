@@ -262,3 +262,22 @@ var frameworkDataSourceSchema = schema.Schema{
 		"vpc":           dataSourceVPCAttribute,
 	},
 }
+
+// DataSourceInterfaceAttributes returns the computed attributes describing a Linode interface,
+// for use by data sources which report multiple Linode interfaces.
+func DataSourceInterfaceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode interface.",
+			Computed:    true,
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode.",
+			Computed:    true,
+		},
+		"default_route": dataSourceDefaultRouteAttribute,
+		"public":        dataSourcePublicAttribute,
+		"vlan":          dataSourceVLANAttribute,
+		"vpc":           dataSourceVPCAttribute,
+	}
+}
@@ -42,8 +42,10 @@ func Resource() *schema.Resource {
 			customDiffValidateOptionalCount,
 			customDiffValidatePoolForStandardTier,
 			customDiffValidateUpdateStrategyWithTier,
+			helper.SDKv2ValidateRegionRequirements("region", regionRequirements),
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
+			helper.SDKv2PlanTagsAll(),
 			helper.SDKv2ValidateFieldRequiresAPIVersion(
 				helper.APIVersionV4Beta,
 				"tier",
@@ -130,7 +132,9 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	d.Set("label", cluster.Label)
 	d.Set("k8s_version", cluster.K8sVersion)
 	d.Set("region", cluster.Region)
-	d.Set("tags", cluster.Tags)
+	if err := helper.SDKv2SetTags(d, meta, cluster.Tags); err != nil {
+		return diag.Errorf("failed to set tags: %s", err)
+	}
 	d.Set("status", cluster.Status)
 	d.Set("tier", cluster.Tier)
 	d.Set("kubeconfig", kubeconfig.KubeConfig)
@@ -245,10 +249,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		})
 	}
 
-	if tagsRaw, tagsOk := d.GetOk("tags"); tagsOk {
-		tags := helper.ExpandStringSet(tagsRaw.(*schema.Set))
-		createOpts.Tags = tags
-	}
+	createOpts.Tags = helper.SDKv2TagsAll(d, meta)
 
 	tflog.Debug(ctx, "client.CreateLKECluster(...)", map[string]any{
 		"options": createOpts,
@@ -330,11 +331,11 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		updateOpts.ControlPlane = &expandedControlPlane
 	}
 
-	if d.HasChange("tags") {
-		tags := helper.ExpandStringSet(d.Get("tags").(*schema.Set))
+	if d.HasChanges("tags", "tags_all") {
+		tags := helper.SDKv2TagsAll(d, meta)
 		updateOpts.Tags = &tags
 	}
-	if d.HasChanges("label", "tags", "k8s_version", "control_plane") {
+	if d.HasChanges("label", "tags", "tags_all", "k8s_version", "control_plane") {
 		tflog.Debug(ctx, "client.UpdateLKECluster(...)", map[string]any{
 			"options": updateOpts,
 		})
@@ -651,3 +652,23 @@ func customDiffValidateUpdateStrategyWithTier(ctx context.Context, diff *schema.
 
 	return nil
 }
+
+// regionRequirements returns the region capabilities and plan types required by a planned cluster.
+func regionRequirements(diff *schema.ResourceDiff) helper.RegionRequirements {
+	requirements := helper.RegionRequirements{
+		Capabilities: []string{linodego.CapabilityLKE},
+	}
+
+	if diff.Get("tier").(string) == TierEnterprise {
+		requirements.Require(linodego.CapabilityKubernetesEnterprise)
+	}
+
+	for _, pool := range diff.Get("pool").([]any) {
+		if pool, ok := pool.(map[string]any); ok {
+			poolType, _ := pool["type"].(string)
+			requirements.RequirePlanType(poolType)
+		}
+	}
+
+	return requirements
+}
@@ -0,0 +1,52 @@
+//go:build unit
+
+package lke_test
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func clusterConfig(label string, nodeCount int) map[string]any {
+	return map[string]any{
+		"label":       label,
+		"region":      "us-east",
+		"k8s_version": "1.33",
+		"tags":        []any{"test"},
+		"pool": []map[string]any{
+			{
+				"type":  "g6-standard-1",
+				"count": nodeCount,
+			},
+		},
+	}
+}
+
+func TestResourceLKECluster_fakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	state := tf.Apply("linode_lke_cluster", nil, clusterConfig("fake-cluster", 3))
+	require.NotEmpty(t, state.Attr("id"))
+	require.Equal(t, "ready", state.Attr("status"))
+	require.Equal(t, float64(3), state.Attr("pool.0.count"))
+	require.Len(t, state.Attr("pool.0.nodes"), 3)
+	require.NotEmpty(t, state.Attr("kubeconfig"))
+
+	state = tf.Apply("linode_lke_cluster", state, clusterConfig("fake-cluster-renamed", 2))
+	require.Equal(t, "fake-cluster-renamed", state.Attr("label"))
+	require.Equal(t, float64(2), state.Attr("pool.0.count"))
+
+	pools := server.List("lke/clusters/" + state.Attr("id").(string) + "/pools")
+	require.Len(t, pools, 1)
+	require.Equal(t, float64(2), pools[0]["count"])
+
+	imported := tf.Import("linode_lke_cluster", state.Attr("id").(string))
+	require.Equal(t, state.Attr("label"), imported.Attr("label"))
+	require.Equal(t, state.Attr("pool.0.count"), imported.Attr("pool.0.count"))
+
+	tf.Destroy(state)
+	require.Empty(t, server.List("lke/clusters"))
+}
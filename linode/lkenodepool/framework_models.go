@@ -2,6 +2,7 @@ package lkenodepool
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -27,6 +28,8 @@ type NodePoolModel struct {
 	UpdateStrategy types.String              `tfsdk:"update_strategy"`
 	Label          types.String              `tfsdk:"label"`
 	FirewallID     types.Int64               `tfsdk:"firewall_id"`
+	HourlyCost     types.Float64             `tfsdk:"hourly_cost"`
+	MonthlyCost    types.Float64             `tfsdk:"monthly_cost"`
 }
 
 type NodePoolAutoscalerModel struct {
@@ -451,9 +454,45 @@ func (data *NodePoolModel) CopyFrom(other NodePoolModel, preserveKnown bool) {
 	data.UpdateStrategy = helper.KeepOrUpdateValue(data.UpdateStrategy, other.UpdateStrategy, preserveKnown)
 	data.Label = helper.KeepOrUpdateValue(data.Label, other.Label, preserveKnown)
 	data.FirewallID = helper.KeepOrUpdateValue(data.FirewallID, other.FirewallID, preserveKnown)
+	data.HourlyCost = helper.KeepOrUpdateValue(data.HourlyCost, other.HourlyCost, preserveKnown)
+	data.MonthlyCost = helper.KeepOrUpdateValue(data.MonthlyCost, other.MonthlyCost, preserveKnown)
 
 	if !preserveKnown {
 		data.Autoscaler = other.Autoscaler
 		data.Taints = other.Taints
 	}
 }
+
+// Cost returns a function computing the cost of the nodes in the Node Pool
+// from their type, count and the region of the cluster.
+func (data *NodePoolModel) Cost(ctx context.Context, client *linodego.Client) helper.CostFunc {
+	return func() (*helper.ResourceCost, error) {
+		if !helper.ValuesKnown(data.ClusterID, data.Type, data.Count) {
+			return nil, nil
+		}
+
+		clusterID, err := helper.SafeInt64ToInt(data.ClusterID.ValueInt64())
+		if err != nil {
+			return nil, err
+		}
+
+		count, err := helper.SafeInt64ToInt(data.Count.ValueInt64())
+		if err != nil {
+			return nil, err
+		}
+
+		cluster, err := client.GetLKECluster(ctx, clusterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get LKE cluster %d: %w", clusterID, err)
+		}
+
+		nodeCost, err := helper.GetLinodeTypeCost(ctx, client, data.Type.ValueString(), cluster.Region, false)
+		if err != nil {
+			return nil, err
+		}
+
+		cost := nodeCost.Multiply(count)
+
+		return &cost, nil
+	}
+}
@@ -29,6 +29,25 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan NodePoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper.PlanCost(ctx, req, resp, plan.Cost(ctx, r.Meta.Client))
+}
+
 func (r *Resource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
@@ -80,6 +99,8 @@ func (r *Resource) Read(
 		return
 	}
 
+	helper.SetCost(ctx, &data.HourlyCost, &data.MonthlyCost, data.Cost(ctx, client))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Read "+r.Config.Name+" done")
 }
@@ -150,6 +171,8 @@ func (r *Resource) Create(
 		return
 	}
 
+	helper.SetCost(ctx, &plan.HourlyCost, &plan.MonthlyCost, plan.Cost(ctx, client))
+
 	// IDs should always be overridden during creation (see #1085)
 	// TODO: Remove when Crossplane empty string ID issue is resolved
 	plan.ID = types.StringValue(strconv.Itoa(readyPool.ID))
@@ -220,6 +243,8 @@ func (r *Resource) Update(
 		plan.FlattenLKENodePool(ctx, readyPool, true, &resp.Diagnostics)
 	}
 
+	helper.SetCost(ctx, &plan.HourlyCost, &plan.MonthlyCost, plan.Cost(ctx, client))
+
 	plan.CopyFrom(state, true)
 
 	// Workaround for Crossplane issue where ID is not
@@ -134,6 +134,16 @@ var resourceSchema = schema.Schema{
 				),
 			},
 		},
+		"hourly_cost": schema.Float64Attribute{
+			Description: "The estimated hourly cost of the nodes in this Node Pool in US dollars, " +
+				"based on their type, count and the region of the cluster.",
+			Computed: true,
+		},
+		"monthly_cost": schema.Float64Attribute{
+			Description: "The estimated monthly cost of the nodes in this Node Pool in US dollars, " +
+				"based on their type, count and the region of the cluster.",
+			Computed: true,
+		},
 	},
 	Blocks: map[string]schema.Block{
 		"autoscaler": schema.ListNestedBlock{
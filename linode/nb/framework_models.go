@@ -30,8 +30,11 @@ type NodeBalancerModel struct {
 	Updated               timetypes.RFC3339 `tfsdk:"updated"`
 	Transfer              types.List        `tfsdk:"transfer"`
 	Tags                  types.Set         `tfsdk:"tags"`
+	TagsAll               types.Set         `tfsdk:"tags_all"`
 	Firewalls             types.List        `tfsdk:"firewalls"`
 	VPCs                  types.List        `tfsdk:"vpcs"`
+	HourlyCost            types.Float64     `tfsdk:"hourly_cost"`
+	MonthlyCost           types.Float64     `tfsdk:"monthly_cost"`
 }
 
 type FirewallModel struct {
@@ -146,8 +149,22 @@ func (data *NodeBalancerModel) CopyFrom(other NodeBalancerModel, preserveKnown b
 	data.Updated = helper.KeepOrUpdateValue(data.Updated, other.Updated, preserveKnown)
 	data.Transfer = helper.KeepOrUpdateValue(data.Transfer, other.Transfer, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.Firewalls = helper.KeepOrUpdateValue(data.Firewalls, other.Firewalls, preserveKnown)
 	data.VPCs = helper.KeepOrUpdateValue(data.VPCs, other.VPCs, preserveKnown)
+	data.HourlyCost = helper.KeepOrUpdateValue(data.HourlyCost, other.HourlyCost, preserveKnown)
+	data.MonthlyCost = helper.KeepOrUpdateValue(data.MonthlyCost, other.MonthlyCost, preserveKnown)
+}
+
+// Cost returns a function computing the cost of the NodeBalancer from its region.
+func (data *NodeBalancerModel) Cost(ctx context.Context, client *linodego.Client) helper.CostFunc {
+	return func() (*helper.ResourceCost, error) {
+		if !helper.ValuesKnown(data.Region) {
+			return nil, nil
+		}
+
+		return helper.GetNodeBalancerCost(ctx, client, data.Region.ValueString())
+	}
 }
 
 func parseNBFirewalls(
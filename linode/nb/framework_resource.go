@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -32,6 +33,39 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan NodeBalancerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper.FrameworkPlanTagsAll(ctx, req, resp, plan.Tags, r.Meta)
+	helper.PlanCost(ctx, req, resp, plan.Cost(ctx, r.Meta.Client))
+
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	helper.ValidateRegionRequirements(
+		ctx,
+		r.Meta.Client,
+		path.Root("region"),
+		plan.Region,
+		helper.RegionRequirements{Capabilities: []string{linodego.CapabilityNodeBalancers}},
+		&resp.Diagnostics,
+	)
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -90,10 +124,7 @@ func (r *Resource) Create(
 	}
 
 	if !data.Tags.IsNull() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &createOpts.Tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+		createOpts.Tags = helper.FrameworkTagsAll(data.Tags, r.Meta)
 	}
 
 	tflog.Debug(ctx, "client.CreateNodeBalancer(...)", map[string]any{
@@ -136,6 +167,12 @@ func (r *Resource) Create(
 		return
 	}
 
+	helper.FrameworkFlattenTags(
+		&data.Tags, &data.TagsAll, data.Tags, nodebalancer.Tags, r.Meta, true, &resp.Diagnostics,
+	)
+
+	helper.SetCost(ctx, &data.HourlyCost, &data.MonthlyCost, data.Cost(ctx, client))
+
 	// IDs should always be overridden during creation (see #1085)
 	// TODO: Remove when Crossplane empty string ID issue is resolved
 	data.ID = types.StringValue(strconv.Itoa(nodebalancer.ID))
@@ -208,11 +245,19 @@ func (r *Resource) Read(
 		return
 	}
 
+	priorTags := data.Tags
+
 	resp.Diagnostics.Append(data.Flatten(ctx, nodeBalancer, firewalls, vpcConfigs, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	helper.FrameworkFlattenTags(
+		&data.Tags, &data.TagsAll, priorTags, nodeBalancer.Tags, r.Meta, false, &resp.Diagnostics,
+	)
+
+	helper.SetCost(ctx, &data.HourlyCost, &data.MonthlyCost, data.Cost(ctx, client))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -242,7 +287,8 @@ func (r *Resource) Update(
 
 	isEqual := state.Label.Equal(plan.Label) &&
 		state.ClientConnThrottle.Equal(plan.ClientConnThrottle) &&
-		state.Tags.Equal(plan.Tags) && state.ClientUDPSessThrottle.Equal(plan.ClientUDPSessThrottle)
+		state.Tags.Equal(plan.Tags) && state.TagsAll.Equal(plan.TagsAll) &&
+		state.ClientUDPSessThrottle.Equal(plan.ClientUDPSessThrottle)
 
 	if !isEqual {
 		clientConnThrottle := helper.FrameworkSafeInt64ToInt(
@@ -264,10 +310,8 @@ func (r *Resource) Update(
 			ClientConnThrottle:    &clientConnThrottle,
 			ClientUDPSessThrottle: &clientUDPSessThrottle,
 		}
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &updateOpts.Tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+		tags := helper.FrameworkTagsAll(plan.Tags, r.Meta)
+		updateOpts.Tags = &tags
 
 		tflog.Debug(ctx, "client.UpdateNodeBalancer(...)", map[string]any{
 			"options": updateOpts,
@@ -307,6 +351,17 @@ func (r *Resource) Update(
 		resp.Diagnostics.Append(plan.Flatten(ctx, nodeBalancer, firewalls, vpcConfigs, true)...)
 	}
 
+	helper.FrameworkFlattenTags(
+		&plan.Tags,
+		&plan.TagsAll,
+		plan.Tags,
+		helper.FrameworkTagsAll(plan.Tags, r.Meta),
+		r.Meta,
+		true,
+		&resp.Diagnostics,
+	)
+	helper.SetCost(ctx, &plan.HourlyCost, &plan.MonthlyCost, plan.Cost(ctx, client))
+
 	plan.CopyFrom(state, true)
 
 	// Workaround for Crossplane issue where ID is not
@@ -173,6 +173,11 @@ var frameworkResourceSchema = schema.Schema{
 			},
 			Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
 		},
+		"tags_all": schema.SetAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "All tags applied to this object, including the default tags of the provider.",
+		},
 		"transfer": schema.ListAttribute{
 			Description: "Information about the amount of transfer this NodeBalancer has had so far this month.",
 			Computed:    true,
@@ -198,6 +203,14 @@ var frameworkResourceSchema = schema.Schema{
 			},
 			NestedObject: frameworkResourceSchemaVPCs,
 		},
+		"hourly_cost": schema.Float64Attribute{
+			Description: "The estimated hourly cost of the NodeBalancer in US dollars, based on its region.",
+			Computed:    true,
+		},
+		"monthly_cost": schema.Float64Attribute{
+			Description: "The estimated monthly cost of the NodeBalancer in US dollars, based on its region.",
+			Computed:    true,
+		},
 	},
 }
 
@@ -0,0 +1,85 @@
+//go:build unit
+
+package nb_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceNodeBalancer_fakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	nbConfig := map[string]any{
+		"label":                "fake-nodebalancer",
+		"region":               "us-east",
+		"client_conn_throttle": 20,
+		"tags":                 []any{"test"},
+	}
+
+	nb := tf.Apply("linode_nodebalancer", nil, nbConfig)
+	require.NotEmpty(t, nb.Attr("id"))
+	require.NotEmpty(t, nb.Attr("ipv4"))
+	require.Equal(t, float64(20), nb.Attr("client_conn_throttle"))
+
+	nbConfig["label"] = "fake-nodebalancer-renamed"
+	nbConfig["client_conn_throttle"] = 10
+
+	nb = tf.Apply("linode_nodebalancer", nb, nbConfig)
+	require.Equal(t, "fake-nodebalancer-renamed", nb.Attr("label"))
+	require.Equal(t, float64(10), nb.Attr("client_conn_throttle"))
+
+	nbID := nb.Attr("id").(string)
+
+	config := tf.Apply("linode_nodebalancer_config", nil, map[string]any{
+		"nodebalancer_id": parseID(t, nbID),
+		"port":            80,
+		"protocol":        "http",
+		"algorithm":       "roundrobin",
+	})
+	require.NotEmpty(t, config.Attr("id"))
+	require.Equal(t, "http", config.Attr("protocol"))
+
+	configID := config.Attr("id").(string)
+
+	node := tf.Apply("linode_nodebalancer_node", nil, map[string]any{
+		"nodebalancer_id": parseID(t, nbID),
+		"config_id":       parseID(t, configID),
+		"label":           "fake-node",
+		"address":         "192.168.200.1:80",
+		"weight":          50,
+	})
+	require.NotEmpty(t, node.Attr("id"))
+	require.Equal(t, float64(50), node.Attr("weight"))
+
+	nodesPath := fmt.Sprintf("nodebalancers/%s/configs/%s/nodes", nbID, configID)
+	require.Len(t, server.List(nodesPath), 1)
+
+	imported := tf.Import("linode_nodebalancer_node", fmt.Sprintf("%s,%s,%s", nbID, configID, node.Attr("id")))
+	require.Equal(t, node.Attr("address"), imported.Attr("address"))
+
+	imported = tf.Import("linode_nodebalancer_config", fmt.Sprintf("%s,%s", nbID, configID))
+	require.Equal(t, config.Attr("port"), imported.Attr("port"))
+
+	imported = tf.Import("linode_nodebalancer", nbID)
+	require.Equal(t, nb.Attr("label"), imported.Attr("label"))
+
+	tf.Destroy(node)
+	require.Empty(t, server.List(nodesPath))
+
+	tf.Destroy(config)
+	tf.Destroy(nb)
+	require.Empty(t, server.List("nodebalancers"))
+}
+
+func parseID(t *testing.T, id string) int {
+	result, err := strconv.Atoi(id)
+	require.NoError(t, err)
+
+	return result
+}
@@ -23,6 +23,12 @@ import (
 
 // Provider creates and manages the resources in a Linode configuration.
 func Provider() *schema.Provider {
+	return ProviderWithHTTPClientModifiers()
+}
+
+// ProviderWithHTTPClientModifiers creates a provider which applies the given
+// modifiers to the HTTP client of the configured Linode client.
+func ProviderWithHTTPClientModifiers(httpClientModifiers ...helper.HTTPClientModifier) *schema.Provider {
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
@@ -31,6 +37,15 @@ func Provider() *schema.Provider {
 				Sensitive:   true,
 				Description: "The token that allows you access to your Linode account",
 			},
+			"token_command": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"token"},
+				Description: "A command and its arguments to run to retrieve the token that allows you " +
+					"access to your Linode account.",
+			},
 			"config_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -62,6 +77,12 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "The path to a Linode API CA file to trust.",
 			},
+			"child_account_euuid": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The EUUID of a child account to manage. If set, the token of the parent account " +
+					"is exchanged for a proxy token scoped to the child account.",
+			},
 
 			"skip_instance_ready_poll": {
 				Type:        schema.TypeBool,
@@ -69,6 +90,14 @@ func Provider() *schema.Provider {
 				Description: "Skip waiting for a linode_instance resource to be running.",
 			},
 
+			"lish_private_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				Description: "The private SSH key used to read the console output of Linode instances through Lish. " +
+					"If set, the console output is included in errors for instances that fail to boot.",
+			},
+
 			"skip_instance_delete_poll": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -103,6 +132,16 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Maximum delay in milliseconds before retrying a request.",
 			},
+			"max_requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "The maximum sustained rate of API requests per second.",
+			},
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum number of API requests that can be in flight at the same time.",
+			},
 			"event_poll_ms": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -118,6 +157,57 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "The rate in milliseconds to poll for an LKE node to be ready.",
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A policy for retrying failed API requests in addition to the built-in retry conditions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_codes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(100, 599),
+							},
+							Description: "The response status codes to retry.",
+						},
+						"path_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "A regular expression matching the URL paths of the requests to retry.",
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The total number of attempts made for a matching request.",
+						},
+						"backoff_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The delay in milliseconds before the first retry, doubled on each subsequent retry.",
+						},
+					},
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Tags to apply to all taggable resources managed by this provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tags to apply to all taggable resources, in addition to their own tags.",
+						},
+					},
+				},
+			},
 			"obj_access_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -151,7 +241,6 @@ func Provider() *schema.Provider {
 			"linode_database_access_controls": databaseaccesscontrols.Resource(),
 			"linode_domain":                   domain.Resource(),
 			"linode_domain_record":            domainrecord.Resource(),
-			"linode_instance":                 instance.Resource(),
 			"linode_instance_config":          instanceconfig.Resource(),
 			"linode_lke_cluster":              lke.Resource(),
 			"linode_object_storage_bucket":    objbucket.Resource(),
@@ -166,7 +255,7 @@ func Provider() *schema.Provider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(ctx, d, terraformVersion)
+		return providerConfigure(ctx, d, terraformVersion, httpClientModifiers)
 	}
 	return provider
 }
@@ -178,6 +267,12 @@ func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnosti
 		config.AccessToken = os.Getenv("LINODE_TOKEN")
 	}
 
+	if v, ok := d.GetOk("token_command"); ok {
+		for _, arg := range v.([]any) {
+			config.TokenCommand = append(config.TokenCommand, arg.(string))
+		}
+	}
+
 	if v, ok := d.GetOk("api_version"); ok {
 		config.APIVersion = v.(string)
 	} else {
@@ -190,6 +285,18 @@ func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnosti
 		config.APICAPath = os.Getenv(linodego.APIHostCert)
 	}
 
+	if v, ok := d.GetOk("child_account_euuid"); ok {
+		config.ChildAccountEUUID = v.(string)
+	} else {
+		config.ChildAccountEUUID = os.Getenv("LINODE_CHILD_ACCOUNT_EUUID")
+	}
+
+	if v, ok := d.GetOk("lish_private_key"); ok {
+		config.LishPrivateKey = v.(string)
+	} else {
+		config.LishPrivateKey = os.Getenv("LINODE_LISH_PRIVATE_KEY")
+	}
+
 	if v, ok := d.GetOk("config_path"); ok {
 		config.ConfigPath = v.(string)
 	} else {
@@ -243,6 +350,28 @@ func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnosti
 		config.LKENodeReadyPollMilliseconds = 3000
 	}
 
+	for _, v := range d.Get("retry").([]any) {
+		policy := v.(map[string]any)
+
+		retryPolicy := helper.RetryPolicy{
+			PathRegex:           policy["path_regex"].(string),
+			MaxAttempts:         policy["max_attempts"].(int),
+			BackoffMilliseconds: policy["backoff_ms"].(int),
+		}
+
+		for _, statusCode := range policy["status_codes"].([]any) {
+			retryPolicy.StatusCodes = append(retryPolicy.StatusCodes, statusCode.(int))
+		}
+
+		config.RetryPolicies = append(config.RetryPolicies, retryPolicy)
+	}
+
+	for _, v := range d.Get("default_tags").([]any) {
+		if defaultTags, ok := v.(map[string]any); ok {
+			config.DefaultTags = helper.ExpandStringSet(defaultTags["tags"].(*schema.Set))
+		}
+	}
+
 	if v, ok := d.GetOk("obj_access_key"); ok {
 		config.ObjAccessKey = v.(string)
 	} else {
@@ -259,7 +388,10 @@ func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnosti
 }
 
 func providerConfigure(
-	ctx context.Context, d *schema.ResourceData, terraformVersion string,
+	ctx context.Context,
+	d *schema.ResourceData,
+	terraformVersion string,
+	httpClientModifiers []helper.HTTPClientModifier,
 ) (any, diag.Diagnostics) {
 	config := &helper.Config{
 		SkipInstanceReadyPoll:    d.Get("skip_instance_ready_poll").(bool),
@@ -272,8 +404,13 @@ func providerConfigure(
 		MinRetryDelayMilliseconds: d.Get("min_retry_delay_ms").(int),
 		MaxRetryDelayMilliseconds: d.Get("max_retry_delay_ms").(int),
 
+		MaxRequestsPerSecond:  d.Get("max_requests_per_second").(float64),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+
 		ObjUseTempKeys:       d.Get("obj_use_temp_keys").(bool),
 		ObjBucketForceDelete: d.Get("obj_bucket_force_delete").(bool),
+
+		HTTPClientModifiers: httpClientModifiers,
 	}
 
 	handleDefault(config, d)
@@ -6,7 +6,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
 	"github.com/linode/terraform-provider-linode/v3/linode/sshkey/tmpl"
@@ -15,17 +14,19 @@ import (
 func TestAccDataSourceSSHKey_basic(t *testing.T) {
 	t.Parallel()
 
-	label := acctest.RandomWithPrefix("tf_test")
+	rec := acceptance.NewRecorder(t)
+
+	label := rec.RandomWithPrefix("tf_test")
 	// resourceName := "data.linode_sshkey.foobar"
 
 	// TODO(ellisbenjamin) -- This test passes only because of the Destroy: true statement and needs attention.
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acceptance.PreCheck(t) },
-		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
-		CheckDestroy:             checkSSHKeyDestroy,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactoriesFor(t),
+		CheckDestroy:             checkSSHKeyDestroy(acceptance.GetTestClientFor(t)),
 		Steps: []resource.TestStep{
 			{
-				Config:  tmpl.Basic(t, label, acceptance.PublicKeyMaterial),
+				Config:  tmpl.Basic(t, label, recordedPublicKey(rec)),
 				Destroy: true,
 			},
 			// {
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
-	"github.com/linode/terraform-provider-linode/v3/linode/helper"
 	"github.com/linode/terraform-provider-linode/v3/linode/sshkey/tmpl"
 )
 
@@ -51,20 +50,24 @@ func sweep(prefix string) error {
 func TestAccResourceSSHKey_basic(t *testing.T) {
 	t.Parallel()
 
+	rec := acceptance.NewRecorder(t)
+
 	resName := "linode_sshkey.foobar"
-	sshkeyName := acctest.RandomWithPrefix("tf_test")
+	sshkeyName := rec.RandomWithPrefix("tf_test")
+	publicKey := recordedPublicKey(rec)
+	client := acceptance.GetTestClientFor(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acceptance.PreCheck(t) },
-		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
-		CheckDestroy:             checkSSHKeyDestroy,
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactoriesFor(t),
+		CheckDestroy:             checkSSHKeyDestroy(client),
 		Steps: []resource.TestStep{
 			{
-				Config: tmpl.Basic(t, sshkeyName, acceptance.PublicKeyMaterial),
+				Config: tmpl.Basic(t, sshkeyName, publicKey),
 				Check: resource.ComposeTestCheckFunc(
-					checkSSHKeyExists,
+					checkSSHKeyExists(client),
 					resource.TestCheckResourceAttr(resName, "label", sshkeyName),
-					resource.TestCheckResourceAttr(resName, "ssh_key", acceptance.PublicKeyMaterial),
+					resource.TestCheckResourceAttr(resName, "ssh_key", publicKey),
 					resource.TestCheckResourceAttrSet(resName, "created"),
 				),
 			},
@@ -80,19 +83,22 @@ func TestAccResourceSSHKey_basic(t *testing.T) {
 
 func TestAccResourceSSHKey_space_in_label(t *testing.T) {
 	t.Parallel()
+	skipWithoutRecording(t)
 
 	resName := "linode_sshkey.foobar"
 	sshkeyName := acctest.RandomWithPrefix("tf_test") + " "
+	publicKey := acceptance.PublicKeyMaterial
+	client := getLiveTestClient(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acceptance.PreCheck(t) },
 		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
-		CheckDestroy:             checkSSHKeyDestroy,
+		CheckDestroy:             checkSSHKeyDestroy(client),
 		Steps: []resource.TestStep{
 			{
-				Config: tmpl.Basic(t, sshkeyName, acceptance.PublicKeyMaterial),
+				Config: tmpl.Basic(t, sshkeyName, publicKey),
 				Check: resource.ComposeTestCheckFunc(
-					checkSSHKeyExists,
+					checkSSHKeyExists(client),
 					resource.TestCheckResourceAttr(resName, "label", sshkeyName),
 				),
 			},
@@ -107,29 +113,33 @@ func TestAccResourceSSHKey_space_in_label(t *testing.T) {
 
 func TestAccResourceSSHKey_update(t *testing.T) {
 	t.Parallel()
+	skipWithoutRecording(t)
+
 	resName := "linode_sshkey.foobar"
 	sshkeyName := acctest.RandomWithPrefix("tf_test")
+	publicKey := acceptance.PublicKeyMaterial
+	client := getLiveTestClient(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acceptance.PreCheck(t) },
 		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
-		CheckDestroy:             checkSSHKeyDestroy,
+		CheckDestroy:             checkSSHKeyDestroy(client),
 		Steps: []resource.TestStep{
 			{
-				Config: tmpl.Basic(t, sshkeyName, acceptance.PublicKeyMaterial),
+				Config: tmpl.Basic(t, sshkeyName, publicKey),
 				Check: resource.ComposeTestCheckFunc(
-					checkSSHKeyExists,
+					checkSSHKeyExists(client),
 					resource.TestCheckResourceAttr(resName, "label", sshkeyName),
-					resource.TestCheckResourceAttr(resName, "ssh_key", acceptance.PublicKeyMaterial),
+					resource.TestCheckResourceAttr(resName, "ssh_key", publicKey),
 					resource.TestCheckResourceAttrSet(resName, "created"),
 				),
 			},
 			{
-				Config: tmpl.Updates(t, sshkeyName, acceptance.PublicKeyMaterial),
+				Config: tmpl.Updates(t, sshkeyName, publicKey),
 				Check: resource.ComposeTestCheckFunc(
-					checkSSHKeyExists,
+					checkSSHKeyExists(client),
 					resource.TestCheckResourceAttr(resName, "label", fmt.Sprintf("%s_renamed", sshkeyName)),
-					resource.TestCheckResourceAttr(resName, "ssh_key", acceptance.PublicKeyMaterial),
+					resource.TestCheckResourceAttr(resName, "ssh_key", publicKey),
 					resource.TestCheckResourceAttrSet(resName, "created"),
 				),
 			},
@@ -142,53 +152,84 @@ func TestAccResourceSSHKey_update(t *testing.T) {
 	})
 }
 
-func checkSSHKeyExists(s *terraform.State) error {
-	client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
+// skipWithoutRecording skips a test which has no recording yet in replay mode.
+// Such tests only run against the live API, without being recorded.
+func skipWithoutRecording(t *testing.T) {
+	t.Helper()
 
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "linode_sshkey" {
-			continue
-		}
+	if acceptance.GetRecorderMode() == acceptance.RecorderModeReplay {
+		t.Skip("skipping live-only test without a recording in replay mode")
+	}
+}
 
-		id, err := strconv.Atoi(rs.Primary.ID)
-		if err != nil {
-			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
-		}
+// getLiveTestClient returns a client for the live API, bypassing the recorder.
+func getLiveTestClient(t *testing.T) *linodego.Client {
+	t.Helper()
 
-		_, err = client.GetSSHKey(context.Background(), id)
-		if err != nil {
-			return fmt.Errorf("Error retrieving state of SSHKey %s: %s", rs.Primary.Attributes["label"], err)
-		}
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		t.Fatalf("failed to get client: %s", err)
 	}
 
-	return nil
+	return client
 }
 
-func checkSSHKeyDestroy(s *terraform.State) error {
-	client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "linode_sshkey" {
-			continue
-		}
+// recordedPublicKey returns the public key used by a test, which is generated
+// at random for each test run and must therefore be preserved in its recording.
+func recordedPublicKey(rec *acceptance.Recorder) string {
+	return rec.Value("public_key", func() (string, error) {
+		return acceptance.PublicKeyMaterial, nil
+	})
+}
 
-		id, err := strconv.Atoi(rs.Primary.ID)
-		if err != nil {
-			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
-		}
-		if id == 0 {
-			return fmt.Errorf("Would have considered %v as %d", rs.Primary.ID, id)
+func checkSSHKeyExists(client *linodego.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "linode_sshkey" {
+				continue
+			}
+
+			id, err := strconv.Atoi(rs.Primary.ID)
+			if err != nil {
+				return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+			}
+
+			_, err = client.GetSSHKey(context.Background(), id)
+			if err != nil {
+				return fmt.Errorf("Error retrieving state of SSHKey %s: %s", rs.Primary.Attributes["label"], err)
+			}
 		}
 
-		_, err = client.GetSSHKey(context.Background(), id)
+		return nil
+	}
+}
 
-		if err == nil {
-			return fmt.Errorf("Linode SSH Key with id %d still exists", id)
+func checkSSHKeyDestroy(client *linodego.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "linode_sshkey" {
+				continue
+			}
+
+			id, err := strconv.Atoi(rs.Primary.ID)
+			if err != nil {
+				return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+			}
+			if id == 0 {
+				return fmt.Errorf("Would have considered %v as %d", rs.Primary.ID, id)
+			}
+
+			_, err = client.GetSSHKey(context.Background(), id)
+
+			if err == nil {
+				return fmt.Errorf("Linode SSH Key with id %d still exists", id)
+			}
+
+			if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+				return fmt.Errorf("Error requesting Linode SSH Key with id %d", id)
+			}
 		}
 
-		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
-			return fmt.Errorf("Error requesting Linode SSH Key with id %d", id)
-		}
+		return nil
 	}
-
-	return nil
 }
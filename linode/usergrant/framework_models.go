@@ -0,0 +1,132 @@
+package usergrant
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+var validEntityTypes = []string{
+	"domain", "firewall", "image", "linode", "longview",
+	"nodebalancer", "stackscript", "volume", "vpc",
+}
+
+type ResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	EntityType  types.String `tfsdk:"entity_type"`
+	EntityID    types.Int64  `tfsdk:"entity_id"`
+	Permissions types.String `tfsdk:"permissions"`
+}
+
+func (m *ResourceModel) Flatten(grant linodego.GrantedEntity, preserveKnown bool) {
+	m.ID = helper.KeepOrUpdateString(
+		m.ID,
+		buildID(m.Username.ValueString(), m.EntityType.ValueString(), grant.ID),
+		preserveKnown,
+	)
+	m.EntityID = helper.KeepOrUpdateInt64(m.EntityID, int64(grant.ID), preserveKnown)
+	m.Permissions = helper.KeepOrUpdateString(m.Permissions, string(grant.Permissions), preserveKnown)
+}
+
+func (m *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	m.ID = helper.KeepOrUpdateValue(m.ID, other.ID, preserveKnown)
+	m.Username = helper.KeepOrUpdateValue(m.Username, other.Username, preserveKnown)
+	m.EntityType = helper.KeepOrUpdateValue(m.EntityType, other.EntityType, preserveKnown)
+	m.EntityID = helper.KeepOrUpdateValue(m.EntityID, other.EntityID, preserveKnown)
+	m.Permissions = helper.KeepOrUpdateValue(m.Permissions, other.Permissions, preserveKnown)
+}
+
+func buildID(username, entityType string, entityID int) string {
+	return fmt.Sprintf("%s:%s:%d", username, entityType, entityID)
+}
+
+// findEntityGrant returns the grant of the given entity in the user's grants,
+// or nil if the entity doesn't exist or the user has no access to it.
+func findEntityGrant(
+	grants *linodego.UserGrants,
+	entityType string,
+	entityID int,
+) (*linodego.GrantedEntity, error) {
+	var entities []linodego.GrantedEntity
+
+	switch entityType {
+	case "domain":
+		entities = grants.Domain
+	case "firewall":
+		entities = grants.Firewall
+	case "image":
+		entities = grants.Image
+	case "linode":
+		entities = grants.Linode
+	case "longview":
+		entities = grants.Longview
+	case "nodebalancer":
+		entities = grants.NodeBalancer
+	case "stackscript":
+		entities = grants.StackScript
+	case "volume":
+		entities = grants.Volume
+	case "vpc":
+		entities = grants.VPC
+	default:
+		return nil, fmt.Errorf("invalid entity type: %s", entityType)
+	}
+
+	for _, entity := range entities {
+		if entity.ID == entityID && entity.Permissions != "" {
+			return &entity, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// buildGrantUpdateOptions returns update options that only set the permissions
+// of a single entity, leaving all other entity grants untouched. The global grants
+// are always sent by the API client, so the current values are passed through.
+// A nil permissions level revokes the user's access to the entity.
+func buildGrantUpdateOptions(
+	current *linodego.UserGrants,
+	entityType string,
+	entityID int,
+	permissions *linodego.GrantPermissionLevel,
+) (linodego.UserGrantsUpdateOptions, error) {
+	opts := linodego.UserGrantsUpdateOptions{
+		Global: current.Global,
+	}
+
+	grant := []linodego.EntityUserGrant{
+		{
+			ID:          entityID,
+			Permissions: permissions,
+		},
+	}
+
+	switch entityType {
+	case "domain":
+		opts.Domain = grant
+	case "firewall":
+		opts.Firewall = grant
+	case "image":
+		opts.Image = grant
+	case "linode":
+		opts.Linode = grant
+	case "longview":
+		opts.Longview = grant
+	case "nodebalancer":
+		opts.NodeBalancer = grant
+	case "stackscript":
+		opts.StackScript = grant
+	case "volume":
+		opts.Volume = grant
+	case "vpc":
+		opts.VPC = grant
+	default:
+		return opts, fmt.Errorf("invalid entity type: %s", entityType)
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,66 @@
+//go:build unit
+
+package usergrant
+
+import (
+	"testing"
+
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindEntityGrant(t *testing.T) {
+	grants := &linodego.UserGrants{
+		Linode: []linodego.GrantedEntity{
+			{ID: 123, Label: "granted", Permissions: linodego.AccessLevelReadOnly},
+			{ID: 456, Label: "not-granted"},
+		},
+	}
+
+	grant, err := findEntityGrant(grants, "linode", 123)
+	require.NoError(t, err)
+	require.NotNil(t, grant)
+	require.Equal(t, linodego.AccessLevelReadOnly, grant.Permissions)
+
+	// Entities without permissions are not granted
+	grant, err = findEntityGrant(grants, "linode", 456)
+	require.NoError(t, err)
+	require.Nil(t, grant)
+
+	grant, err = findEntityGrant(grants, "volume", 123)
+	require.NoError(t, err)
+	require.Nil(t, grant)
+
+	_, err = findEntityGrant(grants, "invalid", 123)
+	require.Error(t, err)
+}
+
+func TestBuildGrantUpdateOptions(t *testing.T) {
+	accountAccess := linodego.AccessLevelReadOnly
+	current := &linodego.UserGrants{
+		Global: linodego.GlobalUserGrants{
+			AccountAccess: &accountAccess,
+			AddLinodes:    true,
+		},
+		Domain: []linodego.GrantedEntity{
+			{ID: 1, Permissions: linodego.AccessLevelReadWrite},
+		},
+	}
+
+	permissions := linodego.AccessLevelReadWrite
+
+	opts, err := buildGrantUpdateOptions(current, "volume", 789, &permissions)
+	require.NoError(t, err)
+
+	// Global grants are passed through and other entities are left untouched
+	require.Equal(t, current.Global, opts.Global)
+	require.Empty(t, opts.Domain)
+	require.Equal(t, []linodego.EntityUserGrant{{ID: 789, Permissions: &permissions}}, opts.Volume)
+
+	opts, err = buildGrantUpdateOptions(current, "domain", 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []linodego.EntityUserGrant{{ID: 1}}, opts.Domain)
+
+	_, err = buildGrantUpdateOptions(current, "invalid", 1, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,313 @@
+package usergrant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+)
+
+// userLocks serializes grant updates for the same user so grants
+// managed by different resources don't overwrite each other.
+var userLocks helper.KeyedMutex
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_user_grant",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setGrant(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	entityID := helper.FrameworkSafeInt64ToInt(plan.EntityID.ValueInt64(), &resp.Diagnostics)
+	plan.ID = types.StringValue(buildID(plan.Username.ValueString(), plan.EntityType.ValueString(), entityID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	entityID := helper.FrameworkSafeInt64ToInt(state.EntityID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+	entityType := state.EntityType.ValueString()
+	ctx = populateLogAttributes(ctx, username, entityType, entityID)
+
+	tflog.Trace(ctx, "client.GetUserGrants(...)")
+
+	grants, err := r.Meta.Client.GetUserGrants(ctx, username)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"User No Longer Exists",
+				fmt.Sprintf(
+					"Removing grant %s from state because the user no longer exists",
+					state.ID.ValueString(),
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get grants for user %s", username),
+			err.Error(),
+		)
+		return
+	}
+
+	grant, err := findEntityGrant(grants, entityType, entityID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find entity grant", err.Error())
+		return
+	}
+
+	if grant == nil {
+		resp.Diagnostics.AddWarning(
+			"Grant No Longer Exists",
+			fmt.Sprintf(
+				"Removing grant %s from state because the user no longer has access to %s %d",
+				state.ID.ValueString(),
+				entityType,
+				entityID,
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Flatten(*grant, false)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Permissions.Equal(plan.Permissions) {
+		r.setGrant(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.CopyFrom(state, true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityID := helper.FrameworkSafeInt64ToInt(state.EntityID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+	entityType := state.EntityType.ValueString()
+	ctx = populateLogAttributes(ctx, username, entityType, entityID)
+
+	if err := updateEntityGrant(ctx, r.Meta.Client, username, entityType, entityID, nil); err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"User No Longer Exists",
+				fmt.Sprintf("User %s was not found; assuming its grants were removed.", username),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to revoke grant for %s %d from user %s", entityType, entityID, username),
+			err.Error(),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+
+	helper.ImportStateWithMultipleIDs(
+		ctx,
+		req,
+		resp,
+		[]helper.ImportableID{
+			{
+				Name:          "username",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+			{
+				Name:          "entity_type",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+			{
+				Name:          "entity_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+		},
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// We need to manually set the ID in state
+	// because it is not implicitly populated by one of the
+	// ID attributes above
+	var state ResourceModel
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityID := helper.FrameworkSafeInt64ToInt(state.EntityID.ValueInt64(), &resp.Diagnostics)
+	state.ID = types.StringValue(buildID(state.Username.ValueString(), state.EntityType.ValueString(), entityID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// setGrant applies the planned permissions of the given grant.
+func (r *Resource) setGrant(ctx context.Context, plan *ResourceModel, diags *diag.Diagnostics) {
+	entityID := helper.FrameworkSafeInt64ToInt(plan.EntityID.ValueInt64(), diags)
+	if diags.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+	entityType := plan.EntityType.ValueString()
+	ctx = populateLogAttributes(ctx, username, entityType, entityID)
+
+	permissions := linodego.GrantPermissionLevel(plan.Permissions.ValueString())
+
+	if err := updateEntityGrant(ctx, r.Meta.Client, username, entityType, entityID, &permissions); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to grant access to %s %d for user %s", entityType, entityID, username),
+			err.Error(),
+		)
+	}
+}
+
+// updateEntityGrant sets the permissions of a single entity grant of the given user
+// without modifying any other entity grants.
+func updateEntityGrant(
+	ctx context.Context,
+	client *linodego.Client,
+	username, entityType string,
+	entityID int,
+	permissions *linodego.GrantPermissionLevel,
+) error {
+	unlock := userLocks.Lock(username)
+	defer unlock()
+
+	// Always read the current grants while holding the lock
+	// so global grants changed elsewhere are preserved.
+	tflog.Trace(ctx, "client.GetUserGrants(...)")
+
+	current, err := client.GetUserGrants(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	updateOpts, err := buildGrantUpdateOptions(current, entityType, entityID, permissions)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "client.UpdateUserGrants(...)", map[string]any{
+		"options": updateOpts,
+	})
+
+	if _, err := client.UpdateUserGrants(ctx, username, updateOpts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func populateLogAttributes(ctx context.Context, username, entityType string, entityID int) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"username":    username,
+		"entity_type": entityType,
+		"entity_id":   entityID,
+	})
+}
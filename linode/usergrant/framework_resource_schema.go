@@ -0,0 +1,57 @@
+package usergrant
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/linode/linodego"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique ID of this grant.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"username": schema.StringAttribute{
+			Description: "The username of the restricted user to grant access to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"entity_type": schema.StringAttribute{
+			Description: "The type of the entity to grant access to.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(validEntityTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"entity_id": schema.Int64Attribute{
+			Description: "The ID of the entity to grant access to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"permissions": schema.StringAttribute{
+			Description: "The level of access the user has to the entity.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(linodego.AccessLevelReadOnly),
+					string(linodego.AccessLevelReadWrite),
+				),
+			},
+		},
+	},
+}
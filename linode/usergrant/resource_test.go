@@ -0,0 +1,107 @@
+//go:build integration || usergrant
+
+package usergrant_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v3/linode/helper"
+	"github.com/linode/terraform-provider-linode/v3/linode/usergrant/tmpl"
+)
+
+const testGrantResName = "linode_user_grant.test"
+
+func TestAccResourceUserGrant_basic(t *testing.T) {
+	t.Parallel()
+
+	data := tmpl.TemplateData{
+		Username:    acctest.RandomWithPrefix("tf-test"),
+		Label:       acctest.RandomWithPrefix("tf-test"),
+		Permissions: "read_only",
+	}
+
+	updatedData := data
+	updatedData.Permissions = "read_write"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV6ProviderFactories: acceptance.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, data),
+				Check: resource.ComposeTestCheckFunc(
+					checkDomainGrant(testGrantResName, "read_only"),
+					resource.TestCheckResourceAttr(testGrantResName, "username", data.Username),
+					resource.TestCheckResourceAttr(testGrantResName, "entity_type", "domain"),
+					resource.TestCheckResourceAttrSet(testGrantResName, "entity_id"),
+					resource.TestCheckResourceAttr(testGrantResName, "permissions", "read_only"),
+				),
+			},
+			{
+				Config: tmpl.Basic(t, updatedData),
+				Check: resource.ComposeTestCheckFunc(
+					checkDomainGrant(testGrantResName, "read_write"),
+					resource.TestCheckResourceAttr(testGrantResName, "permissions", "read_write"),
+				),
+			},
+			{
+				ResourceName:      testGrantResName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[testGrantResName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", testGrantResName)
+					}
+
+					return fmt.Sprintf(
+						"%s,%s,%s",
+						rs.Primary.Attributes["username"],
+						rs.Primary.Attributes["entity_type"],
+						rs.Primary.Attributes["entity_id"],
+					), nil
+				},
+			},
+		},
+	})
+}
+
+func checkDomainGrant(name, permissions string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccSDKv2Provider.Meta().(*helper.ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		entityID, err := strconv.Atoi(rs.Primary.Attributes["entity_id"])
+		if err != nil {
+			return err
+		}
+
+		grants, err := client.GetUserGrants(context.Background(), rs.Primary.Attributes["username"])
+		if err != nil {
+			return err
+		}
+
+		for _, grant := range grants.Domain {
+			if grant.ID == entityID {
+				if string(grant.Permissions) != permissions {
+					return fmt.Errorf("expected permissions %q, got %q", permissions, grant.Permissions)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("grant for domain %d not found", entityID)
+	}
+}
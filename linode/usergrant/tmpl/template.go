@@ -0,0 +1,18 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/acceptance"
+)
+
+type TemplateData struct {
+	Username    string
+	Label       string
+	Permissions string
+}
+
+func Basic(t testing.TB, data TemplateData) string {
+	return acceptance.ExecuteTemplate(t,
+		"user_grant_basic", data)
+}
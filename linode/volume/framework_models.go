@@ -78,10 +78,13 @@ type VolumeResourceModel struct {
 	LinodeID       types.Int64    `tfsdk:"linode_id"`
 	FilesystemPath types.String   `tfsdk:"filesystem_path"`
 	Tags           types.Set      `tfsdk:"tags"`
+	TagsAll        types.Set      `tfsdk:"tags_all"`
 	Status         types.String   `tfsdk:"status"`
 	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 	Encryption     types.String   `tfsdk:"encryption"`
 	IOReady        types.Bool     `tfsdk:"io_ready"`
+	HourlyCost     types.Float64  `tfsdk:"hourly_cost"`
+	MonthlyCost    types.Float64  `tfsdk:"monthly_cost"`
 }
 
 func (data *VolumeResourceModel) FlattenVolume(volume *linodego.Volume, preserveKnown bool) diag.Diagnostics {
@@ -137,6 +140,20 @@ func (data *VolumeResourceModel) CopyFrom(other VolumeResourceModel, preserveKno
 	data.LinodeID = helper.KeepOrUpdateValue(data.LinodeID, other.LinodeID, preserveKnown)
 	data.FilesystemPath = helper.KeepOrUpdateValue(data.FilesystemPath, other.FilesystemPath, preserveKnown)
 	data.Tags = helper.KeepOrUpdateValue(data.Tags, other.Tags, preserveKnown)
+	data.TagsAll = helper.KeepOrUpdateValue(data.TagsAll, other.TagsAll, preserveKnown)
 	data.Status = helper.KeepOrUpdateValue(data.Status, other.Status, preserveKnown)
+	data.HourlyCost = helper.KeepOrUpdateValue(data.HourlyCost, other.HourlyCost, preserveKnown)
+	data.MonthlyCost = helper.KeepOrUpdateValue(data.MonthlyCost, other.MonthlyCost, preserveKnown)
 	data.Timeouts = helper.KeepOrUpdateValue(data.Timeouts, other.Timeouts, preserveKnown)
 }
+
+// Cost returns a function computing the cost of the Volume from its region and size.
+func (data *VolumeResourceModel) Cost(ctx context.Context, client *linodego.Client) helper.CostFunc {
+	return func() (*helper.ResourceCost, error) {
+		if !helper.ValuesKnown(data.Region, data.Size) {
+			return nil, nil
+		}
+
+		return helper.GetVolumeCost(ctx, client, data.Region.ValueString(), int(data.Size.ValueInt64()))
+	}
+}
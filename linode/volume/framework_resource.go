@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -42,6 +43,42 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var plan VolumeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper.FrameworkPlanTagsAll(ctx, req, resp, plan.Tags, r.Meta)
+	helper.PlanCost(ctx, req, resp, plan.Cost(ctx, r.Meta.Client))
+
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	requirements := helper.RegionRequirements{
+		Capabilities: []string{linodego.CapabilityBlockStorage},
+	}
+
+	if plan.Encryption.ValueString() == "enabled" {
+		requirements.Require(linodego.CapabilityBlockStorageEncryption)
+	}
+
+	helper.ValidateRegionRequirements(
+		ctx, r.Meta.Client, path.Root("region"), plan.Region, requirements, &resp.Diagnostics,
+	)
+}
+
 func cloneCheck(data *VolumeResourceModel, sourceVolume *linodego.Volume, diags *diag.Diagnostics) {
 	if sourceVolume == nil {
 		diags.AddError(
@@ -131,10 +168,8 @@ func (r *Resource) CreateVolumeFromSource(
 	var updateOpts linodego.VolumeUpdateOptions
 
 	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		diags.Append(data.Tags.ElementsAs(ctx, &updateOpts.Tags, false)...)
-		if diags.HasError() {
-			return clonedVolume
-		}
+		tags := helper.FrameworkTagsAll(data.Tags, r.Meta)
+		updateOpts.Tags = &tags
 
 		tflog.Debug(ctx, "client.UpdateVolume(...)", map[string]any{
 			"options": updateOpts,
@@ -229,10 +264,7 @@ func (r *Resource) CreateVolume(
 		createOpts.Encryption = "enabled"
 	}
 
-	diags.Append(data.Tags.ElementsAs(ctx, &createOpts.Tags, false)...)
-	if diags.HasError() {
-		return nil
-	}
+	createOpts.Tags = helper.FrameworkTagsAll(data.Tags, r.Meta)
 
 	if !data.LinodeID.IsNull() && !data.LinodeID.IsUnknown() {
 		linodeID := helper.FrameworkSafeInt64ToInt(data.LinodeID.ValueInt64(), diags)
@@ -307,6 +339,10 @@ func (r *Resource) Create(
 		// We should always set the created resource into state even if there is an error
 		// to prevent untracked resources created on the cloud
 		plan.FlattenVolume(volume, true)
+		helper.FrameworkFlattenTags(
+			&plan.Tags, &plan.TagsAll, plan.Tags, volume.Tags, r.Meta, true, &resp.Diagnostics,
+		)
+		helper.SetCost(ctx, &plan.HourlyCost, &plan.MonthlyCost, plan.Cost(ctx, r.Meta.Client))
 
 		// IDs should always be overridden during creation (see #1085)
 		// TODO: Remove when Crossplane empty string ID issue is resolved
@@ -358,10 +394,19 @@ func (r *Resource) Read(
 		return
 	}
 
+	priorTags := state.Tags
+
 	resp.Diagnostics.Append(state.FlattenVolume(volume, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	helper.FrameworkFlattenTags(
+		&state.Tags, &state.TagsAll, priorTags, volume.Tags, r.Meta, false, &resp.Diagnostics,
+	)
+
+	helper.SetCost(ctx, &state.HourlyCost, &state.MonthlyCost, state.Cost(ctx, client))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -457,12 +502,10 @@ func (r *Resource) Update(
 	}
 	doUpdate := false
 
-	if !state.Tags.Equal(plan.Tags) {
+	if !state.Tags.Equal(plan.Tags) || !state.TagsAll.Equal(plan.TagsAll) {
 		doUpdate = true
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &updateOpts.Tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+		tags := helper.FrameworkTagsAll(plan.Tags, r.Meta)
+		updateOpts.Tags = &tags
 	}
 
 	if !state.Label.Equal(plan.Label) {
@@ -550,6 +593,17 @@ func (r *Resource) Update(
 		}
 	}
 
+	helper.FrameworkFlattenTags(
+		&plan.Tags,
+		&plan.TagsAll,
+		plan.Tags,
+		helper.FrameworkTagsAll(plan.Tags, r.Meta),
+		r.Meta,
+		true,
+		&resp.Diagnostics,
+	)
+	helper.SetCost(ctx, &plan.HourlyCost, &plan.MonthlyCost, plan.Cost(ctx, client))
+
 	plan.CopyFrom(state, true)
 
 	// Workaround for Crossplane issue where ID is not
@@ -0,0 +1,50 @@
+//go:build unit
+
+package volume_test
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func volumeConfig(size int) map[string]any {
+	return map[string]any{
+		"label":  "fake-volume",
+		"region": "us-east",
+		"size":   size,
+		"tags":   []any{"test"},
+	}
+}
+
+func TestResourceVolume_fakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	state := tf.Apply("linode_volume", nil, volumeConfig(20))
+	require.NotEmpty(t, state.Attr("id"))
+	require.Equal(t, "active", state.Attr("status"))
+	require.Equal(t, float64(20), state.Attr("size"))
+	require.Equal(t, 0.003, state.Attr("hourly_cost"))
+	require.Equal(t, float64(2), state.Attr("monthly_cost"))
+	require.Equal(t, "/dev/disk/by-id/scsi-0Linode_Volume_fake-volume", state.Attr("filesystem_path"))
+
+	state = tf.Apply("linode_volume", state, volumeConfig(30))
+	require.Equal(t, "active", state.Attr("status"))
+	require.Equal(t, float64(30), state.Attr("size"))
+	require.Equal(t, 0.0045, state.Attr("hourly_cost"))
+	require.Equal(t, float64(3), state.Attr("monthly_cost"))
+
+	volume, ok := server.Get("volumes/" + state.Attr("id").(string))
+	require.True(t, ok)
+	require.Equal(t, float64(30), volume["size"])
+
+	imported := tf.Import("linode_volume", state.Attr("id").(string))
+	require.Equal(t, state.Attr("label"), imported.Attr("label"))
+	require.Equal(t, state.Attr("size"), imported.Attr("size"))
+	require.Equal(t, state.Attr("tags"), imported.Attr("tags"))
+
+	tf.Destroy(state)
+	require.Empty(t, server.List("volumes"))
+}
@@ -119,6 +119,11 @@ var frameworkResourceSchema = schema.Schema{
 			},
 			Default: helper.EmptySetDefault(types.StringType),
 		},
+		"tags_all": schema.SetAttribute{
+			Description: "All tags applied to this object, including the default tags of the provider.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
 		"encryption": schema.StringAttribute{
 			Description: "Whether Block Storage Disk Encryption is enabled or disabled on this Volume. ",
 			Optional:    true,
@@ -132,5 +137,13 @@ var frameworkResourceSchema = schema.Schema{
 				stringplanmodifier.RequiresReplace(),
 			},
 		},
+		"hourly_cost": schema.Float64Attribute{
+			Description: "The estimated hourly cost of the Volume in US dollars, based on its size and region.",
+			Computed:    true,
+		},
+		"monthly_cost": schema.Float64Attribute{
+			Description: "The estimated monthly cost of the Volume in US dollars, based on its size and region.",
+			Computed:    true,
+		},
 	},
 }
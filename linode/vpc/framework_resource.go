@@ -29,6 +29,32 @@ type Resource struct {
 	helper.BaseResource
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.Meta == nil {
+		return
+	}
+
+	var region types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper.ValidateRegionRequirements(
+		ctx,
+		r.Meta.Client,
+		path.Root("region"),
+		region,
+		helper.RegionRequirements{Capabilities: []string{linodego.CapabilityVPCs}},
+		&resp.Diagnostics,
+	)
+}
+
 func (r *Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -0,0 +1,63 @@
+//go:build unit
+
+package vpc_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v3/linode/helper/unit/fakeapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceVPC_fakeAPI(t *testing.T) {
+	server := fakeapi.NewServer(t)
+	tf := server.NewTerraform(t)
+
+	vpcConfig := map[string]any{
+		"label":       "fake-vpc",
+		"region":      "us-east",
+		"description": "test",
+	}
+
+	vpc := tf.Apply("linode_vpc", nil, vpcConfig)
+	require.NotEmpty(t, vpc.Attr("id"))
+	require.Equal(t, "test", vpc.Attr("description"))
+
+	vpcConfig["description"] = "updated"
+
+	vpc = tf.Apply("linode_vpc", vpc, vpcConfig)
+	require.Equal(t, "updated", vpc.Attr("description"))
+
+	vpcID, err := strconv.Atoi(vpc.Attr("id").(string))
+	require.NoError(t, err)
+
+	subnetConfig := map[string]any{
+		"vpc_id": vpcID,
+		"label":  "fake-subnet",
+		"ipv4":   "10.0.0.0/24",
+	}
+
+	subnet := tf.Apply("linode_vpc_subnet", nil, subnetConfig)
+	require.NotEmpty(t, subnet.Attr("id"))
+	require.Equal(t, "10.0.0.0/24", subnet.Attr("ipv4"))
+
+	subnetConfig["label"] = "fake-subnet-renamed"
+
+	subnet = tf.Apply("linode_vpc_subnet", subnet, subnetConfig)
+	require.Equal(t, "fake-subnet-renamed", subnet.Attr("label"))
+
+	imported := tf.Import("linode_vpc_subnet", fmt.Sprintf("%d,%s", vpcID, subnet.Attr("id")))
+	require.Equal(t, subnet.Attr("label"), imported.Attr("label"))
+	require.Equal(t, subnet.Attr("ipv4"), imported.Attr("ipv4"))
+
+	imported = tf.Import("linode_vpc", vpc.Attr("id").(string))
+	require.Equal(t, vpc.Attr("label"), imported.Attr("label"))
+
+	tf.Destroy(subnet)
+	require.Empty(t, server.List(fmt.Sprintf("vpcs/%d/subnets", vpcID)))
+
+	tf.Destroy(vpc)
+	require.Empty(t, server.List("vpcs"))
+}